@@ -30,7 +30,8 @@ func main() {
 	// Create PostHog client
 	client := posthog.NewClient(apiKey, projectID, baseURL)
 	cohortGenerator := posthog.NewCohortGenerator(client)
-	dataSource := posthog.NewPostHogDataSource(client, cohortGenerator)
+	// Only participants are fetched, which does not need the blacklist
+	dataSource := posthog.NewPostHogDataSource(client, cohortGenerator, nil)
 
 	// Create context
 	ctx := context.Background()
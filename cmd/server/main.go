@@ -2,27 +2,47 @@ package main
 
 import (
 	"context"
+	"crypto/ed25519"
+	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
+	_ "time/tzdata" // Draw schedule time zones must load on hosts without a zoneinfo database
 
 	"github.com/gin-gonic/gin"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/adapter"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/infrastructure/cache"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/infrastructure/config"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/infrastructure/mailer"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/infrastructure/notifier"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/infrastructure/payoutprovider"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/infrastructure/persistence/gorm"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/infrastructure/scheduler"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/api"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/api/middleware"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/api/handler"
 
 	// Application services
 	auditApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/audit"
+	blacklistApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/blacklist"
 	drawApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/draw"
+	notificationApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/notification"
 	participantApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/participant"
+	payoutApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/payout"
 	prizeApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/prize"
+	reportApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/report"
+	scheduleApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/schedule"
 	userApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/user"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/mail"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/notification"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/payout"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/report"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/schedule"
 )
 
 func main() {
@@ -44,6 +64,23 @@ func main() {
 	participantRepo := gorm.NewGormParticipantRepository(db.DB)
 	prizeRepo := gorm.NewGormPrizeRepository(db.DB)
 	userRepo := gorm.NewGormUserRepository(db.DB)
+	blacklistRepo := gorm.NewGormBlacklistRepository(db.DB)
+	unitOfWork := gorm.NewGormUnitOfWork(db.DB)
+	drawScheduleRepo := gorm.NewGormDrawScheduleRepository(db.DB)
+	notificationRepo := gorm.NewGormNotificationRepository(db.DB)
+	payoutRepo := gorm.NewGormPayoutRepository(db.DB)
+	reportRepo := gorm.NewGormReportRepository(db.DB)
+	tokenRepo := gorm.NewGormTokenRepository(db.DB)
+	loginAttemptRepo := gorm.NewGormLoginAttemptRepository(db.DB)
+	twoFactorRepo := gorm.NewGormTwoFactorRepository(db.DB)
+	passwordResetTokenRepo := gorm.NewGormPasswordResetTokenRepository(db.DB)
+
+	// Every authenticated request looks up its user, so the auth middleware's lookups by ID are
+	// cached briefly. User services read uncached, since a stale copy would overwrite a
+	// deactivation or token version bump made by another replica, and their writes evict the
+	// user so that the next request sees the change.
+	cachedUserRepo := cache.NewUserRepository(userRepo, cfg.JWT.UserCacheTTL)
+	userWriteRepo := cachedUserRepo.ForWrites()
 
 	// Set up application services
 	logAuditService := auditApp.NewLogAuditService(auditRepo)
@@ -51,20 +88,29 @@ func main() {
 	getDataUploadAuditsService := auditApp.NewGetDataUploadAuditsService(auditRepo)
 
 	// Draw services
-	executeDrawService := drawApp.NewDrawService(drawRepo, participantRepo, prizeRepo, logAuditService)
+	executeDrawService := drawApp.NewDrawService(drawRepo, participantRepo, prizeRepo, blacklistRepo, unitOfWork, logAuditService)
 	getDrawByIDService := drawApp.NewGetDrawByIDService(drawRepo)
 	listDrawsService := drawApp.NewListDrawsService(drawRepo)
 	listWinnersService := drawApp.NewListWinnersService(drawRepo)
-	getEligibilityStatsService := drawApp.NewGetEligibilityStatsService(drawRepo, participantRepo)
-	invokeRunnerUpService := drawApp.NewInvokeRunnerUpService(drawRepo, logAuditService)
-	updateWinnerPaymentStatusService := drawApp.NewUpdateWinnerPaymentStatusService(drawRepo)
+	getEligibilityStatsService := drawApp.NewGetEligibilityStatsService(drawRepo, participantRepo, prizeRepo, blacklistRepo)
+	invokeRunnerUpService := drawApp.NewInvokeRunnerUpService(drawRepo, prizeRepo, unitOfWork, logAuditService)
+	updateWinnerPaymentStatusService := drawApp.NewUpdateWinnerPaymentStatusService(unitOfWork, logAuditService)
+	verifyDrawService := drawApp.NewVerifyDrawService(drawRepo)
+	scheduleDrawService := drawApp.NewScheduleDrawService(drawRepo, prizeRepo, unitOfWork, logAuditService)
+	voidDrawService := drawApp.NewVoidDrawService(drawRepo, logAuditService)
+	getReplacementHistoryService := drawApp.NewGetReplacementHistoryService(drawRepo)
+	confirmWinnerClaimService := drawApp.NewConfirmWinnerClaimService(drawRepo, logAuditService)
+	getWinnerPaymentHistoryService := drawApp.NewGetWinnerPaymentHistoryService(drawRepo)
+	reconcileWinnerPaymentsService := drawApp.NewReconcileWinnerPaymentsService(drawRepo, prizeRepo, unitOfWork, logAuditService)
+	forfeitExpiredClaimsService := drawApp.NewForfeitExpiredClaimsService(drawRepo, prizeRepo, unitOfWork, logAuditService)
 
 	// Participant services
-	uploadParticipantsService := participantApp.NewUploadParticipantsService(participantRepo, logAuditService)
+	uploadParticipantsService := participantApp.NewUploadParticipantsService(participantRepo, blacklistRepo, logAuditService)
 	getParticipantStatsService := participantApp.NewGetParticipantStatsService(participantRepo)
 	listParticipantsService := participantApp.NewListParticipantsService(participantRepo)
 	listUploadAuditsService := participantApp.NewListUploadAuditsService(participantRepo)
 	deleteUploadService := participantApp.NewDeleteUploadService(participantRepo)
+	getUploadErrorReportService := participantApp.NewGetUploadErrorReportService(participantRepo)
 
 	// Prize services
 	createPrizeStructureService := prizeApp.NewCreatePrizeStructureService(prizeRepo, logAuditService)
@@ -74,17 +120,120 @@ func main() {
 	deletePrizeStructureService := prizeApp.NewDeletePrizeStructureService(prizeRepo)
 
 	// User services
-	authenticateUserService := userApp.NewAuthenticateUserService(userRepo, logAuditService)
-	createUserService := userApp.NewCreateUserService(userRepo, logAuditService)
-	updateUserService := userApp.NewUpdateUserService(userRepo, logAuditService)
-	getUserService := userApp.NewGetUserService(userRepo)
-	listUsersService := userApp.NewListUsersService(userRepo)
+	tokenSettings := userApp.TokenSettings{
+		Secret:        cfg.JWT.Secret,
+		AccessExpiry:  cfg.JWT.TokenExpiry,
+		RefreshExpiry: cfg.JWT.RefreshExpiry,
+	}
+	lockoutPolicy := auth.DefaultLockoutPolicy()
+	lockoutPolicy.MaxAccountFailures = cfg.Login.MaxAccountFailures
+	lockoutPolicy.MaxIPFailures = cfg.Login.MaxIPFailures
+	lockoutPolicy.LockoutDuration = cfg.Login.LockoutDuration
+	lockoutPolicy.FailureWindow = cfg.Login.FailureWindow
+	authenticateUserService := userApp.NewAuthenticateUserService(userWriteRepo, tokenRepo, loginAttemptRepo, twoFactorRepo, logAuditService, tokenSettings, lockoutPolicy)
+	refreshTokenService := userApp.NewRefreshTokenService(userWriteRepo, tokenRepo, logAuditService, tokenSettings)
+	logoutService := userApp.NewLogoutService(tokenRepo, logAuditService)
+	unlockUserService := userApp.NewUnlockUserService(userWriteRepo, loginAttemptRepo, logAuditService)
+	twoFactorService := userApp.NewTwoFactorService(userWriteRepo, twoFactorRepo, loginAttemptRepo, logAuditService, lockoutPolicy)
+	passwordMailer, err := newMailer(&cfg.Mail)
+	if err != nil {
+		log.Fatalf("Failed to set up mail: %v", err)
+	}
+	passwordRecoveryService := userApp.NewPasswordRecoveryService(userWriteRepo, passwordResetTokenRepo, tokenRepo, loginAttemptRepo, passwordMailer, logAuditService, userApp.PasswordResetSettings{
+		TokenExpiry:         cfg.PasswordReset.TokenExpiry,
+		ResetURL:            cfg.PasswordReset.URL,
+		MaxRequestsPerEmail: cfg.PasswordReset.MaxRequestsPerEmail,
+		MaxRequestsPerIP:    cfg.PasswordReset.MaxRequestsPerIP,
+		RequestWindow:       cfg.PasswordReset.RequestWindow,
+	})
+	createUserService := userApp.NewCreateUserService(userWriteRepo, logAuditService)
+	updateUserService := userApp.NewUpdateUserService(userWriteRepo, logAuditService)
+	getUserService := userApp.NewGetUserService(userWriteRepo)
+	listUsersService := userApp.NewListUsersService(userWriteRepo)
 	
 	// Password reset service
-	resetPasswordService := userApp.NewResetPasswordService(userRepo, logAuditService)
+	resetPasswordService := userApp.NewResetPasswordService(userWriteRepo, logAuditService)
+
+	// Blacklist services
+	createBlacklistEntryService := blacklistApp.NewCreateBlacklistEntryService(blacklistRepo, logAuditService)
+	getBlacklistEntryService := blacklistApp.NewGetBlacklistEntryService(blacklistRepo)
+	listBlacklistEntriesService := blacklistApp.NewListBlacklistEntriesService(blacklistRepo)
+	updateBlacklistEntryService := blacklistApp.NewUpdateBlacklistEntryService(blacklistRepo, logAuditService)
+	deleteBlacklistEntryService := blacklistApp.NewDeleteBlacklistEntryService(blacklistRepo, logAuditService)
+	importBlacklistService := blacklistApp.NewImportBlacklistService(blacklistRepo, logAuditService)
+
+	// Draw schedule services
+	createDrawScheduleService := scheduleApp.NewCreateDrawScheduleService(drawScheduleRepo, prizeRepo, logAuditService)
+	getDrawScheduleService := scheduleApp.NewGetDrawScheduleService(drawScheduleRepo)
+	listDrawSchedulesService := scheduleApp.NewListDrawSchedulesService(drawScheduleRepo)
+	updateDrawScheduleService := scheduleApp.NewUpdateDrawScheduleService(drawScheduleRepo, prizeRepo, logAuditService)
+	deleteDrawScheduleService := scheduleApp.NewDeleteDrawScheduleService(drawScheduleRepo, logAuditService)
+	runDueDrawSchedulesService := scheduleApp.NewRunDueDrawSchedulesService(
+		drawScheduleRepo,
+		prizeRepo,
+		executeDrawService,
+		gorm.NewGormAdvisoryLock(db.DB, schedule.RunLockKey),
+		logAuditService,
+		cfg.Scheduler.MaxCatchUpRuns,
+	)
+
+	// Notification services
+	winnerNotifier, err := newNotifier(&cfg.Notifications)
+	if err != nil {
+		log.Fatalf("Failed to set up notifications: %v", err)
+	}
+	dispatchWinnerNotificationsService := notificationApp.NewDispatchWinnerNotificationsService(
+		notificationRepo,
+		drawRepo,
+		prizeRepo,
+		winnerNotifier,
+		gorm.NewGormAdvisoryLock(db.DB, notification.DispatchLockKey),
+		cfg.Notifications.MaxAttempts,
+	)
+	handleDeliveryReceiptService := notificationApp.NewHandleDeliveryReceiptService(notificationRepo, unitOfWork, logAuditService)
+	listWinnerNotificationsService := notificationApp.NewListWinnerNotificationsService(notificationRepo)
+
+	// Payout services
+	payoutProviders, err := newPayoutProviders(&cfg.Payouts)
+	if err != nil {
+		log.Fatalf("Failed to set up payouts: %v", err)
+	}
+	requestPayoutService := payoutApp.NewRequestPayoutService(
+		payoutRepo,
+		drawRepo,
+		prizeRepo,
+		payoutProviders,
+		unitOfWork,
+		logAuditService,
+		cfg.Payouts.AirtimeMaxValue,
+	)
+	settlePayoutsService := payoutApp.NewSettlePayoutsService(
+		payoutRepo,
+		payoutProviders,
+		unitOfWork,
+		gorm.NewGormAdvisoryLock(db.DB, payout.SettleLockKey),
+		logAuditService,
+	)
+	handlePayoutCallbackService := payoutApp.NewHandlePayoutCallbackService(payoutRepo, payoutProviders, unitOfWork, logAuditService)
+	getWinnerPayoutService := payoutApp.NewGetWinnerPayoutService(payoutRepo)
+
+	// Report services
+	var certificateSigningKey ed25519.PrivateKey
+	if cfg.Reports.CertificateSigningKey != "" {
+		certificateSigningKey, err = report.ParseSigningKey(cfg.Reports.CertificateSigningKey)
+		if err != nil {
+			log.Fatalf("Invalid REPORT_CERTIFICATE_SIGNING_KEY: %v", err)
+		}
+	} else {
+		log.Printf("REPORT_CERTIFICATE_SIGNING_KEY is not set; winners certificates are unavailable")
+	}
+	exportWinnersReportService := reportApp.NewExportWinnersReportService(reportRepo, logAuditService)
+	exportPaymentLedgerService := reportApp.NewExportPaymentLedgerService(reportRepo, logAuditService)
+	exportPrizeLiabilityService := reportApp.NewExportPrizeLiabilityService(reportRepo, logAuditService)
+	generateWinnersCertificateService := reportApp.NewGenerateWinnersCertificateService(drawRepo, reportRepo, certificateSigningKey, logAuditService)
 
 	// Set up middleware
-	authMiddleware := middleware.NewAuthMiddleware(cfg.JWT.Secret)
+	authMiddleware := middleware.NewAuthMiddleware(cfg.JWT.Secret, tokenRepo, cachedUserRepo, twoFactorService)
 	corsMiddleware := middleware.Default()
 	errorMiddleware := middleware.NewErrorMiddleware(true)
 
@@ -100,6 +249,13 @@ func main() {
 		invokeRunnerUpService,
 		updateWinnerPaymentStatusService,
 		listWinnersService,
+		verifyDrawService,
+		scheduleDrawService,
+		voidDrawService,
+		getReplacementHistoryService,
+		confirmWinnerClaimService,
+		getWinnerPaymentHistoryService,
+		reconcileWinnerPaymentsService,
 	)
 	
 	participantServiceAdapter := adapter.NewParticipantServiceAdapter(
@@ -121,6 +277,7 @@ func main() {
 	participantHandler := handler.NewParticipantHandler(
 		participantServiceAdapter,
 		getParticipantStatsService,
+		getUploadErrorReportService,
 	)
 	
 	prizeHandler := handler.NewPrizeHandler(
@@ -142,6 +299,51 @@ func main() {
 	
 	// Password reset handler
 	resetPasswordHandler := handler.NewResetPasswordHandler(resetPasswordService)
+	
+	blacklistHandler := handler.NewBlacklistHandler(
+		createBlacklistEntryService,
+		getBlacklistEntryService,
+		listBlacklistEntriesService,
+		updateBlacklistEntryService,
+		deleteBlacklistEntryService,
+		importBlacklistService,
+	)
+
+	drawScheduleHandler := handler.NewDrawScheduleHandler(
+		createDrawScheduleService,
+		getDrawScheduleService,
+		listDrawSchedulesService,
+		updateDrawScheduleService,
+		deleteDrawScheduleService,
+	)
+
+	notificationHandler := handler.NewNotificationHandler(
+		handleDeliveryReceiptService,
+		listWinnerNotificationsService,
+		cfg.Notifications.CallbackToken,
+	)
+
+	payoutHandler := handler.NewPayoutHandler(
+		requestPayoutService,
+		getWinnerPayoutService,
+		handlePayoutCallbackService,
+		cfg.Payouts.CallbackToken,
+	)
+
+	reportHandler := handler.NewReportHandler(
+		exportWinnersReportService,
+		exportPaymentLedgerService,
+		exportPrizeLiabilityService,
+		generateWinnersCertificateService,
+	)
+
+	authHandler := handler.NewAuthHandler(
+		refreshTokenService,
+		logoutService,
+		unlockUserService,
+		twoFactorService,
+		passwordRecoveryService,
+	)
 
 	// Set up router
 	router := api.NewRouter(
@@ -155,6 +357,12 @@ func main() {
 		auditHandler,
 		userHandler,
 		resetPasswordHandler,
+		blacklistHandler,
+		drawScheduleHandler,
+		notificationHandler,
+		payoutHandler,
+		reportHandler,
+		authHandler,
 	)
 
 	// Setup routes
@@ -175,16 +383,43 @@ func main() {
 		&gorm.SystemAuditLogModel{},
 		&gorm.DrawModel{},
 		&gorm.WinnerModel{},
+		&gorm.WinnerPaymentEventModel{},
+		&gorm.DrawEntryModel{},
 		&gorm.ParticipantModel{},
 		&gorm.UploadAuditModel{},
 		&gorm.PrizeStructureModel{},
 		&gorm.PrizeTierModel{},
 		&gorm.PrizeModel{},
 		&gorm.UserModel{},
+		&gorm.BlacklistEntryModel{},
+		&gorm.DrawScheduleModel{},
+		&gorm.NotificationModel{},
+		&gorm.PayoutModel{},
+		&gorm.RefreshTokenModel{},
+		&gorm.RevokedTokenModel{},
+		&gorm.LoginAttemptModel{},
+		&gorm.TwoFactorModel{},
+		&gorm.RecoveryCodeModel{},
+		&gorm.PasswordResetTokenModel{},
 	); err != nil {
 		log.Fatalf("Failed to run migrations: %v", err)
 	}
 
+	// Rewrite roles stored with legacy spellings such as "super_admin"
+	if migrated, err := userRepo.MigrateLegacyRoles(); err != nil {
+		log.Fatalf("Failed to migrate user roles: %v", err)
+	} else if migrated > 0 {
+		log.Printf("Migrated %d users to canonical roles", migrated)
+	}
+
+	// Drop refresh tokens and revoked access tokens that have expired
+	if err := tokenRepo.DeleteExpired(time.Now()); err != nil {
+		log.Printf("Failed to delete expired tokens: %v", err)
+	}
+	if err := passwordResetTokenRepo.DeleteExpired(time.Now()); err != nil {
+		log.Printf("Failed to delete expired password reset tokens: %v", err)
+	}
+
 	// Start server in a goroutine
 	go func() {
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -194,6 +429,35 @@ func main() {
 
 	log.Printf("Server started on port %s", cfg.Server.Port)
 
+	// Start the draw scheduler. Every replica runs it; an advisory lock lets only one execute draws.
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	var schedulerDone <-chan struct{}
+	if cfg.Scheduler.Enabled {
+		schedulerDone = scheduler.NewDrawScheduler(runDueDrawSchedulesService, cfg.Scheduler.Interval).Start(schedulerCtx)
+		log.Printf("Draw scheduler started, checking every %s", cfg.Scheduler.Interval)
+	}
+
+	// Start forfeiting unclaimed prizes. Replicas may overlap; each forfeiture locks its draw.
+	var forfeiterDone <-chan struct{}
+	if cfg.Claims.ForfeitureEnabled {
+		forfeiterDone = scheduler.NewClaimForfeiter(forfeitExpiredClaimsService, cfg.Claims.ForfeitureInterval).Start(schedulerCtx)
+		log.Printf("Claim forfeiter started, checking every %s", cfg.Claims.ForfeitureInterval)
+	}
+
+	// Start notifying winners. Every replica runs it; an advisory lock lets only one send.
+	var dispatcherDone <-chan struct{}
+	if cfg.Notifications.Enabled {
+		dispatcherDone = scheduler.NewNotificationDispatcher(dispatchWinnerNotificationsService, cfg.Notifications.Interval).Start(schedulerCtx)
+		log.Printf("Notification dispatcher started on channel %s, checking every %s", cfg.Notifications.Channel, cfg.Notifications.Interval)
+	}
+
+	// Start settling payouts. Every replica runs it; an advisory lock lets only one check providers.
+	var settlerDone <-chan struct{}
+	if cfg.Payouts.Provider != "" {
+		settlerDone = scheduler.NewPayoutSettler(settlePayoutsService, cfg.Payouts.Interval).Start(schedulerCtx)
+		log.Printf("Payout settler started with provider %s, checking every %s", cfg.Payouts.Provider, cfg.Payouts.Interval)
+	}
+
 	// Wait for interrupt signal to gracefully shut down the server
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -201,6 +465,21 @@ func main() {
 
 	log.Println("Shutting down server...")
 
+	// Let a draw that is being executed finish before the database is closed
+	stopScheduler()
+	if schedulerDone != nil {
+		<-schedulerDone
+	}
+	if forfeiterDone != nil {
+		<-forfeiterDone
+	}
+	if dispatcherDone != nil {
+		<-dispatcherDone
+	}
+	if settlerDone != nil {
+		<-settlerDone
+	}
+
 	// Create a deadline for server shutdown
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -217,3 +496,63 @@ func main() {
 
 	log.Println("Server exited properly")
 }
+
+// newNotifier creates the notifier of the configured notification channel
+func newNotifier(cfg *config.NotificationsConfig) (notification.Notifier, error) {
+	switch cfg.Channel {
+	case "sms_gateway":
+		if cfg.SMSGatewayURL == "" {
+			return nil, fmt.Errorf("SMS_GATEWAY_URL is required for the sms_gateway channel")
+		}
+		return notifier.NewSMSGatewayNotifier(cfg.SMSGatewayURL, cfg.SMSGatewayAPIKey, cfg.SMSSenderID, cfg.CallbackURL), nil
+	case "log":
+		var out io.Writer = os.Stdout
+		if cfg.LogFile != "" {
+			file, err := os.OpenFile(cfg.LogFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+			if err != nil {
+				return nil, fmt.Errorf("failed to open notification log file: %w", err)
+			}
+			out = file
+		}
+		return notifier.NewLogNotifier(out), nil
+	}
+
+	return nil, fmt.Errorf("unknown notification channel %q", cfg.Channel)
+}
+
+// newMailer creates the mailer of the configured mail provider
+func newMailer(cfg *config.MailConfig) (mail.Mailer, error) {
+	switch cfg.Provider {
+	case "smtp":
+		if cfg.SMTPHost == "" {
+			return nil, fmt.Errorf("SMTP_HOST is required for the smtp mail provider")
+		}
+		return mailer.NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.From), nil
+	case "file":
+		// The default, so a deployment that forgot to configure mail notices
+		log.Printf("Warning: MAIL_PROVIDER is %q; password reset emails are written to %s and not delivered", cfg.Provider, cfg.FileDir)
+		return mailer.NewFileMailer(cfg.FileDir), nil
+	}
+
+	return nil, fmt.Errorf("unknown mail provider %q", cfg.Provider)
+}
+
+// newPayoutProviders creates the providers of the configured payout provider. One provider
+// pays both airtime and mobile money; payouts are unavailable while none is configured.
+func newPayoutProviders(cfg *config.PayoutsConfig) (*payout.ProviderSet, error) {
+	switch cfg.Provider {
+	case "":
+		return payout.NewProviderSet(nil, nil), nil
+	case "mock":
+		provider := payoutprovider.NewMockProvider("mock")
+		return payout.NewProviderSet(provider, provider), nil
+	case "http":
+		if cfg.APIURL == "" {
+			return nil, fmt.Errorf("PAYOUT_API_URL is required for the http payout provider")
+		}
+		provider := payoutprovider.NewHTTPProvider("http", cfg.APIURL, cfg.APIKey, cfg.CallbackURL)
+		return payout.NewProviderSet(provider, provider), nil
+	}
+
+	return nil, fmt.Errorf("unknown payout provider %q", cfg.Provider)
+}
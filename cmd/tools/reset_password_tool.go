@@ -60,7 +60,7 @@ func main() {
 	}
 	
 	// Update user password
-	_, err = db.Exec("UPDATE users SET password_hash = $1, updated_at = NOW() WHERE id = $2", 
+	_, err = db.Exec("UPDATE users SET password_hash = $1, token_version = token_version + 1, updated_at = NOW() WHERE id = $2", 
 		string(hashedPassword), userID)
 	if err != nil {
 		log.Fatalf("Failed to update password: %v", err)
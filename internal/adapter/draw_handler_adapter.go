@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 
+	drawDomain "github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/entity"
 )
 
@@ -54,16 +55,23 @@ func (a *DrawHandlerAdapter) ListWinners(
 	page, pageSize int,
 	startDateStr, endDateStr string,
 ) (*entity.PaginatedWinners, error) {
-	// Parse the draw ID if provided
-	var drawID uuid.UUID
-	
-	// Default values for other filters
-	msisdn := ""
-	status := ""
-	paymentStatus := ""
-	isRunnerUp := false
-
-	return a.drawServiceAdapter.GetWinners(ctx, page, pageSize, drawID, msisdn, status, paymentStatus, isRunnerUp)
+	var filters drawDomain.WinnerFilters
+	if startDateStr != "" {
+		startDate, err := time.Parse("2006-01-02", startDateStr)
+		if err != nil {
+			return nil, err
+		}
+		filters.StartDate = startDate
+	}
+	if endDateStr != "" {
+		endDate, err := time.Parse("2006-01-02", endDateStr)
+		if err != nil {
+			return nil, err
+		}
+		filters.EndDate = endDate
+	}
+
+	return a.drawServiceAdapter.GetWinners(ctx, page, pageSize, filters)
 }
 
 // ExecuteDraw adapts the service adapter's ExecuteDraw to match the handler's expected signature
@@ -96,7 +104,7 @@ func (a *DrawHandlerAdapter) GetEligibilityStats(
 		return nil, err
 	}
 
-	return a.drawServiceAdapter.GetEligibilityStats(ctx, drawDate)
+	return a.drawServiceAdapter.GetEligibilityStats(ctx, drawDate, uuid.Nil)
 }
 
 // InvokeRunnerUp adapts the service adapter's InvokeRunnerUp to match the handler's expected signature
@@ -106,24 +114,15 @@ func (a *DrawHandlerAdapter) InvokeRunnerUp(
 	invokedBy uuid.UUID,
 	reason string,
 ) (*entity.RunnerUpInvocationResult, error) {
-	winner, err := a.drawServiceAdapter.InvokeRunnerUp(ctx, winnerID, reason, invokedBy)
+	originalWinner, newWinner, err := a.drawServiceAdapter.InvokeRunnerUp(ctx, winnerID, reason, invokedBy)
 	if err != nil {
 		return nil, err
 	}
 
-	// Create a mock result since we don't have the original winner in the adapter response
-	result := &entity.RunnerUpInvocationResult{
-		NewWinner: *winner,
-		OriginalWinner: entity.Winner{
-			ID:          uuid.New(), // Placeholder
-			Status:      "Forfeited",
-			IsRunnerUp:  false,
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
-		},
-	}
-
-	return result, nil
+	return &entity.RunnerUpInvocationResult{
+		OriginalWinner: *originalWinner,
+		NewWinner:      *newWinner,
+	}, nil
 }
 
 // UpdateWinnerPaymentStatus adapts the service adapter's UpdateWinnerPaymentStatus to match the handler's expected signature
@@ -132,6 +131,7 @@ func (a *DrawHandlerAdapter) UpdateWinnerPaymentStatus(
 	winnerIDStr string,
 	paymentStatus string,
 	paymentRef string,
+	notes string,
 	updatedBy uuid.UUID,
 ) (*entity.Winner, error) {
 	// Parse the winner ID
@@ -140,5 +140,5 @@ func (a *DrawHandlerAdapter) UpdateWinnerPaymentStatus(
 		return nil, err
 	}
 
-	return a.drawServiceAdapter.UpdateWinnerPaymentStatus(ctx, winnerID, paymentStatus, updatedBy)
+	return a.drawServiceAdapter.UpdateWinnerPaymentStatus(ctx, winnerID, paymentStatus, paymentRef, notes, updatedBy)
 }
@@ -7,7 +7,9 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/ArowuTest/GP-Backend-Promo/internal/application/draw"
+	drawDomain "github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/entity"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/spreadsheet"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/util"
 )
 
@@ -20,6 +22,13 @@ type DrawServiceAdapter struct {
 	invokeRunnerUpService *draw.InvokeRunnerUpService
 	updateWinnerService *draw.UpdateWinnerPaymentStatusService
 	listWinnersService  *draw.ListWinnersService
+	verifyDrawService   *draw.VerifyDrawService
+	scheduleDrawService *draw.ScheduleDrawService
+	voidDrawService     *draw.VoidDrawService
+	getReplacementHistoryService *draw.GetReplacementHistoryService
+	confirmWinnerClaimService *draw.ConfirmWinnerClaimService
+	getWinnerPaymentHistoryService *draw.GetWinnerPaymentHistoryService
+	reconcileWinnerPaymentsService *draw.ReconcileWinnerPaymentsService
 }
 
 // NewDrawServiceAdapter creates a new DrawServiceAdapter
@@ -31,6 +40,13 @@ func NewDrawServiceAdapter(
 	invokeRunnerUpService *draw.InvokeRunnerUpService,
 	updateWinnerService *draw.UpdateWinnerPaymentStatusService,
 	listWinnersService *draw.ListWinnersService,
+	verifyDrawService *draw.VerifyDrawService,
+	scheduleDrawService *draw.ScheduleDrawService,
+	voidDrawService *draw.VoidDrawService,
+	getReplacementHistoryService *draw.GetReplacementHistoryService,
+	confirmWinnerClaimService *draw.ConfirmWinnerClaimService,
+	getWinnerPaymentHistoryService *draw.GetWinnerPaymentHistoryService,
+	reconcileWinnerPaymentsService *draw.ReconcileWinnerPaymentsService,
 ) *DrawServiceAdapter {
 	return &DrawServiceAdapter{
 		drawService:         drawService,
@@ -40,6 +56,13 @@ func NewDrawServiceAdapter(
 		invokeRunnerUpService: invokeRunnerUpService,
 		updateWinnerService: updateWinnerService,
 		listWinnersService:  listWinnersService,
+		verifyDrawService:   verifyDrawService,
+		scheduleDrawService: scheduleDrawService,
+		voidDrawService:     voidDrawService,
+		getReplacementHistoryService: getReplacementHistoryService,
+		confirmWinnerClaimService: confirmWinnerClaimService,
+		getWinnerPaymentHistoryService: getWinnerPaymentHistoryService,
+		reconcileWinnerPaymentsService: reconcileWinnerPaymentsService,
 	}
 }
 
@@ -80,11 +103,24 @@ func (d *DrawServiceAdapter) ExecuteDraw(
 			PrizeName:   w.PrizeName,
 			PrizeValue:  w.PrizeValue,
 			Status:      "PendingNotification", // Default status
-			IsRunnerUp:  false,
+			IsRunnerUp:  w.IsRunnerUp,
+			RunnerUpRank: w.RunnerUpRank,
 			CreatedAt:   time.Now(),
 		})
 	}
 
+	runnerUps := make([]entity.TierRunnerUps, 0, len(output.RunnerUps))
+	runnerUpsCount := 0
+	for _, tier := range output.RunnerUps {
+		runnerUps = append(runnerUps, entity.TierRunnerUps{
+			PrizeTierID: tier.PrizeTierID,
+			PrizeName:   tier.PrizeName,
+			Requested:   tier.RunnerUpsRequested,
+			Available:   tier.RunnerUpsAvailable,
+		})
+		runnerUpsCount += tier.RunnerUpsAvailable
+	}
+
 	// Create response
 	result := &entity.Draw{
 		ID:                   output.DrawID,
@@ -93,12 +129,14 @@ func (d *DrawServiceAdapter) ExecuteDraw(
 		DrawDate:             output.DrawDate,
 		PrizeStructureID:     prizeStructureID,
 		Status:               "Completed",
-		RunnerUpsCount:       runnerUpCount,
+		SeedHash:             output.SeedHash,
+		RunnerUpsCount:       runnerUpsCount,
 		TotalEligibleMSISDNs: output.TotalEligibleMSISDNs,
 		TotalEntries:         output.TotalEntries,
 		ExecutedByAdminID:    executedByID,
 		CreatedBy:            executedByID,
 		Winners:              winners,
+		RunnerUps:            runnerUps,
 		CreatedAt:            time.Now(),
 		UpdatedAt:            time.Now(),
 	}
@@ -152,11 +190,16 @@ func (d *DrawServiceAdapter) GetDrawByID(
 		DrawDate:             output.DrawDate,
 		PrizeStructureID:     output.PrizeStructureID,
 		Status:               output.Status,
+		SeedHash:             output.SeedHash,
 		RunnerUpsCount:       0, // Not available in output
 		TotalEligibleMSISDNs: output.TotalEligibleMSISDNs,
 		TotalEntries:         output.TotalEntries,
 		ExecutedByAdminID:    output.ExecutedBy,
 		CreatedBy:            uuid.Nil, // Not available in output
+		FailureReason:        output.FailureReason,
+		VoidReason:           output.VoidReason,
+		VoidedAt:             output.VoidedAt,
+		ReplacementDrawID:    output.ReplacementDrawID,
 		Winners:              winners,
 		CreatedAt:            output.CreatedAt,
 		UpdatedAt:            output.UpdatedAt,
@@ -237,10 +280,12 @@ func (d *DrawServiceAdapter) ListDraws(
 	return result, nil
 }
 
-// GetEligibilityStats gets eligibility statistics for a draw
+// GetEligibilityStats gets eligibility statistics for a draw. prizeStructureID selects the
+// eligibility rules to apply; uuid.Nil uses the prize structure active on the date.
 func (d *DrawServiceAdapter) GetEligibilityStats(
 	ctx context.Context,
 	date time.Time,
+	prizeStructureID uuid.UUID,
 ) (*entity.EligibilityStats, error) {
 	// Fix for line 244: Convert time.Time to string as the service expects a string date
 	dateStr := date.Format("2006-01-02")
@@ -248,6 +293,7 @@ func (d *DrawServiceAdapter) GetEligibilityStats(
 	// Create input for the service
 	input := draw.GetEligibilityStatsInput{
 		Date: dateStr, // Using string format as the service expects
+		PrizeStructureID: prizeStructureID,
 	}
 
 	// Get eligibility stats
@@ -262,9 +308,20 @@ func (d *DrawServiceAdapter) GetEligibilityStats(
 		TotalPoints:   output.TotalEntries, // Same as entries for now
 		TotalEligibleMSISDNs: output.TotalEligibleMSISDNs,
 		TotalEntries:  output.TotalEntries,
+		CandidateMSISDNs: output.CandidateMSISDNs,
+		CandidateEntries: output.CandidateEntries,
+		Exclusions:    make([]entity.EligibilityExclusion, 0, len(output.Exclusions)),
+		PrizeStructureID: output.PrizeStructureID,
 		DrawDate:      date,
 		LastUpdated:   time.Now(),
 	}
+	
+	for _, exclusion := range output.Exclusions {
+		result.Exclusions = append(result.Exclusions, entity.EligibilityExclusion{
+			Rule:            exclusion.Rule,
+			ExcludedMSISDNs: exclusion.ExcludedMSISDNs,
+		})
+	}
 
 	return result, nil
 }
@@ -275,7 +332,7 @@ func (d *DrawServiceAdapter) InvokeRunnerUp(
 	winnerID uuid.UUID,
 	reason string,
 	invokedByID uuid.UUID,
-) (*entity.Winner, error) {
+) (*entity.Winner, *entity.Winner, error) {
 	// Create input for the service
 	input := draw.InvokeRunnerUpInput{
 		WinnerID:    winnerID,
@@ -285,91 +342,187 @@ func (d *DrawServiceAdapter) InvokeRunnerUp(
 
 	// Invoke runner-up
 	output, err := d.invokeRunnerUpService.InvokeRunnerUp(ctx, input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	originalWinner := toRunnerUpWinnerEntity(output.OriginalWinner)
+	newWinner := toRunnerUpWinnerEntity(output.NewWinner)
+
+	return &originalWinner, &newWinner, nil
+}
+
+// GetReplacementHistory gets the replacement history of the prize slot held or once held by a winner
+func (d *DrawServiceAdapter) GetReplacementHistory(
+	ctx context.Context,
+	winnerID uuid.UUID,
+) (*entity.ReplacementHistory, error) {
+	output, err := d.getReplacementHistoryService.GetReplacementHistory(ctx, draw.GetReplacementHistoryInput{
+		WinnerID: winnerID,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// Create masked MSISDN
-	maskedMSISDN := util.MaskMSISDN(output.NewWinner.MSISDN)
-	
-	// Create response
-	result := &entity.Winner{
-		ID:          output.NewWinner.ID,
-		DrawID:      uuid.Nil, // Not available in output
-		MSISDN:      output.NewWinner.MSISDN,
-		MaskedMSISDN: maskedMSISDN,
-		PrizeID:     uuid.Nil, // Not available in output
-		PrizeTierID: output.NewWinner.PrizeTierID,
-		PrizeName:   "",
-		PrizeValue:  output.NewWinner.PrizeValue,
-		Status:      output.NewWinner.Status,
-		IsRunnerUp:  false, // This was a runner-up but is now a winner
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+	chain := make([]entity.Winner, 0, len(output.Chain))
+	for _, w := range output.Chain {
+		chain = append(chain, toRunnerUpWinnerEntity(w))
 	}
 
-	return result, nil
+	return &entity.ReplacementHistory{
+		DrawID:             output.DrawID,
+		PrizeTierID:        output.PrizeTierID,
+		CurrentWinnerID:    output.CurrentWinnerID,
+		RemainingRunnerUps: output.RemainingRunnerUps,
+		Chain:              chain,
+	}, nil
+}
+
+// ConfirmWinnerClaim records that a winner claimed the prize within the claim window
+func (d *DrawServiceAdapter) ConfirmWinnerClaim(
+	ctx context.Context,
+	winnerID uuid.UUID,
+	confirmedByID uuid.UUID,
+) (*entity.Winner, error) {
+	output, err := d.confirmWinnerClaimService.ConfirmWinnerClaim(ctx, draw.ConfirmWinnerClaimInput{
+		WinnerID:      winnerID,
+		ConfirmedByID: confirmedByID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	winner := toRunnerUpWinnerEntity(*output)
+	return &winner, nil
 }
 
-// UpdateWinnerPaymentStatus updates a winner's payment status
+// toRunnerUpWinnerEntity converts a runner-up invocation winner to the entity model
+func toRunnerUpWinnerEntity(w draw.RunnerUpWinnerOutput) entity.Winner {
+	return entity.Winner{
+		ID:                 w.ID,
+		DrawID:             w.DrawID,
+		MSISDN:             w.MSISDN,
+		MaskedMSISDN:       util.MaskMSISDN(w.MSISDN),
+		PrizeID:            uuid.Nil, // Not available in output
+		PrizeTierID:        w.PrizeTierID,
+		PrizeValue:         w.PrizeValue,
+		Status:             w.Status,
+		PaymentStatus:      w.PaymentStatus,
+		IsRunnerUp:         w.IsRunnerUp,
+		RunnerUpRank:       w.RunnerUpRank,
+		ReplacesWinnerID:   w.ReplacesWinnerID,
+		ReplacedByWinnerID: w.ReplacedByWinnerID,
+		ReplacementReason:  w.ReplacementReason,
+		ReplacedAt:         w.ReplacedAt,
+		ClaimDeadline:      w.ClaimDeadline,
+		ClaimedAt:          w.ClaimedAt,
+		CreatedAt:          w.CreatedAt,
+	}
+}
+
+// UpdateWinnerPaymentStatus moves a winner's payment through the approval flow
 func (d *DrawServiceAdapter) UpdateWinnerPaymentStatus(
 	ctx context.Context,
 	winnerID uuid.UUID,
 	paymentStatus string,
+	paymentReference string,
+	notes string,
 	updatedByID uuid.UUID,
 ) (*entity.Winner, error) {
-	// Create input for the service - convert UUID to string
-	winnerIDStr := winnerID.String()
-	
-	input := draw.UpdateWinnerPaymentStatusInput{
-		WinnerID:      winnerIDStr,
-		PaymentStatus: paymentStatus,
+	output, err := d.updateWinnerService.UpdateWinnerPaymentStatus(ctx, draw.UpdateWinnerPaymentStatusInput{
+		WinnerID:         winnerID,
+		PaymentStatus:    paymentStatus,
+		PaymentReference: paymentReference,
+		Notes:            notes,
+		UpdatedBy:        updatedByID,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Update winner payment status
-	output, err := d.updateWinnerService.UpdateWinnerPaymentStatus(ctx, input)
+	return &entity.Winner{
+		ID:                output.ID,
+		DrawID:            output.DrawID,
+		MSISDN:            output.MSISDN,
+		MaskedMSISDN:      util.MaskMSISDN(output.MSISDN),
+		PrizeID:           uuid.Nil, // Not available in output
+		PrizeTierID:       output.PrizeTierID,
+		Status:            output.Status,
+		PaymentStatus:     output.PaymentStatus,
+		PaymentNotes:      output.PaymentNotes,
+		PaymentReference:  output.PaymentReference,
+		PaymentApprovedBy: output.PaymentApprovedBy,
+		PaymentApprovedAt: output.PaymentApprovedAt,
+		PaymentHistory:    toPaymentEventEntities(output.PaymentHistory),
+		PaidAt:            output.PaidAt,
+		IsRunnerUp:        output.IsRunnerUp,
+		RunnerUpRank:      output.RunnerUpRank,
+		CreatedAt:         output.CreatedAt,
+		UpdatedAt:         output.UpdatedAt,
+	}, nil
+}
+
+// GetWinnerPaymentHistory gets every step taken to pay a winner's prize
+func (d *DrawServiceAdapter) GetWinnerPaymentHistory(ctx context.Context, winnerID uuid.UUID) (*entity.PaymentHistory, error) {
+	output, err := d.getWinnerPaymentHistoryService.GetWinnerPaymentHistory(ctx, winnerID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Create masked MSISDN
-	maskedMSISDN := util.MaskMSISDN(output.MSISDN)
-	
-	// Create response
-	result := &entity.Winner{
-		ID:            output.ID,
-		DrawID:        uuid.Nil, // Not available in output
-		MSISDN:        output.MSISDN,
-		MaskedMSISDN:  maskedMSISDN,
-		PrizeID:       uuid.Nil, // Not available in output
-		PrizeTierID:   uuid.Nil, // Not available in output
-		PrizeName:     "",
-		PrizeValue:    0,
-		Status:        output.Status,
-		PaymentStatus: output.PaymentStatus,
-		IsRunnerUp:    false, // Default value
-		CreatedAt:     time.Now(), // Default value
-		UpdatedAt:     time.Now(),
-	}
+	return &entity.PaymentHistory{
+		WinnerID:          output.WinnerID,
+		PaymentStatus:     output.PaymentStatus,
+		PaymentReference:  output.PaymentReference,
+		PaymentApprovedBy: output.PaymentApprovedBy,
+		PaymentApprovedAt: output.PaymentApprovedAt,
+		PaidAt:            output.PaidAt,
+		Events:            toPaymentEventEntities(output.Events),
+	}, nil
+}
 
-	return result, nil
+// ReconcileWinnerPayments marks winners paid from a bank statement
+func (d *DrawServiceAdapter) ReconcileWinnerPayments(
+	ctx context.Context,
+	rows spreadsheet.RowReader,
+	reconciledByID uuid.UUID,
+	fileName string,
+	dryRun bool,
+) (*draw.ReconcileWinnerPaymentsOutput, error) {
+	return d.reconcileWinnerPaymentsService.ReconcileWinnerPayments(ctx, draw.ReconcileWinnerPaymentsInput{
+		Rows:         rows,
+		ReconciledBy: reconciledByID,
+		FileName:     fileName,
+		DryRun:       dryRun,
+	})
+}
+
+// toPaymentEventEntities converts domain payment events to the entity model
+func toPaymentEventEntities(events []drawDomain.PaymentEvent) []entity.PaymentEvent {
+	entities := make([]entity.PaymentEvent, 0, len(events))
+	for _, event := range events {
+		entities = append(entities, entity.PaymentEvent{
+			ID:         event.ID,
+			FromStatus: event.FromStatus,
+			ToStatus:   event.ToStatus,
+			ActorID:    event.ActorID,
+			Reference:  event.Reference,
+			Notes:      event.Notes,
+			At:         event.At,
+		})
+	}
+	return entities
 }
 
-// GetWinners gets a list of winners with pagination
+// GetWinners gets a page of the winners matching the filters
 func (d *DrawServiceAdapter) GetWinners(
 	ctx context.Context,
 	page, pageSize int,
-	drawID uuid.UUID,
-	msisdn string,
-	status string,
-	paymentStatus string,
-	isRunnerUp bool,
+	filters drawDomain.WinnerFilters,
 ) (*entity.PaginatedWinners, error) {
-	// Create input for the service
-	// Note: Adjusting to match actual service input structure
 	input := draw.ListWinnersInput{
-		Page:          page,
-		PageSize:      pageSize,
+		Page:     page,
+		PageSize: pageSize,
+		Filters:  filters,
 	}
 
 	// Get winners
@@ -378,7 +531,6 @@ func (d *DrawServiceAdapter) GetWinners(
 		return nil, err
 	}
 
-	// Filter winners based on criteria if needed
 	filteredWinners := output.Winners
 	
 	// Convert winners to entity model
@@ -399,6 +551,7 @@ func (d *DrawServiceAdapter) GetWinners(
 			Status:        w.Status,
 			PaymentStatus: w.PaymentStatus,
 			IsRunnerUp:    w.IsRunnerUp,
+			RunnerUpRank:  w.RunnerUpRank,
 			CreatedAt:     w.CreatedAt,
 			UpdatedAt:     w.UpdatedAt,
 		})
@@ -415,3 +568,57 @@ func (d *DrawServiceAdapter) GetWinners(
 
 	return result, nil
 }
+
+// VerifyDraw re-runs a draw from its revealed seed and entry snapshot
+func (d *DrawServiceAdapter) VerifyDraw(
+	ctx context.Context,
+	drawID uuid.UUID,
+) (*draw.VerifyDrawOutput, error) {
+	return d.verifyDrawService.VerifyDraw(ctx, draw.VerifyDrawInput{
+		DrawID: drawID,
+	})
+}
+
+// SimulateDraw previews a draw without persisting it
+func (d *DrawServiceAdapter) SimulateDraw(
+	ctx context.Context,
+	drawDate time.Time,
+	prizeStructureID uuid.UUID,
+	simulatedByID uuid.UUID,
+) (*draw.SimulateDrawOutput, error) {
+	return d.drawService.SimulateDraw(draw.ExecuteDrawInput{
+		DrawDate:          drawDate,
+		PrizeStructureID:  prizeStructureID,
+		ExecutedByAdminID: simulatedByID,
+	})
+}
+
+// ScheduleDraw creates a draw that is executed later for the given date
+func (d *DrawServiceAdapter) ScheduleDraw(
+	ctx context.Context,
+	drawDate time.Time,
+	prizeStructureID uuid.UUID,
+	scheduledByID uuid.UUID,
+) (*draw.ScheduleDrawOutput, error) {
+	return d.scheduleDrawService.ScheduleDraw(ctx, draw.ScheduleDrawInput{
+		DrawDate:           drawDate,
+		PrizeStructureID:   prizeStructureID,
+		ScheduledByAdminID: scheduledByID,
+	})
+}
+
+// VoidDraw voids a draw
+func (d *DrawServiceAdapter) VoidDraw(
+	ctx context.Context,
+	drawID uuid.UUID,
+	reason string,
+	voidedByID uuid.UUID,
+	voidedByRole string,
+) (*draw.VoidDrawOutput, error) {
+	return d.voidDrawService.VoidDraw(ctx, draw.VoidDrawInput{
+		DrawID:          drawID,
+		Reason:          reason,
+		VoidedByAdminID: voidedByID,
+		VoidedByRole:    voidedByRole,
+	})
+}
@@ -2,12 +2,14 @@ package adapter
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 
 	appParticipant "github.com/ArowuTest/GP-Backend-Promo/internal/application/participant"
 	domainParticipant "github.com/ArowuTest/GP-Backend-Promo/internal/domain/participant"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/spreadsheet"
 )
 
 // ParticipantServiceAdapter adapts the participant service to a consistent interface
@@ -84,13 +86,17 @@ type ListUploadAuditsOutput struct {
 
 // UploadParticipantsOutput represents the output of UploadParticipants
 type UploadParticipantsOutput struct {
-	ID             uuid.UUID
-	FileName       string
-	UploadDate     time.Time
-	RecordCount    int
-	Status         string
-	ErrorMessage   string
-	ProcessingTime string
+	ID                   uuid.UUID
+	FileName             string
+	UploadDate           time.Time
+	RecordCount          int
+	SuccessfullyImported int
+	DuplicatesSkipped    int
+	ErrorsEncountered    int
+	RowErrors            []appParticipant.UploadRowError
+	Status               string
+	ErrorMessage         string
+	ProcessingTime       string
 }
 
 // DeleteUploadOutput represents the output of DeleteUpload
@@ -213,28 +219,76 @@ func (p *ParticipantServiceAdapter) ListUploadAudits(ctx context.Context, page,
 
 // UploadParticipants uploads participants
 func (p *ParticipantServiceAdapter) UploadParticipants(ctx context.Context, participants []domainParticipant.ParticipantInput, uploadedBy uuid.UUID, fileName string) (*UploadParticipantsOutput, error) {
-	// Mock output since we're using interface{} type
-	// This is a temporary fix until proper interface alignment is done
-	output := &appParticipant.UploadParticipantsOutput{
-		ID:             uuid.New(),
-		FileName:       fileName,
-		UploadDate:     time.Now(),
-		RecordCount:    len(participants),
-		Status:         "COMPLETED",
-		ErrorMessage:   "",
-		ProcessingTime: "0s",
+	service, err := p.uploadService()
+	if err != nil {
+		return nil, err
 	}
 
-	// Convert to adapter output
+	inputs := make([]appParticipant.ParticipantInput, 0, len(participants))
+	for i, participant := range participants {
+		inputs = append(inputs, appParticipant.ParticipantInput{
+			Row:            i + 1,
+			MSISDN:         participant.MSISDN,
+			RechargeAmount: participant.RechargeAmount,
+			RechargeDate:   participant.RechargeDate.Format("2006-01-02"),
+		})
+	}
+
+	output, err := service.UploadParticipants(ctx, appParticipant.UploadParticipantsInput{
+		Participants: inputs,
+		UploadedBy:   uploadedBy,
+		FileName:     fileName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toUploadParticipantsOutput(output), nil
+}
+
+// ImportParticipantsFile imports participants from the rows of an uploaded CSV or XLSX file
+func (p *ParticipantServiceAdapter) ImportParticipantsFile(ctx context.Context, rows spreadsheet.RowReader, uploadedBy uuid.UUID, fileName string) (*UploadParticipantsOutput, error) {
+	service, err := p.uploadService()
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := service.ImportParticipantsFile(ctx, appParticipant.ImportParticipantsFileInput{
+		Rows:       rows,
+		UploadedBy: uploadedBy,
+		FileName:   fileName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toUploadParticipantsOutput(output), nil
+}
+
+// uploadService returns the configured upload service
+func (p *ParticipantServiceAdapter) uploadService() (*appParticipant.UploadParticipantsService, error) {
+	service, ok := p.uploadParticipantsService.(*appParticipant.UploadParticipantsService)
+	if !ok || service == nil {
+		return nil, errors.New("upload participants service is not configured")
+	}
+	return service, nil
+}
+
+// toUploadParticipantsOutput converts the application output to the adapter output
+func toUploadParticipantsOutput(output *appParticipant.UploadParticipantsOutput) *UploadParticipantsOutput {
 	return &UploadParticipantsOutput{
-		ID:             output.ID,
-		FileName:       output.FileName,
-		UploadDate:     output.UploadDate,
-		RecordCount:    output.RecordCount,
-		Status:         output.Status,
-		ErrorMessage:   output.ErrorMessage,
-		ProcessingTime: output.ProcessingTime,
-	}, nil
+		ID:                   output.ID,
+		FileName:             output.FileName,
+		UploadDate:           output.UploadDate,
+		RecordCount:          output.RecordCount,
+		SuccessfullyImported: output.SuccessfullyImported,
+		DuplicatesSkipped:    output.DuplicatesSkipped,
+		ErrorsEncountered:    output.ErrorsEncountered,
+		RowErrors:            output.RowErrors,
+		Status:               output.Status,
+		ErrorMessage:         output.ErrorMessage,
+		ProcessingTime:       output.ProcessingTime,
+	}
 }
 
 // DeleteUpload deletes an upload
@@ -58,6 +58,8 @@ func (p *PrizeServiceAdapter) CreatePrizeStructure(
 			Value:             p.Value,
 			Quantity:          p.Quantity,
 			NumberOfRunnerUps: p.NumberOfRunnerUps,
+			ClaimWindowHours:  p.ClaimWindowHours,
+			NotificationTemplate: p.NotificationTemplate,
 		})
 	}
 
@@ -80,6 +82,8 @@ func (p *PrizeServiceAdapter) CreatePrizeStructure(
 			Value:             p.Value,
 			Quantity:          p.Quantity,
 			NumberOfRunnerUps: p.NumberOfRunnerUps,
+			ClaimWindowHours:  p.ClaimWindowHours,
+			NotificationTemplate: p.NotificationTemplate,
 		})
 	}
 
@@ -100,6 +104,8 @@ func (p *PrizeServiceAdapter) CreatePrizeStructure(
 			Value:             p.Value,
 			Quantity:          p.Quantity,
 			NumberOfRunnerUps: p.NumberOfRunnerUps,
+			ClaimWindowHours:  p.ClaimWindowHours,
+			NotificationTemplate: p.NotificationTemplate,
 		})
 	}
 
@@ -147,6 +153,8 @@ func (p *PrizeServiceAdapter) GetPrizeStructure(
 			Value:             p.Value,
 			Quantity:          p.Quantity,
 			NumberOfRunnerUps: p.NumberOfRunnerUps,
+			ClaimWindowHours:  p.ClaimWindowHours,
+			NotificationTemplate: p.NotificationTemplate,
 		})
 	}
 
@@ -198,6 +206,8 @@ func (p *PrizeServiceAdapter) ListPrizeStructures(
 				Value:             p.Value,
 				Quantity:          p.Quantity,
 				NumberOfRunnerUps: p.NumberOfRunnerUps,
+				ClaimWindowHours:  p.ClaimWindowHours,
+				NotificationTemplate: p.NotificationTemplate,
 			})
 		}
 
@@ -249,6 +259,8 @@ func (p *PrizeServiceAdapter) UpdatePrizeStructure(
 			Value:             p.Value,
 			Quantity:          p.Quantity,
 			NumberOfRunnerUps: p.NumberOfRunnerUps,
+			ClaimWindowHours:  p.ClaimWindowHours,
+			NotificationTemplate: p.NotificationTemplate,
 		})
 	}
 
@@ -281,6 +293,8 @@ func (p *PrizeServiceAdapter) UpdatePrizeStructure(
 			Value:             p.Value,
 			Quantity:          p.Quantity,
 			NumberOfRunnerUps: p.NumberOfRunnerUps,
+			ClaimWindowHours:  p.ClaimWindowHours,
+			NotificationTemplate: p.NotificationTemplate,
 		})
 	}
 
@@ -29,6 +29,13 @@ func NewSetupAdapter(
 	invokeRunnerUpService *draw.InvokeRunnerUpService,
 	updateWinnerService *draw.UpdateWinnerPaymentStatusService,
 	listWinnersService *draw.ListWinnersService,
+	verifyDrawService *draw.VerifyDrawService,
+	scheduleDrawService *draw.ScheduleDrawService,
+	voidDrawService *draw.VoidDrawService,
+	getReplacementHistoryService *draw.GetReplacementHistoryService,
+	confirmWinnerClaimService *draw.ConfirmWinnerClaimService,
+	getWinnerPaymentHistoryService *draw.GetWinnerPaymentHistoryService,
+	reconcileWinnerPaymentsService *draw.ReconcileWinnerPaymentsService,
 
 	// Audit services
 	auditService *audit.AuditService,
@@ -64,6 +71,13 @@ func NewSetupAdapter(
 		invokeRunnerUpService,
 		updateWinnerService,
 		listWinnersService,
+		verifyDrawService,
+		scheduleDrawService,
+		voidDrawService,
+		getReplacementHistoryService,
+		confirmWinnerClaimService,
+		getWinnerPaymentHistoryService,
+		reconcileWinnerPaymentsService,
 	)
 
 	// Create audit adapter
@@ -0,0 +1,121 @@
+package blacklist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/blacklist"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/participant"
+)
+
+// CreateBlacklistEntryService provides functionality for blacklisting an MSISDN
+type CreateBlacklistEntryService struct {
+	blacklistRepository blacklist.BlacklistRepository
+	auditService        audit.AuditService
+}
+
+// NewCreateBlacklistEntryService creates a new CreateBlacklistEntryService
+func NewCreateBlacklistEntryService(
+	blacklistRepository blacklist.BlacklistRepository,
+	auditService audit.AuditService,
+) *CreateBlacklistEntryService {
+	return &CreateBlacklistEntryService{
+		blacklistRepository: blacklistRepository,
+		auditService:        auditService,
+	}
+}
+
+// CreateBlacklistEntryInput defines the input for the CreateBlacklistEntry use case
+type CreateBlacklistEntryInput struct {
+	MSISDN    string
+	Reason    string
+	ExpiresAt *time.Time // Optional, the entry is permanent when nil
+	CreatedBy uuid.UUID
+}
+
+// CreateBlacklistEntry blacklists an MSISDN
+func (s *CreateBlacklistEntryService) CreateBlacklistEntry(ctx context.Context, input CreateBlacklistEntryInput) (*blacklist.BlacklistEntry, error) {
+	if input.CreatedBy == uuid.Nil {
+		return nil, errors.New("created by is required")
+	}
+
+	now := time.Now()
+	msisdn, err := validateEntry(input.MSISDN, input.Reason, input.ExpiresAt, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// An MSISDN can only be blacklisted once, existing entries are updated instead
+	existing, err := s.blacklistRepository.GetByMSISDN(msisdn)
+	if err != nil {
+		var blacklistErr *blacklist.BlacklistError
+		if !errors.As(err, &blacklistErr) || blacklistErr.Code != blacklist.ErrEntryNotFound {
+			return nil, fmt.Errorf("failed to check blacklist: %w", err)
+		}
+	}
+	if existing != nil {
+		return nil, blacklist.NewBlacklistError(blacklist.ErrEntryAlreadyExists, "MSISDN is already blacklisted", nil)
+	}
+
+	entry := &blacklist.BlacklistEntry{
+		ID:        uuid.New(),
+		MSISDN:    msisdn,
+		Reason:    strings.TrimSpace(input.Reason),
+		ExpiresAt: input.ExpiresAt,
+		CreatedBy: input.CreatedBy,
+		UpdatedBy: input.CreatedBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := s.blacklistRepository.Create(entry); err != nil {
+		return nil, fmt.Errorf("failed to create blacklist entry: %w", err)
+	}
+
+	// Log audit
+	if err := s.auditService.LogAudit(
+		"CREATE_BLACKLIST_ENTRY",
+		"BlacklistEntry",
+		entry.ID,
+		input.CreatedBy,
+		fmt.Sprintf("MSISDN blacklisted: %s", entry.MSISDN),
+		fmt.Sprintf("Reason: %s, Expires: %s", entry.Reason, formatExpiry(entry.ExpiresAt)),
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
+	return entry, nil
+}
+
+// validateEntry validates the fields of a blacklist entry and returns the normalized MSISDN
+func validateEntry(msisdn, reason string, expiresAt *time.Time, now time.Time) (string, error) {
+	msisdn = participant.NormalizeMSISDN(msisdn)
+	if err := participant.ValidateMSISDN(msisdn); err != nil {
+		return "", blacklist.NewBlacklistError(blacklist.ErrInvalidEntry, err.Error(), nil)
+	}
+
+	if err := blacklist.ValidateReason(reason); err != nil {
+		return "", blacklist.NewBlacklistError(blacklist.ErrInvalidEntry, err.Error(), nil)
+	}
+
+	if err := blacklist.ValidateExpiry(expiresAt, now); err != nil {
+		return "", blacklist.NewBlacklistError(blacklist.ErrInvalidEntry, err.Error(), nil)
+	}
+
+	return msisdn, nil
+}
+
+// formatExpiry formats an optional expiry for audit logs
+func formatExpiry(expiresAt *time.Time) string {
+	if expiresAt == nil {
+		return "never"
+	}
+	return expiresAt.Format(time.RFC3339)
+}
@@ -0,0 +1,70 @@
+package blacklist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/blacklist"
+)
+
+// DeleteBlacklistEntryService provides functionality for removing an MSISDN from the blacklist
+type DeleteBlacklistEntryService struct {
+	blacklistRepository blacklist.BlacklistRepository
+	auditService        audit.AuditService
+}
+
+// NewDeleteBlacklistEntryService creates a new DeleteBlacklistEntryService
+func NewDeleteBlacklistEntryService(
+	blacklistRepository blacklist.BlacklistRepository,
+	auditService audit.AuditService,
+) *DeleteBlacklistEntryService {
+	return &DeleteBlacklistEntryService{
+		blacklistRepository: blacklistRepository,
+		auditService:        auditService,
+	}
+}
+
+// DeleteBlacklistEntryInput defines the input for the DeleteBlacklistEntry use case
+type DeleteBlacklistEntryInput struct {
+	ID        uuid.UUID
+	DeletedBy uuid.UUID
+}
+
+// DeleteBlacklistEntry removes a blacklist entry
+func (s *DeleteBlacklistEntryService) DeleteBlacklistEntry(ctx context.Context, input DeleteBlacklistEntryInput) error {
+	if input.ID == uuid.Nil {
+		return errors.New("blacklist entry ID is required")
+	}
+
+	if input.DeletedBy == uuid.Nil {
+		return errors.New("deleted by is required")
+	}
+
+	entry, err := s.blacklistRepository.GetByID(input.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.blacklistRepository.Delete(entry.ID); err != nil {
+		return err
+	}
+
+	// Log audit
+	if err := s.auditService.LogAudit(
+		"DELETE_BLACKLIST_ENTRY",
+		"BlacklistEntry",
+		entry.ID,
+		input.DeletedBy,
+		fmt.Sprintf("MSISDN removed from blacklist: %s", entry.MSISDN),
+		fmt.Sprintf("Reason: %s, Expires: %s", entry.Reason, formatExpiry(entry.ExpiresAt)),
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package blacklist
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/blacklist"
+)
+
+// GetBlacklistEntryService provides functionality for retrieving a blacklist entry
+type GetBlacklistEntryService struct {
+	blacklistRepository blacklist.BlacklistRepository
+}
+
+// NewGetBlacklistEntryService creates a new GetBlacklistEntryService
+func NewGetBlacklistEntryService(blacklistRepository blacklist.BlacklistRepository) *GetBlacklistEntryService {
+	return &GetBlacklistEntryService{
+		blacklistRepository: blacklistRepository,
+	}
+}
+
+// GetBlacklistEntry retrieves a blacklist entry by ID
+func (s *GetBlacklistEntryService) GetBlacklistEntry(ctx context.Context, id uuid.UUID) (*blacklist.BlacklistEntry, error) {
+	if id == uuid.Nil {
+		return nil, errors.New("blacklist entry ID is required")
+	}
+
+	return s.blacklistRepository.GetByID(id)
+}
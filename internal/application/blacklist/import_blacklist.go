@@ -0,0 +1,266 @@
+package blacklist
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/blacklist"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/spreadsheet"
+)
+
+// importBatchSize is the number of entries written per Upsert call
+const importBatchSize = 1000
+
+// Canonical import columns
+const (
+	columnMSISDN    = "msisdn"
+	columnReason    = "reason"
+	columnExpiresAt = "expires at"
+)
+
+// importColumnAliases maps normalized header names to canonical import columns
+var importColumnAliases = map[string]string{
+	"msisdn":        columnMSISDN,
+	"phone":         columnMSISDN,
+	"phone number":  columnMSISDN,
+	"mobile":        columnMSISDN,
+	"mobile number": columnMSISDN,
+	"reason":        columnReason,
+	"expires at":    columnExpiresAt,
+	"expiresat":     columnExpiresAt,
+	"expires":       columnExpiresAt,
+	"expiry":        columnExpiresAt,
+	"expiry date":   columnExpiresAt,
+}
+
+// expiryLayouts are the expiry formats accepted in imported files
+var expiryLayouts = []string{
+	"2006-01-02",
+	"2006-01-02 15:04:05",
+	time.RFC3339,
+}
+
+// ImportBlacklistService provides functionality for bulk blacklisting MSISDNs from a file
+type ImportBlacklistService struct {
+	blacklistRepository blacklist.BlacklistRepository
+	auditService        audit.AuditService
+}
+
+// NewImportBlacklistService creates a new ImportBlacklistService
+func NewImportBlacklistService(
+	blacklistRepository blacklist.BlacklistRepository,
+	auditService audit.AuditService,
+) *ImportBlacklistService {
+	return &ImportBlacklistService{
+		blacklistRepository: blacklistRepository,
+		auditService:        auditService,
+	}
+}
+
+// ImportBlacklistInput defines the input for the ImportBlacklist use case
+type ImportBlacklistInput struct {
+	Rows          spreadsheet.RowReader
+	DefaultReason string // Used for rows without a reason
+	ImportedBy    uuid.UUID
+	FileName      string
+}
+
+// ImportRowError describes why a single imported row was rejected
+type ImportRowError struct {
+	Row    int    `json:"row"`
+	MSISDN string `json:"msisdn"`
+	Reason string `json:"reason"`
+}
+
+// ImportBlacklistOutput defines the output for the ImportBlacklist use case
+type ImportBlacklistOutput struct {
+	TotalRows int              `json:"totalRows"`
+	Imported  int              `json:"imported"`
+	RowErrors []ImportRowError `json:"rowErrors"`
+}
+
+// ImportBlacklist reads MSISDN, reason and optional expiry columns from a CSV or XLSX file.
+// MSISDNs that are already blacklisted have their reason and expiry replaced.
+func (s *ImportBlacklistService) ImportBlacklist(ctx context.Context, input ImportBlacklistInput) (*ImportBlacklistOutput, error) {
+	if input.Rows == nil {
+		return nil, errors.New("file rows are required")
+	}
+
+	if input.ImportedBy == uuid.Nil {
+		return nil, errors.New("imported by is required")
+	}
+
+	header, err := input.Rows.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, blacklist.NewBlacklistError(blacklist.ErrInvalidImportFile, "File is empty", nil)
+		}
+		return nil, blacklist.NewBlacklistError(blacklist.ErrInvalidImportFile, "Failed to read header row", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		if canonical, ok := importColumnAliases[spreadsheet.NormalizeHeader(name)]; ok {
+			if _, exists := columns[canonical]; !exists {
+				columns[canonical] = i
+			}
+		}
+	}
+	if _, ok := columns[columnMSISDN]; !ok {
+		return nil, blacklist.NewBlacklistError(blacklist.ErrInvalidImportFile, "Missing required column: msisdn", nil)
+	}
+
+	now := time.Now()
+	output := &ImportBlacklistOutput{
+		RowErrors: make([]ImportRowError, 0),
+	}
+	seen := make(map[string]bool)
+	batch := make([]*blacklist.BlacklistEntry, 0, importBatchSize)
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := s.blacklistRepository.Upsert(batch); err != nil {
+			return err
+		}
+		output.Imported += len(batch)
+		batch = batch[:0]
+		return nil
+	}
+
+	reject := func(row int, msisdn, reason string) {
+		output.RowErrors = append(output.RowErrors, ImportRowError{Row: row, MSISDN: msisdn, Reason: reason})
+	}
+
+	// Header is row 1, data starts on row 2
+	for rowNumber := 2; ; rowNumber++ {
+		row, err := input.Rows.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				output.TotalRows++
+				reject(rowNumber, "", fmt.Sprintf("malformed row: %v", parseErr.Err))
+				continue
+			}
+			return nil, blacklist.NewBlacklistError(blacklist.ErrInvalidImportFile, fmt.Sprintf("Failed to read row %d", rowNumber), err)
+		}
+
+		if isBlankRow(row) {
+			continue
+		}
+		output.TotalRows++
+
+		rawMSISDN := cell(row, columns, columnMSISDN)
+		reason := cell(row, columns, columnReason)
+		if reason == "" {
+			reason = input.DefaultReason
+		}
+
+		var expiresAt *time.Time
+		if value := cell(row, columns, columnExpiresAt); value != "" {
+			expiry, err := parseExpiry(value)
+			if err != nil {
+				reject(rowNumber, rawMSISDN, err.Error())
+				continue
+			}
+			expiresAt = &expiry
+		}
+
+		msisdn, err := validateEntry(rawMSISDN, reason, expiresAt, now)
+		if err != nil {
+			var blacklistErr *blacklist.BlacklistError
+			if errors.As(err, &blacklistErr) {
+				reject(rowNumber, rawMSISDN, blacklistErr.Message)
+				continue
+			}
+			return nil, err
+		}
+
+		if seen[msisdn] {
+			reject(rowNumber, msisdn, "MSISDN appears more than once in the file")
+			continue
+		}
+		seen[msisdn] = true
+
+		batch = append(batch, &blacklist.BlacklistEntry{
+			ID:        uuid.New(),
+			MSISDN:    msisdn,
+			Reason:    strings.TrimSpace(reason),
+			ExpiresAt: expiresAt,
+			CreatedBy: input.ImportedBy,
+			UpdatedBy: input.ImportedBy,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+
+		if len(batch) >= importBatchSize {
+			if err := flush(); err != nil {
+				return nil, fmt.Errorf("failed to import blacklist entries: %w", err)
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return nil, fmt.Errorf("failed to import blacklist entries: %w", err)
+	}
+
+	if output.TotalRows == 0 {
+		return nil, blacklist.NewBlacklistError(blacklist.ErrInvalidImportFile, "File contains no data rows", nil)
+	}
+
+	// Log audit
+	if err := s.auditService.LogAudit(
+		"IMPORT_BLACKLIST",
+		"BlacklistEntry",
+		uuid.New(),
+		input.ImportedBy,
+		fmt.Sprintf("MSISDNs blacklisted from file: %d", output.Imported),
+		fmt.Sprintf("File: %s, Rows: %d, Errors: %d", input.FileName, output.TotalRows, len(output.RowErrors)),
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
+	return output, nil
+}
+
+// parseExpiry parses an expiry in any of the accepted layouts
+func parseExpiry(value string) (time.Time, error) {
+	for _, layout := range expiryLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid expiry %q, expected YYYY-MM-DD", value)
+}
+
+// cell returns the trimmed value of a column, or an empty string when the column or cell is missing
+func cell(row []string, columns map[string]int, column string) string {
+	index, ok := columns[column]
+	if !ok || index >= len(row) {
+		return ""
+	}
+	return strings.TrimSpace(row[index])
+}
+
+// isBlankRow reports whether every cell in the row is empty
+func isBlankRow(row []string) bool {
+	for _, value := range row {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,197 @@
+package blacklist_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	blacklistApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/blacklist"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/blacklist"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/spreadsheet"
+)
+
+// fakeBlacklistRepository keeps entries in memory, one per MSISDN
+type fakeBlacklistRepository struct {
+	blacklist.BlacklistRepository
+	entries map[string]blacklist.BlacklistEntry
+}
+
+func newFakeBlacklistRepository(entries ...blacklist.BlacklistEntry) *fakeBlacklistRepository {
+	repo := &fakeBlacklistRepository{entries: map[string]blacklist.BlacklistEntry{}}
+	for _, entry := range entries {
+		repo.entries[entry.MSISDN] = entry
+	}
+	return repo
+}
+
+func (r *fakeBlacklistRepository) Create(entry *blacklist.BlacklistEntry) error {
+	r.entries[entry.MSISDN] = *entry
+	return nil
+}
+
+func (r *fakeBlacklistRepository) GetByMSISDN(msisdn string) (*blacklist.BlacklistEntry, error) {
+	entry, ok := r.entries[msisdn]
+	if !ok {
+		return nil, blacklist.NewBlacklistError(blacklist.ErrEntryNotFound, "Blacklist entry not found", nil)
+	}
+	return &entry, nil
+}
+
+func (r *fakeBlacklistRepository) Upsert(entries []*blacklist.BlacklistEntry) error {
+	for _, entry := range entries {
+		r.entries[entry.MSISDN] = *entry
+	}
+	return nil
+}
+
+func (r *fakeBlacklistRepository) ListActive(at time.Time) ([]blacklist.BlacklistEntry, error) {
+	active := make([]blacklist.BlacklistEntry, 0, len(r.entries))
+	for _, entry := range r.entries {
+		if entry.IsActive(at) {
+			active = append(active, entry)
+		}
+	}
+	return active, nil
+}
+
+// fakeAuditService records the actions audited
+type fakeAuditService struct {
+	actions []string
+}
+
+func (s *fakeAuditService) LogAudit(action, entityType string, entityID uuid.UUID, userID uuid.UUID, summary, details string) error {
+	s.actions = append(s.actions, action)
+	return nil
+}
+
+func importCSV(service *blacklistApp.ImportBlacklistService, content string) (*blacklistApp.ImportBlacklistOutput, error) {
+	return service.ImportBlacklist(context.Background(), blacklistApp.ImportBlacklistInput{
+		Rows:          spreadsheet.NewCSVReader(strings.NewReader(content)),
+		DefaultReason: "Fraud review",
+		ImportedBy:    uuid.New(),
+		FileName:      "blacklist.csv",
+	})
+}
+
+func TestImportBlacklist(t *testing.T) {
+	repo := newFakeBlacklistRepository(blacklist.BlacklistEntry{
+		ID:     uuid.New(),
+		MSISDN: "2348030000001",
+		Reason: "Old reason",
+	})
+	auditService := &fakeAuditService{}
+	service := blacklistApp.NewImportBlacklistService(repo, auditService)
+
+	output, err := importCSV(service, strings.Join([]string{
+		"Phone Number,Reason,Expiry",
+		"08030000001,Chargeback,2099-01-01",
+		"2348030000002,,",
+		"0803 000 0002,Duplicate,",
+		"12345,Bad number,",
+		"2348030000003,Expired,2001-01-01",
+		"2348030000004,Unreadable,tomorrow",
+		",,",
+	}, "\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, 6, output.TotalRows)
+	assert.Equal(t, 2, output.Imported)
+
+	rejected := map[int]string{}
+	for _, rowErr := range output.RowErrors {
+		rejected[rowErr.Row] = rowErr.Reason
+	}
+	assert.Equal(t, map[int]string{
+		4: "MSISDN appears more than once in the file",
+		5: "MSISDN must be a Nigerian number in the format 234XXXXXXXXXX",
+		6: "expiry must be in the future",
+		7: `invalid expiry "tomorrow", expected YYYY-MM-DD`,
+	}, rejected)
+
+	// An MSISDN already on the blacklist has its reason and expiry replaced
+	replaced := repo.entries["2348030000001"]
+	assert.Equal(t, "Chargeback", replaced.Reason)
+	require.NotNil(t, replaced.ExpiresAt)
+	assert.Equal(t, 2099, replaced.ExpiresAt.Year())
+
+	// Rows without a reason get the default one and never expire
+	defaulted := repo.entries["2348030000002"]
+	assert.Equal(t, "Fraud review", defaulted.Reason)
+	assert.Nil(t, defaulted.ExpiresAt)
+
+	assert.NotContains(t, repo.entries, "2348030000003")
+	assert.Equal(t, []string{"IMPORT_BLACKLIST"}, auditService.actions)
+}
+
+func TestImportBlacklist_RequiresMSISDNColumn(t *testing.T) {
+	service := blacklistApp.NewImportBlacklistService(newFakeBlacklistRepository(), &fakeAuditService{})
+
+	_, err := importCSV(service, "Number,Reason\n2348030000001,Chargeback\n")
+
+	var blacklistErr *blacklist.BlacklistError
+	require.True(t, errors.As(err, &blacklistErr))
+	assert.Equal(t, blacklist.ErrInvalidImportFile, blacklistErr.Code)
+}
+
+func TestCreateBlacklistEntry(t *testing.T) {
+	repo := newFakeBlacklistRepository()
+	auditService := &fakeAuditService{}
+	service := blacklistApp.NewCreateBlacklistEntryService(repo, auditService)
+
+	expiresAt := time.Now().Add(24 * time.Hour)
+	entry, err := service.CreateBlacklistEntry(context.Background(), blacklistApp.CreateBlacklistEntryInput{
+		MSISDN:    "+234 803 000 0001",
+		Reason:    " Chargeback ",
+		ExpiresAt: &expiresAt,
+		CreatedBy: uuid.New(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "2348030000001", entry.MSISDN)
+	assert.Equal(t, "Chargeback", entry.Reason)
+	assert.Equal(t, []string{"CREATE_BLACKLIST_ENTRY"}, auditService.actions)
+
+	tests := []struct {
+		name      string
+		input     blacklistApp.CreateBlacklistEntryInput
+		errorCode string
+	}{
+		{
+			name:      "already blacklisted",
+			input:     blacklistApp.CreateBlacklistEntryInput{MSISDN: "08030000001", Reason: "Again"},
+			errorCode: blacklist.ErrEntryAlreadyExists,
+		},
+		{
+			name:      "invalid MSISDN",
+			input:     blacklistApp.CreateBlacklistEntryInput{MSISDN: "12345", Reason: "Chargeback"},
+			errorCode: blacklist.ErrInvalidEntry,
+		},
+		{
+			name:      "missing reason",
+			input:     blacklistApp.CreateBlacklistEntryInput{MSISDN: "2348030000002", Reason: "  "},
+			errorCode: blacklist.ErrInvalidEntry,
+		},
+		{
+			name:      "expiry in the past",
+			input:     blacklistApp.CreateBlacklistEntryInput{MSISDN: "2348030000002", Reason: "Chargeback", ExpiresAt: &time.Time{}},
+			errorCode: blacklist.ErrInvalidEntry,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.input.CreatedBy = uuid.New()
+			_, err := service.CreateBlacklistEntry(context.Background(), tt.input)
+
+			var blacklistErr *blacklist.BlacklistError
+			require.True(t, errors.As(err, &blacklistErr), "got %v", err)
+			assert.Equal(t, tt.errorCode, blacklistErr.Code)
+		})
+	}
+	assert.Len(t, repo.entries, 1)
+}
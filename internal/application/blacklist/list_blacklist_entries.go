@@ -0,0 +1,71 @@
+package blacklist
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/blacklist"
+)
+
+// ListBlacklistEntriesService provides functionality for listing blacklist entries
+type ListBlacklistEntriesService struct {
+	blacklistRepository blacklist.BlacklistRepository
+}
+
+// NewListBlacklistEntriesService creates a new ListBlacklistEntriesService
+func NewListBlacklistEntriesService(blacklistRepository blacklist.BlacklistRepository) *ListBlacklistEntriesService {
+	return &ListBlacklistEntriesService{
+		blacklistRepository: blacklistRepository,
+	}
+}
+
+// ListBlacklistEntriesInput defines the input for the ListBlacklistEntries use case
+type ListBlacklistEntriesInput struct {
+	Page           int
+	PageSize       int
+	MSISDN         string // Optional, matches part of an MSISDN
+	IncludeExpired bool
+}
+
+// ListBlacklistEntriesOutput defines the output for the ListBlacklistEntries use case
+type ListBlacklistEntriesOutput struct {
+	Entries    []blacklist.BlacklistEntry
+	TotalCount int
+	Page       int
+	PageSize   int
+	TotalPages int
+}
+
+// ListBlacklistEntries retrieves a paginated list of blacklist entries
+func (s *ListBlacklistEntriesService) ListBlacklistEntries(ctx context.Context, input ListBlacklistEntriesInput) (*ListBlacklistEntriesOutput, error) {
+	if input.Page < 1 {
+		input.Page = 1
+	}
+
+	if input.PageSize < 1 {
+		input.PageSize = 10
+	}
+
+	filters := blacklist.BlacklistFilters{
+		MSISDN:         input.MSISDN,
+		IncludeExpired: input.IncludeExpired,
+	}
+
+	entries, totalCount, err := s.blacklistRepository.List(filters, input.Page, input.PageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blacklist entries: %w", err)
+	}
+
+	totalPages := totalCount / input.PageSize
+	if totalCount%input.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListBlacklistEntriesOutput{
+		Entries:    entries,
+		TotalCount: totalCount,
+		Page:       input.Page,
+		PageSize:   input.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
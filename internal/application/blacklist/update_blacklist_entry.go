@@ -0,0 +1,86 @@
+package blacklist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/blacklist"
+)
+
+// UpdateBlacklistEntryService provides functionality for updating a blacklist entry
+type UpdateBlacklistEntryService struct {
+	blacklistRepository blacklist.BlacklistRepository
+	auditService        audit.AuditService
+}
+
+// NewUpdateBlacklistEntryService creates a new UpdateBlacklistEntryService
+func NewUpdateBlacklistEntryService(
+	blacklistRepository blacklist.BlacklistRepository,
+	auditService audit.AuditService,
+) *UpdateBlacklistEntryService {
+	return &UpdateBlacklistEntryService{
+		blacklistRepository: blacklistRepository,
+		auditService:        auditService,
+	}
+}
+
+// UpdateBlacklistEntryInput defines the input for the UpdateBlacklistEntry use case
+type UpdateBlacklistEntryInput struct {
+	ID        uuid.UUID
+	Reason    string
+	ExpiresAt *time.Time // Optional, the entry becomes permanent when nil
+	UpdatedBy uuid.UUID
+}
+
+// UpdateBlacklistEntry changes the reason and expiry of a blacklist entry
+func (s *UpdateBlacklistEntryService) UpdateBlacklistEntry(ctx context.Context, input UpdateBlacklistEntryInput) (*blacklist.BlacklistEntry, error) {
+	if input.ID == uuid.Nil {
+		return nil, errors.New("blacklist entry ID is required")
+	}
+
+	if input.UpdatedBy == uuid.Nil {
+		return nil, errors.New("updated by is required")
+	}
+
+	entry, err := s.blacklistRepository.GetByID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if _, err := validateEntry(entry.MSISDN, input.Reason, input.ExpiresAt, now); err != nil {
+		return nil, err
+	}
+
+	previous := fmt.Sprintf("Reason: %s, Expires: %s", entry.Reason, formatExpiry(entry.ExpiresAt))
+
+	entry.Reason = strings.TrimSpace(input.Reason)
+	entry.ExpiresAt = input.ExpiresAt
+	entry.UpdatedBy = input.UpdatedBy
+	entry.UpdatedAt = now
+
+	if err := s.blacklistRepository.Update(entry); err != nil {
+		return nil, fmt.Errorf("failed to update blacklist entry: %w", err)
+	}
+
+	// Log audit
+	if err := s.auditService.LogAudit(
+		"UPDATE_BLACKLIST_ENTRY",
+		"BlacklistEntry",
+		entry.ID,
+		input.UpdatedBy,
+		fmt.Sprintf("Blacklist entry updated: %s", entry.MSISDN),
+		fmt.Sprintf("Was %s. Now Reason: %s, Expires: %s", previous, entry.Reason, formatExpiry(entry.ExpiresAt)),
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,73 @@
+package draw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+)
+
+// ConfirmWinnerClaimService records that a winner claimed the prize within the claim window
+type ConfirmWinnerClaimService struct {
+	drawRepository draw.DrawRepository
+	auditService   audit.AuditService
+}
+
+// NewConfirmWinnerClaimService creates a new ConfirmWinnerClaimService
+func NewConfirmWinnerClaimService(drawRepository draw.DrawRepository, auditService audit.AuditService) *ConfirmWinnerClaimService {
+	return &ConfirmWinnerClaimService{
+		drawRepository: drawRepository,
+		auditService:   auditService,
+	}
+}
+
+// ConfirmWinnerClaimInput defines the input for the ConfirmWinnerClaim use case
+type ConfirmWinnerClaimInput struct {
+	WinnerID      uuid.UUID
+	ConfirmedByID uuid.UUID
+}
+
+// ConfirmWinnerClaim marks a winner's prize as claimed, which stops it being forfeited
+func (s *ConfirmWinnerClaimService) ConfirmWinnerClaim(ctx context.Context, input ConfirmWinnerClaimInput) (*RunnerUpWinnerOutput, error) {
+	if input.WinnerID == uuid.Nil {
+		return nil, errors.New("winner ID is required")
+	}
+
+	if input.ConfirmedByID == uuid.Nil {
+		return nil, errors.New("confirmed by ID is required")
+	}
+
+	winner, err := s.drawRepository.GetWinnerByID(input.WinnerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get winner: %w", err)
+	}
+
+	if err := winner.ConfirmClaim(time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := s.drawRepository.UpdateWinner(winner); err != nil {
+		return nil, fmt.Errorf("failed to update winner: %w", err)
+	}
+
+	// Log audit
+	if err := s.auditService.LogAudit(
+		"CONFIRM_WINNER_CLAIM",
+		"Winner",
+		winner.ID,
+		input.ConfirmedByID,
+		fmt.Sprintf("Winner %s claimed the prize", winner.MSISDN),
+		fmt.Sprintf("Draw: %s, Prize tier: %s", winner.DrawID, winner.PrizeTierID),
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
+	output := toRunnerUpWinnerOutput(winner)
+	return &output, nil
+}
@@ -0,0 +1,32 @@
+package draw
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+)
+
+// logStatusTransitions writes an audit entry for every draw status change
+func logStatusTransitions(auditService audit.AuditService, userID uuid.UUID, transitions []draw.StatusTransition) {
+	for _, transition := range transitions {
+		details := fmt.Sprintf("From: %s, To: %s", transition.From, transition.To)
+		if transition.Reason != "" {
+			details += ", Reason: " + transition.Reason
+		}
+
+		if err := auditService.LogAudit(
+			"DRAW_STATUS_CHANGED",
+			"Draw",
+			transition.DrawID,
+			userID,
+			fmt.Sprintf("Draw status changed to %s", transition.To),
+			details,
+		); err != nil {
+			// Log error but continue
+			fmt.Printf("Failed to log audit: %v\n", err)
+		}
+	}
+}
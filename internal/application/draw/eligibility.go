@@ -0,0 +1,51 @@
+package draw
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/blacklist"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/participant"
+)
+
+// evaluateEligibility streams the recharge totals of every MSISDN with points on or before the
+// draw date through the eligibility rules. ExecuteDraw and the eligibility stats both use it so
+// that the stats always describe the entries a draw would be executed against.
+// MSISDNs on the blacklist are excluded in addition to those listed in the rules.
+// The recharges are summed by the database, so only the entries of eligible MSISDNs, which the
+// selection needs, are held in memory.
+func evaluateEligibility(
+	drawRepository draw.DrawRepository,
+	participantRepository participant.ParticipantRepository,
+	blacklistRepository blacklist.BlacklistRepository,
+	rules draw.EligibilityRules,
+	date time.Time,
+) (*draw.EligibilityResult, error) {
+	blacklisted, err := blacklistRepository.ListActive(time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blacklist: %w", err)
+	}
+	rules = rules.WithBlacklistedMSISDNs(blacklist.MSISDNs(blacklisted))
+
+	var recentWinners []string
+	if rules.WinCooldownDays > 0 {
+		recentWinners, err = drawRepository.ListWinningMSISDNs(rules.WinCooldownStart(date), date)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get recent winners: %w", err)
+		}
+	}
+
+	evaluator := draw.NewEligibilityEvaluator(rules, date, recentWinners)
+	err = participantRepository.ForEachEligible(date, evaluator.WindowStart(), eligibleParticipantsBatchSize, func(batch []participant.RechargeTotals) error {
+		for _, totals := range batch {
+			evaluator.AddTotals(totals.MSISDN, totals.TotalPoints, totals.WindowPoints)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get eligible participants: %w", err)
+	}
+
+	return evaluator.Result(), nil
+}
@@ -1,23 +1,31 @@
 package draw
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/blacklist"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/participant"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/prize"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
 )
 
+// eligibleParticipantsBatchSize is the number of MSISDNs read per batch when building a draw
+const eligibleParticipantsBatchSize = 5000
+
 // ExecuteDrawService provides functionality for executing draws
 type ExecuteDrawService struct {
 	drawRepository        draw.DrawRepository
 	participantRepository participant.ParticipantRepository
 	prizeRepository       prize.PrizeRepository
+	blacklistRepository   blacklist.BlacklistRepository
+	unitOfWork            draw.UnitOfWork
 	auditService          audit.AuditService
 }
 
@@ -26,12 +34,16 @@ func NewDrawService(
 	drawRepository draw.DrawRepository,
 	participantRepository participant.ParticipantRepository,
 	prizeRepository prize.PrizeRepository,
+	blacklistRepository blacklist.BlacklistRepository,
+	unitOfWork draw.UnitOfWork,
 	auditService audit.AuditService,
 ) *ExecuteDrawService {
 	return &ExecuteDrawService{
 		drawRepository:        drawRepository,
 		participantRepository: participantRepository,
 		prizeRepository:       prizeRepository,
+		blacklistRepository:   blacklistRepository,
+		unitOfWork:            unitOfWork,
 		auditService:          auditService,
 	}
 }
@@ -49,16 +61,29 @@ type ExecuteDrawOutput struct {
 	DrawDate            time.Time
 	TotalEligibleMSISDNs int
 	TotalEntries        int
+	SeedHash            string
 	Winners             []WinnerOutput
+	RunnerUps           []TierRunnerUpsOutput
 }
 
 // WinnerOutput defines the winner output structure
 type WinnerOutput struct {
-	ID          uuid.UUID
-	MSISDN      string
-	PrizeTierID uuid.UUID
-	PrizeName   string
-	PrizeValue  float64
+	ID           uuid.UUID
+	MSISDN       string
+	PrizeTierID  uuid.UUID
+	PrizeName    string
+	PrizeValue   float64
+	IsRunnerUp   bool
+	RunnerUpRank int
+}
+
+// TierRunnerUpsOutput reports how many runner-ups a prize tier asked for and how many
+// could be drawn once its winners and the higher tiers had been selected
+type TierRunnerUpsOutput struct {
+	PrizeTierID        uuid.UUID
+	PrizeName          string
+	RunnerUpsRequested int
+	RunnerUpsAvailable int
 }
 
 // ExecuteDraw executes a draw for the given date and prize structure
@@ -82,8 +107,8 @@ func (uc *ExecuteDrawService) ExecuteDraw(input ExecuteDrawInput) (*ExecuteDrawO
 		return nil, fmt.Errorf("failed to check for existing draw: %w", err)
 	}
 	
-	if existingDraw != nil {
-		return nil, draw.NewDrawError(draw.ErrDrawAlreadyExists, "Draw already exists for this date", nil)
+	if err := checkExistingDraw(existingDraw, input.PrizeStructureID); err != nil {
+		return nil, err
 	}
 	
 	// Get prize structure
@@ -92,67 +117,173 @@ func (uc *ExecuteDrawService) ExecuteDraw(input ExecuteDrawInput) (*ExecuteDrawO
 		return nil, fmt.Errorf("failed to get prize structure: %w", err)
 	}
 	
-	// Get eligible participants
-	eligibleParticipants, err := uc.getEligibleParticipants(input.DrawDate)
+	// Apply the prize structure's eligibility rules to every participant for the date
+	eligibility, err := evaluateEligibility(uc.drawRepository, uc.participantRepository, uc.blacklistRepository, prizeStructure.EligibilityRules, input.DrawDate)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get eligible participants: %w", err)
+		return nil, err
+	}
+	
+	if err := uc.checkEligibilityConsistency(input.DrawDate, eligibility); err != nil {
+		uc.recordFailedPendingDraw(existingDraw, input.ExecutedByAdminID, err)
+		return nil, err
 	}
 	
-	if len(eligibleParticipants) == 0 {
-		return nil, draw.NewDrawError(draw.ErrNoEligibleParticipants, "No eligible participants for draw", nil)
+	entries := eligibility.Entries
+	totalEntries := eligibility.TotalEntries
+	if len(entries) == 0 {
+		err := draw.NewDrawError(draw.ErrNoEligibleParticipants, "No eligible participants for draw", nil)
+		uc.recordFailedPendingDraw(existingDraw, input.ExecutedByAdminID, err)
+		return nil, err
 	}
 	
-	// Calculate total entries
-	totalEntries := 0
-	for _, participant := range eligibleParticipants {
-		totalEntries += participant.Points
+	// Commit to the seed before anything is selected
+	seed, err := GenerateSeed()
+	if err != nil {
+		return nil, err
 	}
 	
-	// Create draw
-	drawID := uuid.New()
+	// Create draw. A scheduled or failed draw is executed in place, a voided one is
+	// replaced by a new draw and marked as redrawn.
+	now := time.Now()
 	newDraw := &draw.Draw{
-		ID:                   drawID,
+		ID:                   uuid.New(),
 		DrawDate:             input.DrawDate,
 		PrizeStructureID:     input.PrizeStructureID,
-		Status:               "Pending",
-		TotalEligibleMSISDNs: len(eligibleParticipants),
+		Status:               draw.StatusInProgress,
+		TotalEligibleMSISDNs: len(entries),
 		TotalEntries:         totalEntries,
 		ExecutedByAdminID:    input.ExecutedByAdminID,
-		CreatedAt:            time.Now(),
-		UpdatedAt:            time.Now(),
+		AlgorithmVersion:     CurrentSelectionAlgorithm,
+		SeedHash:             HashSeed(seed),
+		EntriesHash:          HashEntries(entries),
+		SelectionPlan:        BuildSelectionPlan(prizeStructure.Prizes),
+		CreatedAt:            now,
+		UpdatedAt:            now,
 	}
 	
-	if err := uc.drawRepository.Create(newDraw); err != nil {
-		return nil, fmt.Errorf("failed to create draw: %w", err)
+	executeInPlace := existingDraw != nil && existingDraw.Status != draw.StatusVoided
+	if executeInPlace {
+		newDraw.ID = existingDraw.ID
+		newDraw.Status = existingDraw.Status
+		newDraw.CreatedAt = existingDraw.CreatedAt
 	}
+	drawID := newDraw.ID
+	
+	// Commit the seed hash before anything is selected. Once the draw is in progress it can
+	// only complete or fail, so it cannot be executed again with other seeds meanwhile.
+	var transitions []draw.StatusTransition
+	err = uc.unitOfWork.Do(func(drawRepository draw.DrawRepository) error {
+		transitions = nil
+		
+		// Check again inside the transaction in case another draw for the date started
+		// meanwhile. The date stays locked until the transaction ends, so no other draw for
+		// it can be written between the check and the writes below.
+		if err := drawRepository.LockDate(input.DrawDate); err != nil {
+			return err
+		}
+		
+		currentDraw, err := drawRepository.GetByDate(input.DrawDate)
+		if err != nil {
+			return fmt.Errorf("failed to check for existing draw: %w", err)
+		}
+		
+		if !sameDrawState(currentDraw, existingDraw) {
+			return draw.NewDrawError(draw.ErrDrawAlreadyExists, "Draw for this date changed while it was being executed", nil)
+		}
+		
+		if executeInPlace {
+			transition, err := newDraw.TransitionTo(draw.StatusInProgress, time.Now())
+			if err != nil {
+				return err
+			}
+			transitions = append(transitions, transition)
+			
+			if err := drawRepository.Update(newDraw); err != nil {
+				return fmt.Errorf("failed to update draw status: %w", err)
+			}
+			return nil
+		}
+		
+		if err := drawRepository.Create(newDraw); err != nil {
+			return fmt.Errorf("failed to create draw: %w", err)
+		}
+		
+		if existingDraw != nil {
+			transition, err := existingDraw.MarkRedrawn(drawID, time.Now())
+			if err != nil {
+				return err
+			}
+			transitions = append(transitions, transition)
+			
+			if err := drawRepository.Update(existingDraw); err != nil {
+				return fmt.Errorf("failed to update voided draw: %w", err)
+			}
+		}
+		
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	
+	logStatusTransitions(uc.auditService, input.ExecutedByAdminID, transitions)
 	
 	// Execute draw algorithm
-	winners, err := uc.executeDrawAlgorithm(newDraw, prizeStructure, eligibleParticipants)
+	winners, err := uc.executeDrawAlgorithm(newDraw, seed, entries, prizeStructure.Prizes)
 	if err != nil {
-		// Update draw status to failed
-		newDraw.Status = "Failed"
-		if updateErr := uc.drawRepository.Update(newDraw); updateErr != nil {
-			// Log error but continue with original error
-			fmt.Printf("Failed to update draw status: %v\n", updateErr)
+		err = fmt.Errorf("failed to execute draw algorithm: %w", err)
+		uc.recordFailedDraw(drawID, input.ExecutedByAdminID, err, draw.StatusInProgress)
+		return nil, err
+	}
+	
+	// Persist the entries and winners and reveal the seed in one transaction, so that a failure
+	// at any point leaves the draw in progress with nothing selected, and it is marked as failed
+	transitions = nil
+	err = uc.unitOfWork.Do(func(drawRepository draw.DrawRepository) error {
+		transitions = nil
+		
+		currentDraw, err := drawRepository.GetByIDForUpdate(drawID)
+		if err != nil {
+			return err
+		}
+		if currentDraw.Status != draw.StatusInProgress || currentDraw.SeedHash != newDraw.SeedHash {
+			return draw.NewDrawError(draw.ErrDrawAlreadyExists, "Draw for this date changed while it was being executed", nil)
 		}
 		
-		return nil, fmt.Errorf("failed to execute draw algorithm: %w", err)
+		if err := drawRepository.CreateEntries(drawID, entries); err != nil {
+			return fmt.Errorf("failed to store draw entries: %w", err)
+		}
+		
+		// Update draw status to completed and reveal the seed
+		completedDraw := *newDraw
+		transition, err := completedDraw.TransitionTo(draw.StatusCompleted, time.Now())
+		if err != nil {
+			return err
+		}
+		transitions = append(transitions, transition)
+		
+		completedDraw.Seed = hex.EncodeToString(seed)
+		if err := drawRepository.Update(&completedDraw); err != nil {
+			return fmt.Errorf("failed to update draw status: %w", err)
+		}
+		
+		for i := range winners {
+			if err := drawRepository.CreateWinner(&winners[i]); err != nil {
+				return fmt.Errorf("failed to create winner: %w", err)
+			}
+		}
+		
+		*newDraw = completedDraw
+		return nil
+	})
+	if err != nil {
+		uc.recordFailedDraw(drawID, input.ExecutedByAdminID, err, draw.StatusInProgress)
+		return nil, err
 	}
-	
-	// Update draw status to completed
-	newDraw.Status = "Completed"
 	newDraw.Winners = winners
-	if err := uc.drawRepository.Update(newDraw); err != nil {
-		return nil, fmt.Errorf("failed to update draw status: %w", err)
-	}
 	
-	// Create winners
 	winnerOutputs := make([]WinnerOutput, 0, len(winners))
 	for _, winner := range winners {
-		if err := uc.drawRepository.CreateWinner(&winner); err != nil {
-			return nil, fmt.Errorf("failed to create winner: %w", err)
-		}
-		
 		// Find prize tier
 		var prizeName string
 		var prizeValue float64
@@ -165,14 +296,18 @@ func (uc *ExecuteDrawService) ExecuteDraw(input ExecuteDrawInput) (*ExecuteDrawO
 		}
 		
 		winnerOutputs = append(winnerOutputs, WinnerOutput{
-			ID:          winner.ID,
-			MSISDN:      winner.MSISDN,
-			PrizeTierID: winner.PrizeTierID,
-			PrizeName:   prizeName,
-			PrizeValue:  prizeValue,
+			ID:           winner.ID,
+			MSISDN:       winner.MSISDN,
+			PrizeTierID:  winner.PrizeTierID,
+			PrizeName:    prizeName,
+			PrizeValue:   prizeValue,
+			IsRunnerUp:   winner.IsRunnerUp,
+			RunnerUpRank: winner.RunnerUpRank,
 		})
 	}
 	
+	runnerUps := summarizeRunnerUps(newDraw.SelectionPlan, winners, prizeStructure.Prizes)
+	
 	// Log audit
 	if err := uc.auditService.LogAudit(
 		"EXECUTE_DRAW",
@@ -180,148 +315,223 @@ func (uc *ExecuteDrawService) ExecuteDraw(input ExecuteDrawInput) (*ExecuteDrawO
 		drawID,
 		input.ExecutedByAdminID,
 		fmt.Sprintf("Draw executed for date %s", input.DrawDate.Format("2006-01-02")),
-		fmt.Sprintf("Total eligible MSISDNs: %d, Total entries: %d, Winners: %d", 
-			len(eligibleParticipants), totalEntries, len(winners)),
+		fmt.Sprintf("Total eligible MSISDNs: %d, Total entries: %d, Winners: %d, Seed hash: %s, Excluded: %s, Runner-ups: %s", 
+			len(entries), totalEntries, len(winners), newDraw.SeedHash, formatExclusions(eligibility.Exclusions), formatRunnerUps(runnerUps)),
 	); err != nil {
 		// Log error but continue
 		fmt.Printf("Failed to log audit: %v\n", err)
 	}
 	
+	logStatusTransitions(uc.auditService, input.ExecutedByAdminID, transitions)
+	
 	return &ExecuteDrawOutput{
 		DrawID:              drawID,
 		DrawDate:            input.DrawDate,
-		TotalEligibleMSISDNs: len(eligibleParticipants),
+		TotalEligibleMSISDNs: len(entries),
 		TotalEntries:        totalEntries,
+		SeedHash:            newDraw.SeedHash,
 		Winners:             winnerOutputs,
+		RunnerUps:           runnerUps,
 	}, nil
 }
 
-	// getEligibleParticipants retrieves eligible participants for the draw
-func (uc *ExecuteDrawService) getEligibleParticipants(date time.Time) ([]participant.Participant, error) {
-	// This is a simplified implementation
-	// In a real-world scenario, this would involve complex eligibility rules
+// checkExistingDraw rejects a draw date that already has a draw, unless that draw is
+// waiting to be executed or has been voided and may be redrawn. A draw waiting to be
+// executed is executed in place, so it must be executed with the prize structure it was
+// scheduled with.
+func checkExistingDraw(existingDraw *draw.Draw, prizeStructureID uuid.UUID) error {
+	if existingDraw == nil {
+		return nil
+	}
 	
-	// Get all participants with points up to the draw date
-	participants, _, err := uc.participantRepository.ListByDate(date, 1, 1000)
+	switch existingDraw.Status {
+	case draw.StatusVoided:
+		return nil
+	case draw.StatusScheduled, draw.StatusFailed:
+		if existingDraw.PrizeStructureID != prizeStructureID {
+			return draw.NewDrawError(draw.ErrPrizeStructureMismatch, "Draw for this date is scheduled with a different prize structure", nil)
+		}
+		return nil
+	}
+	
+	return draw.NewDrawError(draw.ErrDrawAlreadyExists, "Draw already exists for this date", nil)
+}
+
+// recordFailedPendingDraw marks a scheduled or failed draw for the date as failed when the draw
+// stops before its seed hash is committed. With no draw for the date, or only a voided one,
+// nothing is recorded: a voided draw stays voided, and the new draw is only created once its
+// seed hash is committed, so the caller is told of the failure through the error alone.
+func (uc *ExecuteDrawService) recordFailedPendingDraw(existingDraw *draw.Draw, executedBy uuid.UUID, cause error) {
+	if existingDraw == nil || existingDraw.Status == draw.StatusVoided {
+		return
+	}
+	uc.recordFailedDraw(existingDraw.ID, executedBy, cause, draw.StatusScheduled, draw.StatusFailed)
+}
+
+// recordFailedDraw marks the draw whose execution failed as failed, with the error, so the
+// failure shows on the draw for its date. The draw is left alone unless it is still in one of
+// the given statuses. Nothing was selected; executing the draw again starts afresh with a new
+// seed.
+func (uc *ExecuteDrawService) recordFailedDraw(drawID uuid.UUID, executedBy uuid.UUID, cause error, statuses ...string) {
+	var transition draw.StatusTransition
+	recorded := false
+	err := uc.unitOfWork.Do(func(drawRepository draw.DrawRepository) error {
+		failedDraw, err := drawRepository.GetByIDForUpdate(drawID)
+		if err != nil {
+			return err
+		}
+		if !hasStatus(failedDraw, statuses) {
+			return nil
+		}
+		
+		transition, err = failedDraw.MarkFailed(cause.Error(), time.Now())
+		if err != nil {
+			return err
+		}
+		
+		if err := drawRepository.Update(failedDraw); err != nil {
+			return fmt.Errorf("failed to update draw status: %w", err)
+		}
+		recorded = true
+		return nil
+	})
 	if err != nil {
-		return nil, err
+		fmt.Printf("Failed to record failed draw: %v\n", err)
+		return
 	}
 	
-	return participants, nil
+	if recorded {
+		logStatusTransitions(uc.auditService, executedBy, []draw.StatusTransition{transition})
+	}
+}
+
+// hasStatus reports whether the draw is in one of the given statuses
+func hasStatus(d *draw.Draw, statuses []string) bool {
+	for _, status := range statuses {
+		if d.Status == status {
+			return true
+		}
+	}
+	return false
 }
-// executeDrawAlgorithm implements the draw algorithm
+
+// sameDrawState reports whether two reads of the draw for a date found the same draw in the same status
+func sameDrawState(a, b *draw.Draw) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.ID == b.ID && a.Status == b.Status
+}
+
+// checkEligibilityConsistency fails the draw when the participants the rules were applied to
+// do not match the eligibility stats reported for the same date. The stats are counted in a
+// single query, while the draw's candidates are summed from the pages of the participant stream,
+// so a page that is lost or read twice, or participants uploaded while the draw was being
+// built, stop the draw.
+func (uc *ExecuteDrawService) checkEligibilityConsistency(date time.Time, eligibility *draw.EligibilityResult) error {
+	statsMSISDNs, statsEntries, err := uc.drawRepository.GetEligibilityStats(date)
+	if err != nil {
+		return fmt.Errorf("failed to get eligibility stats: %w", err)
+	}
+	
+	if statsMSISDNs != eligibility.CandidateMSISDNs || statsEntries != eligibility.CandidateEntries {
+		return draw.NewDrawError(
+			draw.ErrEligibilityMismatch,
+			fmt.Sprintf("Eligible participants do not match eligibility stats: draw has %d MSISDNs and %d entries, stats report %d MSISDNs and %d entries",
+				eligibility.CandidateMSISDNs, eligibility.CandidateEntries, statsMSISDNs, statsEntries),
+			nil,
+		)
+	}
+	
+	return nil
+}
+
+// executeDrawAlgorithm implements the draw algorithm. Winners get the claim deadline of their
+// tier; runner-ups get one when they are promoted.
 func (uc *ExecuteDrawService) executeDrawAlgorithm(
 	newDraw *draw.Draw,
-	prizeStructure *prize.PrizeStructure,
-	eligibleParticipants []participant.Participant,
+	seed []byte,
+	entries []draw.Entry,
+	prizeTiers []prize.PrizeTier,
 ) ([]draw.Winner, error) {
-	// This is a simplified implementation of the draw algorithm
-	// In a real-world scenario, this would be more complex
-	
-	// Create a pool of entries based on points
-	entryPool := make([]string, 0, newDraw.TotalEntries)
-	for _, participant := range eligibleParticipants {
-		for i := 0; i < participant.Points; i++ {
-			entryPool = append(entryPool, participant.MSISDN)
-		}
+	selections, err := RunSelection(newDraw.AlgorithmVersion, seed, entries, newDraw.SelectionPlan)
+	if err != nil {
+		return nil, err
 	}
 	
-	// Shuffle the entry pool
-	shuffleEntries(entryPool)
-	
-	// Select winners for each prize tier
-	winners := make([]draw.Winner, 0)
-	selectedMSISDNs := make(map[string]bool)
-	
-	for _, prizeTier := range prizeStructure.Prizes {
-		// For each prize in the tier
-		for i := 0; i < prizeTier.Quantity; i++ {
-			// Find a winner that hasn't been selected yet
-			var winnerMSISDN string
-			for len(entryPool) > 0 {
-				// Pop an entry from the pool
-				winnerMSISDN = entryPool[0]
-				entryPool = entryPool[1:]
-				
-				// Check if this MSISDN has already won
-				if !selectedMSISDNs[winnerMSISDN] {
-					selectedMSISDNs[winnerMSISDN] = true
-					break
-				}
-			}
-			
-			if winnerMSISDN == "" {
-				return nil, errors.New("not enough eligible participants for all prizes")
-			}
-			
-			// Create winner
-			winner := draw.Winner{
-				ID:            uuid.New(),
-				DrawID:        newDraw.ID,
-				MSISDN:        winnerMSISDN,
-				PrizeTierID:   prizeTier.ID,
-				Status:        "PendingNotification",
-				PaymentStatus: "Pending",
-				IsRunnerUp:    false,
-				CreatedAt:     time.Now(),
-				UpdatedAt:     time.Now(),
-			}
-			
-			winners = append(winners, winner)
-		}
-		
-		// Generate runner-ups for this prize tier (50% of winners or at least 1)
-		runnerUpCount := prizeTier.Quantity / 2
-		if runnerUpCount < 1 {
-			runnerUpCount = 1
+	claimWindows := make(map[uuid.UUID]time.Duration, len(prizeTiers))
+	for i := range prizeTiers {
+		claimWindows[prizeTiers[i].ID] = prizeTiers[i].ClaimWindow()
+	}
+	
+	now := time.Now()
+	winners := make([]draw.Winner, 0, len(selections))
+	for _, selection := range selections {
+		var claimDeadline *time.Time
+		if !selection.IsRunnerUp {
+			deadline := now.Add(claimWindows[selection.PrizeTierID])
+			claimDeadline = &deadline
 		}
 		
-		for i := 0; i < runnerUpCount; i++ {
-			// Find a runner-up that hasn't been selected yet
-			var runnerUpMSISDN string
-			for len(entryPool) > 0 {
-				// Pop an entry from the pool
-				runnerUpMSISDN = entryPool[0]
-				entryPool = entryPool[1:]
-				
-				// Check if this MSISDN has already won
-				if !selectedMSISDNs[runnerUpMSISDN] {
-					selectedMSISDNs[runnerUpMSISDN] = true
-					break
-				}
-			}
-			
-			if runnerUpMSISDN == "" {
-				// Not enough participants for runner-ups, but that's okay
-				break
-			}
-			
-			// Create runner-up
-			runnerUp := draw.Winner{
-				ID:            uuid.New(),
-				DrawID:        newDraw.ID,
-				MSISDN:        runnerUpMSISDN,
-				PrizeTierID:   prizeTier.ID,
-				Status:        "PendingNotification",
-				PaymentStatus: "Pending",
-				IsRunnerUp:    true,
-				RunnerUpRank:  i + 1,
-				CreatedAt:     time.Now(),
-				UpdatedAt:     time.Now(),
-			}
-			
-			winners = append(winners, runnerUp)
-		}
+		winners = append(winners, draw.Winner{
+			ID:            uuid.New(),
+			DrawID:        newDraw.ID,
+			MSISDN:        selection.MSISDN,
+			PrizeTierID:   selection.PrizeTierID,
+			Status:        "PendingNotification",
+			PaymentStatus: draw.PaymentStatusPending,
+			IsRunnerUp:    selection.IsRunnerUp,
+			RunnerUpRank:  selection.RunnerUpRank,
+			ClaimDeadline: claimDeadline,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
 	}
 	
 	return winners, nil
 }
 
-// shuffleEntries shuffles the entry pool
-func shuffleEntries(entries []string) {
-	// Fisher-Yates shuffle algorithm
-	for i := len(entries) - 1; i > 0; i-- {
-		j := uuid.New().ID() % uint32(i+1)
-		entries[i], entries[j] = entries[j], entries[i]
+// formatExclusions summarises rule exclusions as "rule=count" pairs for audit logs
+func formatExclusions(exclusions []draw.RuleExclusion) string {
+	parts := make([]string, 0, len(exclusions))
+	for _, exclusion := range exclusions {
+		parts = append(parts, fmt.Sprintf("%s=%d", exclusion.Rule, exclusion.ExcludedMSISDNs))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// summarizeRunnerUps counts the runner-ups drawn for each tier of the selection plan
+func summarizeRunnerUps(plan []draw.TierSelection, winners []draw.Winner, prizeTiers []prize.PrizeTier) []TierRunnerUpsOutput {
+	drawn := make(map[uuid.UUID]int, len(plan))
+	for _, winner := range winners {
+		if winner.IsRunnerUp {
+			drawn[winner.PrizeTierID]++
+		}
+	}
+	
+	prizeNames := make(map[uuid.UUID]string, len(prizeTiers))
+	for _, prizeTier := range prizeTiers {
+		prizeNames[prizeTier.ID] = prizeTier.Name
+	}
+	
+	outputs := make([]TierRunnerUpsOutput, 0, len(plan))
+	for _, tier := range plan {
+		outputs = append(outputs, TierRunnerUpsOutput{
+			PrizeTierID:        tier.PrizeTierID,
+			PrizeName:          prizeNames[tier.PrizeTierID],
+			RunnerUpsRequested: tier.RunnerUps,
+			RunnerUpsAvailable: drawn[tier.PrizeTierID],
+		})
+	}
+	
+	return outputs
+}
+
+// formatRunnerUps summarises runner-ups as "tier=available/requested" pairs for audit logs
+func formatRunnerUps(runnerUps []TierRunnerUpsOutput) string {
+	parts := make([]string, 0, len(runnerUps))
+	for _, tier := range runnerUps {
+		parts = append(parts, fmt.Sprintf("%s=%d/%d", tier.PrizeName, tier.RunnerUpsAvailable, tier.RunnerUpsRequested))
 	}
+	return strings.Join(parts, ", ")
 }
@@ -0,0 +1,411 @@
+package draw_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	drawApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/blacklist"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/participant"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/prize"
+)
+
+// fakeDrawRepository keeps draws, entries and winners in memory and logs every write and
+// commit, along with each version of a draw written
+type fakeDrawRepository struct {
+	draw.DrawRepository
+	draws        map[uuid.UUID]draw.Draw
+	entries      map[uuid.UUID][]draw.Entry
+	winners      []draw.Winner
+	statsMSISDNs int
+	statsEntries int
+	writes       []string
+	saved        []draw.Draw
+	winnerErr    error
+}
+
+func newFakeDrawRepository() *fakeDrawRepository {
+	return &fakeDrawRepository{
+		draws:   map[uuid.UUID]draw.Draw{},
+		entries: map[uuid.UUID][]draw.Entry{},
+	}
+}
+
+func (r *fakeDrawRepository) GetByID(id uuid.UUID) (*draw.Draw, error) {
+	d, ok := r.draws[id]
+	if !ok {
+		return nil, draw.NewDrawError(draw.ErrDrawNotFound, "Draw not found", nil)
+	}
+	return &d, nil
+}
+
+func (r *fakeDrawRepository) GetByIDForUpdate(id uuid.UUID) (*draw.Draw, error) {
+	return r.GetByID(id)
+}
+
+func (r *fakeDrawRepository) GetByDate(date time.Time) (*draw.Draw, error) {
+	var latest *draw.Draw
+	for _, d := range r.draws {
+		if d.DrawDate.Format("2006-01-02") != date.Format("2006-01-02") {
+			continue
+		}
+		if latest == nil || d.CreatedAt.After(latest.CreatedAt) {
+			copied := d
+			latest = &copied
+		}
+	}
+	return latest, nil
+}
+
+func (r *fakeDrawRepository) LockDate(date time.Time) error {
+	return nil
+}
+
+func (r *fakeDrawRepository) Create(d *draw.Draw) error {
+	r.writes = append(r.writes, "Create "+d.Status)
+	r.draws[d.ID] = *d
+	r.saved = append(r.saved, *d)
+	return nil
+}
+
+func (r *fakeDrawRepository) Update(d *draw.Draw) error {
+	r.writes = append(r.writes, "Update "+d.Status)
+	r.draws[d.ID] = *d
+	r.saved = append(r.saved, *d)
+	return nil
+}
+
+func (r *fakeDrawRepository) GetEligibilityStats(date time.Time) (int, int, error) {
+	return r.statsMSISDNs, r.statsEntries, nil
+}
+
+func (r *fakeDrawRepository) CreateEntries(drawID uuid.UUID, entries []draw.Entry) error {
+	r.writes = append(r.writes, "CreateEntries")
+	r.entries[drawID] = entries
+	return nil
+}
+
+func (r *fakeDrawRepository) CreateWinner(w *draw.Winner) error {
+	if r.winnerErr != nil {
+		return r.winnerErr
+	}
+	r.writes = append(r.writes, "CreateWinner")
+	r.winners = append(r.winners, *w)
+	return nil
+}
+
+func (r *fakeDrawRepository) ListWinningMSISDNs(from, to time.Time) ([]string, error) {
+	return nil, nil
+}
+
+// fakeUnitOfWork runs fn against the fake repository, restoring its state when fn fails
+type fakeUnitOfWork struct {
+	drawRepository *fakeDrawRepository
+}
+
+func (u fakeUnitOfWork) Do(fn func(drawRepository draw.DrawRepository) error) error {
+	repo := u.drawRepository
+	draws := make(map[uuid.UUID]draw.Draw, len(repo.draws))
+	for id, d := range repo.draws {
+		draws[id] = d
+	}
+	entries := make(map[uuid.UUID][]draw.Entry, len(repo.entries))
+	for id, e := range repo.entries {
+		entries[id] = e
+	}
+	winners := append([]draw.Winner(nil), repo.winners...)
+
+	if err := fn(repo); err != nil {
+		repo.draws, repo.entries, repo.winners = draws, entries, winners
+		repo.writes = append(repo.writes, "Rollback")
+		return err
+	}
+	repo.writes = append(repo.writes, "Commit")
+	return nil
+}
+
+// fakeParticipantRepository sums recharges per MSISDN and pages them by MSISDN like the
+// database does
+type fakeParticipantRepository struct {
+	participant.ParticipantRepository
+	recharges []participant.Participant
+	pages     int
+}
+
+func (r *fakeParticipantRepository) ForEachEligible(date, windowStart time.Time, batchSize int, fn func(batch []participant.RechargeTotals) error) error {
+	totals := map[string]*participant.RechargeTotals{}
+	for _, p := range r.recharges {
+		if p.Points <= 0 || p.RechargeDate.After(date) {
+			continue
+		}
+		t, ok := totals[p.MSISDN]
+		if !ok {
+			t = &participant.RechargeTotals{MSISDN: p.MSISDN}
+			totals[p.MSISDN] = t
+		}
+		t.TotalPoints += p.Points
+		if !p.RechargeDate.Before(windowStart) {
+			t.WindowPoints += p.Points
+		}
+	}
+
+	msisdns := make([]string, 0, len(totals))
+	for msisdn := range totals {
+		msisdns = append(msisdns, msisdn)
+	}
+	sort.Strings(msisdns)
+
+	for start := 0; start < len(msisdns); start += batchSize {
+		end := min(start+batchSize, len(msisdns))
+		batch := make([]participant.RechargeTotals, 0, end-start)
+		for _, msisdn := range msisdns[start:end] {
+			batch = append(batch, *totals[msisdn])
+		}
+		r.pages++
+		if err := fn(batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type fakePrizeRepository struct {
+	prize.PrizeRepository
+	structures map[uuid.UUID]*prize.PrizeStructure
+}
+
+func (r *fakePrizeRepository) GetPrizeStructureByID(id uuid.UUID) (*prize.PrizeStructure, error) {
+	prizeStructure, ok := r.structures[id]
+	if !ok {
+		return nil, errors.New("prize structure not found")
+	}
+	return prizeStructure, nil
+}
+
+type fakeBlacklistRepository struct {
+	blacklist.BlacklistRepository
+}
+
+func (fakeBlacklistRepository) ListActive(at time.Time) ([]blacklist.BlacklistEntry, error) {
+	return nil, nil
+}
+
+type fakeAuditService struct{}
+
+func (fakeAuditService) LogAudit(action, entityType string, entityID uuid.UUID, userID uuid.UUID, summary, details string) error {
+	return nil
+}
+
+// drawFixture wires an ExecuteDrawService to in-memory repositories holding one prize
+// structure and a recharge for each of the given MSISDNs
+type drawFixture struct {
+	service          *drawApp.ExecuteDrawService
+	drawRepo         *fakeDrawRepository
+	participantRepo  *fakeParticipantRepository
+	prizeStructureID uuid.UUID
+	drawDate         time.Time
+}
+
+func newDrawFixture(msisdns ...string) *drawFixture {
+	drawDate := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	participantRepo := &fakeParticipantRepository{}
+	for i, msisdn := range msisdns {
+		participantRepo.recharges = append(participantRepo.recharges, participant.Participant{
+			ID:           uuid.New(),
+			MSISDN:       msisdn,
+			Points:       i + 1,
+			RechargeDate: drawDate.AddDate(0, 0, -1),
+		})
+	}
+
+	drawRepo := newFakeDrawRepository()
+	drawRepo.statsMSISDNs = len(msisdns)
+	for i := range msisdns {
+		drawRepo.statsEntries += i + 1
+	}
+
+	structureID := uuid.New()
+	prizeRepo := &fakePrizeRepository{structures: map[uuid.UUID]*prize.PrizeStructure{
+		structureID: {
+			ID: structureID,
+			Prizes: []prize.PrizeTier{
+				{ID: uuid.New(), PrizeStructureID: structureID, Rank: 1, Name: "Jackpot", Value: 100000, Quantity: 1, NumberOfRunnerUps: 1},
+			},
+		},
+	}}
+
+	return &drawFixture{
+		service:          drawApp.NewDrawService(drawRepo, participantRepo, prizeRepo, fakeBlacklistRepository{}, fakeUnitOfWork{drawRepository: drawRepo}, fakeAuditService{}),
+		drawRepo:         drawRepo,
+		participantRepo:  participantRepo,
+		prizeStructureID: structureID,
+		drawDate:         drawDate,
+	}
+}
+
+func (f *drawFixture) input() drawApp.ExecuteDrawInput {
+	return drawApp.ExecuteDrawInput{
+		DrawDate:          f.drawDate,
+		PrizeStructureID:  f.prizeStructureID,
+		ExecutedByAdminID: uuid.New(),
+	}
+}
+
+func TestExecuteDraw_DrawsFromEveryEligibleMSISDN(t *testing.T) {
+	fixture := newDrawFixture("2348030000003", "2348030000001", "2348030000002")
+
+	output, err := fixture.service.ExecuteDraw(fixture.input())
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, output.TotalEligibleMSISDNs)
+	assert.Equal(t, 6, output.TotalEntries)
+	assert.Equal(t, []draw.Entry{
+		{MSISDN: "2348030000001", Points: 2},
+		{MSISDN: "2348030000002", Points: 3},
+		{MSISDN: "2348030000003", Points: 1},
+	}, fixture.drawRepo.entries[output.DrawID])
+	assert.Len(t, fixture.drawRepo.winners, 2)
+}
+
+func TestExecuteDraw_FailsWhenEligibleParticipantsDisagreeWithStats(t *testing.T) {
+	fixture := newDrawFixture("2348030000001", "2348030000002")
+	fixture.drawRepo.statsMSISDNs = 3 // A participant the stream did not deliver
+
+	_, err := fixture.service.ExecuteDraw(fixture.input())
+
+	var drawErr *draw.DrawError
+	require.True(t, errors.As(err, &drawErr), "got %v", err)
+	assert.Equal(t, draw.ErrEligibilityMismatch, drawErr.Code)
+	assert.Empty(t, fixture.drawRepo.winners)
+	assert.Empty(t, fixture.drawRepo.entries)
+}
+
+func TestExecuteDraw_CommitsSeedHashBeforeSelection(t *testing.T) {
+	fixture := newDrawFixture("2348030000001", "2348030000002", "2348030000003")
+
+	output, err := fixture.service.ExecuteDraw(fixture.input())
+	require.NoError(t, err)
+
+	// The draw is committed with the seed hash alone; the winners are written in a later
+	// transaction, which reveals the seed
+	assert.Equal(t, []string{
+		"Create " + draw.StatusInProgress, "Commit",
+		"CreateEntries", "Update " + draw.StatusCompleted, "CreateWinner", "CreateWinner", "Commit",
+	}, fixture.drawRepo.writes)
+
+	committed := fixture.drawRepo.saved[0]
+	assert.Equal(t, output.SeedHash, committed.SeedHash)
+	assert.Empty(t, committed.Seed)
+
+	completed := fixture.drawRepo.draws[output.DrawID]
+	seed, err := hex.DecodeString(completed.Seed)
+	require.NoError(t, err)
+	assert.Equal(t, committed.SeedHash, drawApp.HashSeed(seed))
+}
+
+// scheduleDraw stores a draw scheduled for the fixture's date with the given prize structure
+func (f *drawFixture) scheduleDraw(prizeStructureID uuid.UUID) draw.Draw {
+	scheduled := draw.Draw{
+		ID:               uuid.New(),
+		DrawDate:         f.drawDate,
+		PrizeStructureID: prizeStructureID,
+		Status:           draw.StatusScheduled,
+		CreatedAt:        f.drawDate.AddDate(0, 0, -7),
+	}
+	f.drawRepo.draws[scheduled.ID] = scheduled
+	return scheduled
+}
+
+func TestExecuteDraw_RejectsScheduledDrawWithOtherPrizeStructure(t *testing.T) {
+	fixture := newDrawFixture("2348030000001", "2348030000002")
+	scheduled := fixture.scheduleDraw(uuid.New())
+
+	_, err := fixture.service.ExecuteDraw(fixture.input())
+
+	var drawErr *draw.DrawError
+	require.True(t, errors.As(err, &drawErr), "got %v", err)
+	assert.Equal(t, draw.ErrPrizeStructureMismatch, drawErr.Code)
+	assert.Equal(t, scheduled, fixture.drawRepo.draws[scheduled.ID])
+	assert.Empty(t, fixture.drawRepo.writes)
+}
+
+func TestExecuteDraw_ExecutesScheduledDrawInPlace(t *testing.T) {
+	fixture := newDrawFixture("2348030000001", "2348030000002")
+	scheduled := fixture.scheduleDraw(fixture.prizeStructureID)
+
+	output, err := fixture.service.ExecuteDraw(fixture.input())
+	require.NoError(t, err)
+
+	assert.Equal(t, scheduled.ID, output.DrawID)
+	assert.Len(t, fixture.drawRepo.draws, 1)
+	assert.Equal(t, draw.StatusCompleted, fixture.drawRepo.draws[scheduled.ID].Status)
+}
+
+func TestExecuteDraw_MarksScheduledDrawFailedWhenItStopsBeforeSelection(t *testing.T) {
+	tests := []struct {
+		name      string
+		configure func(f *drawFixture)
+		errorCode string
+	}{
+		{
+			name: "no eligible participants",
+			configure: func(f *drawFixture) {
+				f.participantRepo.recharges = nil
+				f.drawRepo.statsMSISDNs, f.drawRepo.statsEntries = 0, 0
+			},
+			errorCode: draw.ErrNoEligibleParticipants,
+		},
+		{
+			name:      "eligibility mismatch",
+			configure: func(f *drawFixture) { f.drawRepo.statsMSISDNs++ },
+			errorCode: draw.ErrEligibilityMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixture := newDrawFixture("2348030000001", "2348030000002")
+			scheduled := fixture.scheduleDraw(fixture.prizeStructureID)
+			tt.configure(fixture)
+
+			_, err := fixture.service.ExecuteDraw(fixture.input())
+
+			var drawErr *draw.DrawError
+			require.True(t, errors.As(err, &drawErr), "got %v", err)
+			assert.Equal(t, tt.errorCode, drawErr.Code)
+
+			failed := fixture.drawRepo.draws[scheduled.ID]
+			assert.Equal(t, draw.StatusFailed, failed.Status)
+			assert.Equal(t, err.Error(), failed.FailureReason)
+			assert.Empty(t, failed.SeedHash)
+			assert.Empty(t, fixture.drawRepo.entries)
+		})
+	}
+}
+
+func TestExecuteDraw_MarksDrawFailedWhenWinnersCannotBeStored(t *testing.T) {
+	fixture := newDrawFixture("2348030000001", "2348030000002")
+	fixture.drawRepo.winnerErr = errors.New("connection reset")
+
+	_, err := fixture.service.ExecuteDraw(fixture.input())
+	require.Error(t, err)
+
+	require.Len(t, fixture.drawRepo.draws, 1)
+	for _, failed := range fixture.drawRepo.draws {
+		assert.Equal(t, draw.StatusFailed, failed.Status)
+		assert.Contains(t, failed.FailureReason, "connection reset")
+		assert.NotEmpty(t, failed.SeedHash)
+		assert.Empty(t, failed.Seed)
+	}
+	assert.Empty(t, fixture.drawRepo.entries)
+	assert.Empty(t, fixture.drawRepo.winners)
+}
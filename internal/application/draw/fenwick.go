@@ -0,0 +1,57 @@
+package draw
+
+// fenwickTree maintains prefix sums over entry weights so that a ticket number
+// can be mapped to its entry, and an entry removed, in O(log n) without
+// materialising one slot per point
+type fenwickTree struct {
+	tree  []uint64 // 1-based binary indexed tree
+	mask  int      // highest power of two <= n, used for descent
+	total uint64
+}
+
+// newFenwickTree builds a tree over the given weights in O(n)
+func newFenwickTree(weights []uint64) *fenwickTree {
+	n := len(weights)
+	tree := make([]uint64, n+1)
+	var total uint64
+	for i, w := range weights {
+		tree[i+1] += w
+		total += w
+		if parent := (i + 1) + ((i + 1) & -(i + 1)); parent <= n {
+			tree[parent] += tree[i+1]
+		}
+	}
+
+	mask := 1
+	for mask*2 <= n {
+		mask *= 2
+	}
+
+	return &fenwickTree{
+		tree:  tree,
+		mask:  mask,
+		total: total,
+	}
+}
+
+// subtract removes weight from the entry at the zero-based index
+func (f *fenwickTree) subtract(index int, weight uint64) {
+	f.total -= weight
+	for i := index + 1; i < len(f.tree); i += i & -i {
+		f.tree[i] -= weight
+	}
+}
+
+// find returns the zero-based index of the entry whose cumulative range contains ticket,
+// that is the smallest index whose prefix sum is greater than ticket
+func (f *fenwickTree) find(ticket uint64) int {
+	pos := 0
+	for step := f.mask; step > 0; step >>= 1 {
+		next := pos + step
+		if next < len(f.tree) && f.tree[next] <= ticket {
+			pos = next
+			ticket -= f.tree[next]
+		}
+	}
+	return pos
+}
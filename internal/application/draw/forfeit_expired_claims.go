@@ -0,0 +1,126 @@
+package draw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/prize"
+)
+
+// forfeitureBatchSize is the number of expired claims handled per run
+const forfeitureBatchSize = 100
+
+// forfeitureReason is recorded on winners whose prize passed on because they did not claim it
+const forfeitureReason = "Claim window expired"
+
+// ForfeitExpiredClaimsService forfeits the prizes of winners who did not claim them in time and
+// promotes the next runner-up of each tier in their place
+type ForfeitExpiredClaimsService struct {
+	drawRepository  draw.DrawRepository
+	prizeRepository prize.PrizeRepository
+	unitOfWork      draw.UnitOfWork
+	auditService    audit.AuditService
+}
+
+// NewForfeitExpiredClaimsService creates a new ForfeitExpiredClaimsService
+func NewForfeitExpiredClaimsService(
+	drawRepository draw.DrawRepository,
+	prizeRepository prize.PrizeRepository,
+	unitOfWork draw.UnitOfWork,
+	auditService audit.AuditService,
+) *ForfeitExpiredClaimsService {
+	return &ForfeitExpiredClaimsService{
+		drawRepository:  drawRepository,
+		prizeRepository: prizeRepository,
+		unitOfWork:      unitOfWork,
+		auditService:    auditService,
+	}
+}
+
+// ForfeitureOutput describes one forfeited prize
+type ForfeitureOutput struct {
+	WinnerID         uuid.UUID
+	DrawID           uuid.UUID
+	PrizeTierID      uuid.UUID
+	ClaimDeadline    time.Time
+	PromotedWinnerID uuid.UUID // uuid.Nil when the tier had no runner-up left
+	Error            string
+}
+
+// ForfeitExpiredClaimsOutput defines the output for the ForfeitExpiredClaims use case
+type ForfeitExpiredClaimsOutput struct {
+	Forfeitures []ForfeitureOutput
+}
+
+// ForfeitExpiredClaims forfeits up to one batch of winners whose claim window closed before now.
+// Each forfeiture runs in its own transaction, so one failure does not hold up the others.
+func (s *ForfeitExpiredClaimsService) ForfeitExpiredClaims(ctx context.Context, now time.Time) (*ForfeitExpiredClaimsOutput, error) {
+	expired, err := s.drawRepository.ListExpiredClaims(now, forfeitureBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ForfeitExpiredClaimsOutput{
+		Forfeitures: make([]ForfeitureOutput, 0, len(expired)),
+	}
+
+	for _, winner := range expired {
+		forfeiture := ForfeitureOutput{
+			WinnerID:    winner.ID,
+			DrawID:      winner.DrawID,
+			PrizeTierID: winner.PrizeTierID,
+		}
+		if winner.ClaimDeadline != nil {
+			forfeiture.ClaimDeadline = *winner.ClaimDeadline
+		}
+
+		var forfeited, promoted *draw.Winner
+		err := s.unitOfWork.Do(func(drawRepository draw.DrawRepository) error {
+			var err error
+			forfeited, promoted, err = replaceWinner(drawRepository, s.prizeRepository, winner.ID, forfeitureReason, true, now)
+			return err
+		})
+		if err != nil {
+			// The winner claimed or was replaced after the expired claims were listed
+			var drawErr *draw.DrawError
+			if errors.As(err, &drawErr) && drawErr.Code == draw.ErrClaimNotExpired {
+				continue
+			}
+			forfeiture.Error = err.Error()
+			output.Forfeitures = append(output.Forfeitures, forfeiture)
+			continue
+		}
+
+		promotedMSISDN := "none, no runner-ups left"
+		if promoted != nil {
+			forfeiture.PromotedWinnerID = promoted.ID
+			promotedMSISDN = promoted.MSISDN
+		}
+		output.Forfeitures = append(output.Forfeitures, forfeiture)
+
+		// Log audit
+		if err := s.auditService.LogAudit(
+			"FORFEIT_WINNER",
+			"Winner",
+			forfeited.ID,
+			audit.SystemActorID,
+			fmt.Sprintf("Winner %s forfeited the prize: claim window expired", forfeited.MSISDN),
+			fmt.Sprintf("Draw: %s, Claim deadline: %s, Promoted runner-up: %s", forfeited.DrawID, forfeiture.ClaimDeadline.Format(time.RFC3339), promotedMSISDN),
+		); err != nil {
+			// Log error but continue
+			fmt.Printf("Failed to log audit: %v\n", err)
+		}
+
+		if promoted != nil {
+			logRunnerUpInvoked(s.auditService, audit.SystemActorID, forfeited, promoted, forfeitureReason)
+		}
+	}
+
+	return output, nil
+}
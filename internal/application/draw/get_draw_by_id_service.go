@@ -19,9 +19,14 @@ type GetDrawByIDOutput struct {
 	DrawDate             time.Time
 	PrizeStructureID     uuid.UUID
 	Status               string
+	SeedHash             string
 	TotalEligibleMSISDNs int
 	TotalEntries         int
 	ExecutedBy           uuid.UUID
+	FailureReason        string
+	VoidReason           string
+	VoidedAt             *time.Time
+	ReplacementDrawID    uuid.UUID
 	Winners              []draw.Winner
 	CreatedAt            time.Time
 	UpdatedAt            time.Time
@@ -59,9 +64,14 @@ func (s *GetDrawByIDService) GetDrawByID(ctx context.Context, input GetDrawByIDI
 		DrawDate:             drawEntity.DrawDate,
 		PrizeStructureID:     drawEntity.PrizeStructureID,
 		Status:               drawEntity.Status,
+		SeedHash:             drawEntity.SeedHash,
 		TotalEligibleMSISDNs: drawEntity.TotalEligibleMSISDNs,
 		TotalEntries:         drawEntity.TotalEntries,
 		ExecutedBy:           drawEntity.ExecutedBy,
+		FailureReason:        drawEntity.FailureReason,
+		VoidReason:           drawEntity.VoidReason,
+		VoidedAt:             drawEntity.VoidedAt,
+		ReplacementDrawID:    drawEntity.ReplacementDrawID,
 		Winners:              drawEntity.Winners,
 		CreatedAt:            drawEntity.CreatedAt,
 		UpdatedAt:            drawEntity.UpdatedAt,
@@ -2,39 +2,55 @@ package draw
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 	
+	"github.com/google/uuid"
+	
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/blacklist"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/participant"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/prize"
 )
 
 // GetEligibilityStatsService provides functionality for retrieving eligibility statistics
 type GetEligibilityStatsService struct {
 	drawRepository draw.DrawRepository
 	participantRepository participant.ParticipantRepository
+	prizeRepository prize.PrizeRepository
+	blacklistRepository blacklist.BlacklistRepository
 }
 
 // NewGetEligibilityStatsService creates a new GetEligibilityStatsService
 func NewGetEligibilityStatsService(
 	drawRepository draw.DrawRepository,
 	participantRepository participant.ParticipantRepository,
+	prizeRepository prize.PrizeRepository,
+	blacklistRepository blacklist.BlacklistRepository,
 ) *GetEligibilityStatsService {
 	return &GetEligibilityStatsService{
 		drawRepository: drawRepository,
 		participantRepository: participantRepository,
+		prizeRepository: prizeRepository,
+		blacklistRepository: blacklistRepository,
 	}
 }
 
 // GetEligibilityStatsInput defines the input for the GetEligibilityStats use case
 type GetEligibilityStatsInput struct {
 	Date string // Format: YYYY-MM-DD
+	PrizeStructureID uuid.UUID // Optional, defaults to the prize structure active on the date
 }
 
 // GetEligibilityStatsOutput defines the output for the GetEligibilityStats use case
 type GetEligibilityStatsOutput struct {
 	TotalEligibleMSISDNs int
 	TotalEntries int
+	CandidateMSISDNs int // MSISDNs with points on or before the date, before rules are applied
+	CandidateEntries int
+	Exclusions []draw.RuleExclusion
+	PrizeStructureID uuid.UUID // Prize structure whose rules were applied, uuid.Nil for the default rules
 	Date string
 }
 
@@ -50,19 +66,51 @@ func (s *GetEligibilityStatsService) GetEligibilityStats(ctx context.Context, in
 		return nil, fmt.Errorf("invalid date format: %w", err)
 	}
 	
-	// Get eligibility stats
-	totalEligibleMSISDNs, totalEntries, err := s.drawRepository.GetEligibilityStats(date)
+	prizeStructureID, rules, err := s.resolveEligibilityRules(input.PrizeStructureID, date)
+	if err != nil {
+		return nil, err
+	}
+	
+	// Apply the rules exactly as ExecuteDraw would
+	eligibility, err := evaluateEligibility(s.drawRepository, s.participantRepository, s.blacklistRepository, rules, date)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get eligibility stats: %w", err)
+		return nil, err
 	}
 	
 	return &GetEligibilityStatsOutput{
-		TotalEligibleMSISDNs: totalEligibleMSISDNs,
-		TotalEntries: totalEntries,
+		TotalEligibleMSISDNs: len(eligibility.Entries),
+		TotalEntries: eligibility.TotalEntries,
+		CandidateMSISDNs: eligibility.CandidateMSISDNs,
+		CandidateEntries: eligibility.CandidateEntries,
+		Exclusions: eligibility.Exclusions,
+		PrizeStructureID: prizeStructureID,
 		Date: input.Date,
 	}, nil
 }
 
+// resolveEligibilityRules returns the rules of the requested prize structure, or of the one
+// active on the date when none is requested. Without an active structure the default rules apply.
+func (s *GetEligibilityStatsService) resolveEligibilityRules(prizeStructureID uuid.UUID, date time.Time) (uuid.UUID, draw.EligibilityRules, error) {
+	if prizeStructureID != uuid.Nil {
+		prizeStructure, err := s.prizeRepository.GetPrizeStructureByID(prizeStructureID)
+		if err != nil {
+			return uuid.Nil, draw.EligibilityRules{}, fmt.Errorf("failed to get prize structure: %w", err)
+		}
+		return prizeStructure.ID, prizeStructure.EligibilityRules, nil
+	}
+	
+	prizeStructure, err := s.prizeRepository.GetActivePrizeStructure(date)
+	if err != nil {
+		var prizeErr *prize.PrizeError
+		if errors.As(err, &prizeErr) && prizeErr.Code == prize.ErrNoPrizeStructureActive {
+			return uuid.Nil, draw.EligibilityRules{}, nil
+		}
+		return uuid.Nil, draw.EligibilityRules{}, fmt.Errorf("failed to get active prize structure: %w", err)
+	}
+	
+	return prizeStructure.ID, prizeStructure.EligibilityRules, nil
+}
+
 // Helper function to parse date string
 func parseDate(dateStr string) (time.Time, error) {
 	return time.Parse("2006-01-02", dateStr)
@@ -0,0 +1,80 @@
+package draw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+)
+
+// GetReplacementHistoryService provides the replacement history of a prize slot
+type GetReplacementHistoryService struct {
+	drawRepository draw.DrawRepository
+}
+
+// NewGetReplacementHistoryService creates a new GetReplacementHistoryService
+func NewGetReplacementHistoryService(drawRepository draw.DrawRepository) *GetReplacementHistoryService {
+	return &GetReplacementHistoryService{
+		drawRepository: drawRepository,
+	}
+}
+
+// GetReplacementHistoryInput defines the input for the GetReplacementHistory use case
+type GetReplacementHistoryInput struct {
+	WinnerID uuid.UUID // Any winner of the slot: the original winner or a promoted runner-up
+}
+
+// GetReplacementHistoryOutput defines the output for the GetReplacementHistory use case
+type GetReplacementHistoryOutput struct {
+	DrawID             uuid.UUID
+	PrizeTierID        uuid.UUID
+	CurrentWinnerID    uuid.UUID
+	RemainingRunnerUps int // Runner-ups of the tier not yet promoted to any slot
+	Chain              []RunnerUpWinnerOutput
+}
+
+// GetReplacementHistory returns the holders of a prize slot in order, from the original winner
+// to the current one
+func (s *GetReplacementHistoryService) GetReplacementHistory(ctx context.Context, input GetReplacementHistoryInput) (*GetReplacementHistoryOutput, error) {
+	if input.WinnerID == uuid.Nil {
+		return nil, errors.New("winner ID is required")
+	}
+
+	winner, err := s.drawRepository.GetWinnerByID(input.WinnerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get winner: %w", err)
+	}
+
+	winners, err := s.drawRepository.ListWinnersByPrizeTier(winner.DrawID, winner.PrizeTierID)
+	if err != nil {
+		return nil, err
+	}
+
+	chain, err := draw.ReplacementChain(winners, input.WinnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	remainingRunnerUps := 0
+	for _, w := range winners {
+		if w.IsRunnerUp {
+			remainingRunnerUps++
+		}
+	}
+
+	output := &GetReplacementHistoryOutput{
+		DrawID:             winner.DrawID,
+		PrizeTierID:        winner.PrizeTierID,
+		CurrentWinnerID:    chain[len(chain)-1].ID,
+		RemainingRunnerUps: remainingRunnerUps,
+		Chain:              make([]RunnerUpWinnerOutput, 0, len(chain)),
+	}
+	for i := range chain {
+		output.Chain = append(output.Chain, toRunnerUpWinnerOutput(&chain[i]))
+	}
+
+	return output, nil
+}
@@ -0,0 +1,57 @@
+package draw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+)
+
+// GetWinnerPaymentHistoryService provides the payment history of a winner's prize
+type GetWinnerPaymentHistoryService struct {
+	drawRepository draw.DrawRepository
+}
+
+// NewGetWinnerPaymentHistoryService creates a new GetWinnerPaymentHistoryService
+func NewGetWinnerPaymentHistoryService(drawRepository draw.DrawRepository) *GetWinnerPaymentHistoryService {
+	return &GetWinnerPaymentHistoryService{
+		drawRepository: drawRepository,
+	}
+}
+
+// GetWinnerPaymentHistoryOutput defines the output for the GetWinnerPaymentHistory use case
+type GetWinnerPaymentHistoryOutput struct {
+	WinnerID          uuid.UUID
+	PaymentStatus     string
+	PaymentReference  string
+	PaymentApprovedBy uuid.UUID
+	PaymentApprovedAt *time.Time
+	PaidAt            *time.Time
+	Events            []draw.PaymentEvent // Oldest first
+}
+
+// GetWinnerPaymentHistory returns every step taken to pay a winner's prize
+func (s *GetWinnerPaymentHistoryService) GetWinnerPaymentHistory(ctx context.Context, winnerID uuid.UUID) (*GetWinnerPaymentHistoryOutput, error) {
+	if winnerID == uuid.Nil {
+		return nil, errors.New("winner ID is required")
+	}
+
+	winner, err := s.drawRepository.GetWinnerByID(winnerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get winner: %w", err)
+	}
+
+	return &GetWinnerPaymentHistoryOutput{
+		WinnerID:          winner.ID,
+		PaymentStatus:     winner.PaymentStatus,
+		PaymentReference:  winner.PaymentReference,
+		PaymentApprovedBy: winner.PaymentApprovedBy,
+		PaymentApprovedAt: winner.PaymentApprovedAt,
+		PaidAt:            winner.PaidAt,
+		Events:            winner.PaymentHistory,
+	}, nil
+}
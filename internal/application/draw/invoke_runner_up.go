@@ -10,22 +10,29 @@ import (
 	
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/prize"
 )
 
 // InvokeRunnerUpService provides functionality for invoking runner-ups
 type InvokeRunnerUpService struct {
-	drawRepository draw.DrawRepository
-	auditService   audit.AuditService
+	drawRepository  draw.DrawRepository
+	prizeRepository prize.PrizeRepository
+	unitOfWork      draw.UnitOfWork
+	auditService    audit.AuditService
 }
 
 // NewInvokeRunnerUpService creates a new InvokeRunnerUpService
 func NewInvokeRunnerUpService(
 	drawRepository draw.DrawRepository,
+	prizeRepository prize.PrizeRepository,
+	unitOfWork draw.UnitOfWork,
 	auditService audit.AuditService,
 ) *InvokeRunnerUpService {
 	return &InvokeRunnerUpService{
-		drawRepository: drawRepository,
-		auditService:   auditService,
+		drawRepository:  drawRepository,
+		prizeRepository: prizeRepository,
+		unitOfWork:      unitOfWork,
+		auditService:    auditService,
 	}
 }
 
@@ -44,14 +51,26 @@ type InvokeRunnerUpOutput struct {
 
 // RunnerUpWinnerOutput defines the winner output structure for runner-up invocation
 type RunnerUpWinnerOutput struct {
-	ID          uuid.UUID
-	MSISDN      string
-	PrizeTierID uuid.UUID
-	PrizeValue  float64
-	Status      string
+	ID                 uuid.UUID
+	DrawID             uuid.UUID
+	MSISDN             string
+	PrizeTierID        uuid.UUID
+	PrizeValue         float64
+	Status             string
+	PaymentStatus      string
+	IsRunnerUp         bool
+	RunnerUpRank       int
+	ReplacesWinnerID   uuid.UUID
+	ReplacedByWinnerID uuid.UUID
+	ReplacementReason  string
+	ReplacedAt         *time.Time
+	ClaimDeadline      *time.Time
+	ClaimedAt          *time.Time
+	CreatedAt          time.Time
 }
 
-// InvokeRunnerUp invokes a runner-up to replace a winner
+// InvokeRunnerUp hands a winner's prize to the next runner-up of its tier, in rank order.
+// The winner may itself be a runner-up promoted earlier, in which case the chain continues.
 func (uc *InvokeRunnerUpService) InvokeRunnerUp(ctx context.Context, input InvokeRunnerUpInput) (*InvokeRunnerUpOutput, error) {
 	// Validate input
 	if input.WinnerID == uuid.Nil {
@@ -62,74 +81,139 @@ func (uc *InvokeRunnerUpService) InvokeRunnerUp(ctx context.Context, input Invok
 		return nil, errors.New("admin user ID is required")
 	}
 	
-	// Get the original winner
-	originalWinner, err := uc.drawRepository.GetWinnerByID(input.WinnerID)
+	var originalWinner, newWinner *draw.Winner
+	err := uc.unitOfWork.Do(func(drawRepository draw.DrawRepository) error {
+		var err error
+		originalWinner, newWinner, err = replaceWinner(drawRepository, uc.prizeRepository, input.WinnerID, input.Reason, false, time.Now())
+		return err
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get winner: %w", err)
+		return nil, err
 	}
 	
-	// Check if winner is eligible for replacement
-	if originalWinner.IsRunnerUp {
-		return nil, errors.New("cannot replace a runner-up")
+	logRunnerUpInvoked(uc.auditService, input.AdminUserID, originalWinner, newWinner, input.Reason)
+	
+	return &InvokeRunnerUpOutput{
+		OriginalWinner: toRunnerUpWinnerOutput(originalWinner),
+		NewWinner:      toRunnerUpWinnerOutput(newWinner),
+	}, nil
+}
+
+// replaceWinner hands a winner's prize to the next runner-up of its tier and must run inside a
+// transaction. Replacements for a draw are serialised on the draw row, so concurrent requests
+// cannot promote the same runner-up or replace the same winner twice. With forfeit set the
+// winner's claim window must have closed, and the winner is forfeited even when no runner-up
+// is left, in which case the returned runner-up is nil.
+func replaceWinner(
+	drawRepository draw.DrawRepository,
+	prizeRepository prize.PrizeRepository,
+	winnerID uuid.UUID,
+	reason string,
+	forfeit bool,
+	now time.Time,
+) (*draw.Winner, *draw.Winner, error) {
+	winner, err := drawRepository.GetWinnerByID(winnerID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get winner: %w", err)
 	}
 	
-	// Get available runner-ups
-	runnerUps, err := uc.drawRepository.GetRunnerUps(originalWinner.DrawID, originalWinner.PrizeTierID, 1)
+	// Winners of voided or redrawn draws cannot be replaced
+	parentDraw, err := drawRepository.GetByIDForUpdate(winner.DrawID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get runner-ups: %w", err)
+		return nil, nil, fmt.Errorf("failed to get draw: %w", err)
 	}
 	
-	if len(runnerUps) == 0 {
-		return nil, draw.NewDrawError(draw.ErrNoRunnerUpsAvailable, "No runner-ups available", nil)
+	if parentDraw.Status != draw.StatusCompleted {
+		return nil, nil, draw.NewDrawError(draw.ErrDrawNotCompleted, fmt.Sprintf("Runner-ups can only be invoked for completed draws, draw is %s", parentDraw.Status), nil)
+	}
+	
+	// Read the winner again now that the draw is locked
+	winner, err = drawRepository.GetWinnerByID(winnerID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get winner: %w", err)
+	}
+	
+	if forfeit {
+		if err := winner.Forfeit(now); err != nil {
+			return nil, nil, err
+		}
+	}
+	
+	// The next runner-up is the lowest rank not yet promoted
+	runnerUps, err := drawRepository.GetRunnerUps(winner.DrawID, winner.PrizeTierID, 1)
+	var drawErr *draw.DrawError
+	if err != nil && !(errors.As(err, &drawErr) && drawErr.Code == draw.ErrNoRunnerUpsAvailable) {
+		return nil, nil, fmt.Errorf("failed to get runner-ups: %w", err)
 	}
 	
-	// Select the first runner-up
-	newWinner := runnerUps[0]
+	if len(runnerUps) == 0 {
+		if !forfeit {
+			return nil, nil, draw.NewDrawError(draw.ErrNoRunnerUpsAvailable, "No runner-ups available", nil)
+		}
+		
+		if err := drawRepository.UpdateWinner(winner); err != nil {
+			return nil, nil, fmt.Errorf("failed to update winner: %w", err)
+		}
+		return winner, nil, nil
+	}
+	runnerUp := runnerUps[0]
 	
-	// Update original winner status
-	originalWinner.Status = "Replaced"
-	originalWinner.UpdatedAt = time.Now()
+	if err := winner.ReplaceWith(&runnerUp, reason, now); err != nil {
+		return nil, nil, err
+	}
 	
-	if err := uc.drawRepository.UpdateWinner(originalWinner); err != nil {
-		return nil, fmt.Errorf("failed to update original winner: %w", err)
+	// The promoted runner-up gets a full claim window of its own
+	prizeTier, err := prizeRepository.GetPrizeTierByID(runnerUp.PrizeTierID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get prize tier: %w", err)
 	}
+	claimDeadline := now.Add(prizeTier.ClaimWindow())
+	runnerUp.ClaimDeadline = &claimDeadline
 	
-	// Update runner-up status
-	newWinner.IsRunnerUp = false
-	newWinner.Status = "PendingNotification"
-	newWinner.UpdatedAt = time.Now()
+	if err := drawRepository.UpdateWinner(winner); err != nil {
+		return nil, nil, fmt.Errorf("failed to update original winner: %w", err)
+	}
 	
-	if err := uc.drawRepository.UpdateWinner(&newWinner); err != nil {
-		return nil, fmt.Errorf("failed to update runner-up: %w", err)
+	if err := drawRepository.UpdateWinner(&runnerUp); err != nil {
+		return nil, nil, fmt.Errorf("failed to update runner-up: %w", err)
 	}
 	
-	// Log audit
-	if err := uc.auditService.LogAudit(
+	return winner, &runnerUp, nil
+}
+
+// logRunnerUpInvoked writes the audit entry for a runner-up taking over a winner's prize
+func logRunnerUpInvoked(auditService audit.AuditService, userID uuid.UUID, originalWinner, newWinner *draw.Winner, reason string) {
+	if err := auditService.LogAudit(
 		"INVOKE_RUNNER_UP",
 		"Winner",
 		originalWinner.ID,
-		input.AdminUserID,
+		userID,
 		fmt.Sprintf("Runner-up invoked to replace winner %s", originalWinner.MSISDN),
-		fmt.Sprintf("Reason: %s, New winner: %s", input.Reason, newWinner.MSISDN),
+		fmt.Sprintf("Reason: %s, New winner: %s (%s), Runner-up rank: %d", reason, newWinner.MSISDN, newWinner.ID, newWinner.RunnerUpRank),
 	); err != nil {
 		// Log error but continue
 		fmt.Printf("Failed to log audit: %v\n", err)
 	}
-	
-	return &InvokeRunnerUpOutput{
-		OriginalWinner: RunnerUpWinnerOutput{
-			ID:          originalWinner.ID,
-			MSISDN:      originalWinner.MSISDN,
-			PrizeTierID: originalWinner.PrizeTierID,
-			PrizeValue:  originalWinner.PrizeValue,
-			Status:      originalWinner.Status,
-		},
-		NewWinner: RunnerUpWinnerOutput{
-			ID:          newWinner.ID,
-			MSISDN:      newWinner.MSISDN,
-			PrizeTierID: newWinner.PrizeTierID,
-			PrizeValue:  newWinner.PrizeValue,
-			Status:      newWinner.Status,
-		},
-	}, nil
+}
+
+// toRunnerUpWinnerOutput converts a domain winner to a RunnerUpWinnerOutput
+func toRunnerUpWinnerOutput(winner *draw.Winner) RunnerUpWinnerOutput {
+	return RunnerUpWinnerOutput{
+		ID:                 winner.ID,
+		DrawID:             winner.DrawID,
+		MSISDN:             winner.MSISDN,
+		PrizeTierID:        winner.PrizeTierID,
+		PrizeValue:         winner.PrizeValue,
+		Status:             winner.Status,
+		PaymentStatus:      winner.PaymentStatus,
+		IsRunnerUp:         winner.IsRunnerUp,
+		RunnerUpRank:       winner.RunnerUpRank,
+		ReplacesWinnerID:   winner.ReplacesWinnerID,
+		ReplacedByWinnerID: winner.ReplacedByWinnerID,
+		ReplacementReason:  winner.ReplacementReason,
+		ReplacedAt:         winner.ReplacedAt,
+		ClaimDeadline:      winner.ClaimDeadline,
+		ClaimedAt:          winner.ClaimedAt,
+		CreatedAt:          winner.CreatedAt,
+	}
 }
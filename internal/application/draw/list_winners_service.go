@@ -2,16 +2,20 @@ package draw
 
 import (
 	"context"
-	
+	"strings"
+
 	drawDomain "github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/participant"
 )
 
+// maxWinnersPageSize caps the page size of a winner listing
+const maxWinnersPageSize = 500
+
 // ListWinnersInput represents input for ListWinners
 type ListWinnersInput struct {
-	Page      int
-	PageSize  int
-	StartDate string
-	EndDate   string
+	Page     int
+	PageSize int
+	Filters  drawDomain.WinnerFilters
 }
 
 // ListWinnersOutput represents output for ListWinners
@@ -35,25 +39,40 @@ func NewListWinnersService(repository Repository) *ListWinnersService {
 	}
 }
 
-// ListWinners lists winners with pagination
+// ListWinners lists the winners matching the filters, one page at a time
 func (s *ListWinnersService) ListWinners(ctx context.Context, input ListWinnersInput) (ListWinnersOutput, error) {
-	// Implementation using domain types
-	winners, total, err := s.repository.ListWinners(ctx, input.Page, input.PageSize, input.StartDate, input.EndDate)
+	if input.Page < 1 {
+		input.Page = 1
+	}
+	if input.PageSize < 1 {
+		input.PageSize = 10
+	}
+	if input.PageSize > maxWinnersPageSize {
+		input.PageSize = maxWinnersPageSize
+	}
+
+	filters := input.Filters
+	filters.MSISDN = normalizeMSISDNSearch(filters.MSISDN)
+	if err := filters.Validate(); err != nil {
+		return ListWinnersOutput{}, err
+	}
+
+	winners, total, err := s.repository.ListWinners(ctx, filters, input.Page, input.PageSize)
 	if err != nil {
 		return ListWinnersOutput{}, err
 	}
-	
+
 	// Convert to output format
 	winnerOutputs := make([]drawDomain.Winner, len(winners))
 	for i, winner := range winners {
 		winnerOutputs[i] = *winner
 	}
-	
+
 	totalPages := total / input.PageSize
 	if total%input.PageSize > 0 {
 		totalPages++
 	}
-	
+
 	return ListWinnersOutput{
 		Winners:    winnerOutputs,
 		Page:       input.Page,
@@ -62,3 +81,19 @@ func (s *ListWinnersService) ListWinners(ctx context.Context, input ListWinnersI
 		TotalPages: totalPages,
 	}, nil
 }
+
+// normalizeMSISDNSearch puts an MSISDN searched for in the stored international format.
+// A masked MSISDN keeps its mask; only a local 0 prefix is replaced with 234.
+func normalizeMSISDNSearch(msisdn string) string {
+	msisdn = strings.TrimSpace(msisdn)
+	if !strings.Contains(msisdn, "*") {
+		return participant.NormalizeMSISDN(msisdn)
+	}
+
+	msisdn = strings.NewReplacer(" ", "", "-", "").Replace(msisdn)
+	msisdn = strings.TrimPrefix(msisdn, "+")
+	if strings.HasPrefix(msisdn, "0") {
+		msisdn = "234" + msisdn[1:]
+	}
+	return msisdn
+}
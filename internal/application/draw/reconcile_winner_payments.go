@@ -0,0 +1,490 @@
+package draw
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/participant"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/prize"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/spreadsheet"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/util"
+)
+
+// statementLookupBatchSize is the number of MSISDNs looked up per repository call
+const statementLookupBatchSize = 1000
+
+// Canonical bank statement columns
+const (
+	statementColumnMSISDN    = "msisdn"
+	statementColumnAmount    = "amount"
+	statementColumnReference = "reference"
+)
+
+// statementColumnAliases maps normalized header names to canonical statement columns
+var statementColumnAliases = map[string]string{
+	"msisdn":                statementColumnMSISDN,
+	"phone":                 statementColumnMSISDN,
+	"phone number":          statementColumnMSISDN,
+	"mobile":                statementColumnMSISDN,
+	"mobile number":         statementColumnMSISDN,
+	"beneficiary msisdn":    statementColumnMSISDN,
+	"amount":                statementColumnAmount,
+	"paid amount":           statementColumnAmount,
+	"credit":                statementColumnAmount,
+	"value":                 statementColumnAmount,
+	"reference":             statementColumnReference,
+	"ref":                   statementColumnReference,
+	"payment reference":     statementColumnReference,
+	"transaction reference": statementColumnReference,
+	"transaction ref":       statementColumnReference,
+}
+
+// ReconcileWinnerPaymentsService marks winners paid from the statement of a bank payment batch
+type ReconcileWinnerPaymentsService struct {
+	drawRepository  draw.DrawRepository
+	prizeRepository prize.PrizeRepository
+	unitOfWork      draw.UnitOfWork
+	auditService    audit.AuditService
+}
+
+// NewReconcileWinnerPaymentsService creates a new ReconcileWinnerPaymentsService
+func NewReconcileWinnerPaymentsService(
+	drawRepository draw.DrawRepository,
+	prizeRepository prize.PrizeRepository,
+	unitOfWork draw.UnitOfWork,
+	auditService audit.AuditService,
+) *ReconcileWinnerPaymentsService {
+	return &ReconcileWinnerPaymentsService{
+		drawRepository:  drawRepository,
+		prizeRepository: prizeRepository,
+		unitOfWork:      unitOfWork,
+		auditService:    auditService,
+	}
+}
+
+// ReconcileWinnerPaymentsInput defines the input for the ReconcileWinnerPayments use case
+type ReconcileWinnerPaymentsInput struct {
+	Rows         spreadsheet.RowReader
+	ReconciledBy uuid.UUID
+	FileName     string
+	DryRun       bool // Report the matches without marking any winner paid
+}
+
+// ReconciliationRow describes how one statement row was reconciled
+type ReconciliationRow struct {
+	Row            int     `json:"row"`
+	MSISDN         string  `json:"msisdn"`
+	Amount         float64 `json:"amount"`
+	Reference      string  `json:"reference"`
+	WinnerID       string  `json:"winnerId,omitempty"`
+	ExpectedAmount float64 `json:"expectedAmount,omitempty"` // The prize value, for amount mismatches
+	Reason         string  `json:"reason,omitempty"`
+}
+
+// ReconcileWinnerPaymentsOutput defines the output for the ReconcileWinnerPayments use case
+type ReconcileWinnerPaymentsOutput struct {
+	TotalRows      int                 `json:"totalRows"`
+	DryRun         bool                `json:"dryRun"`
+	Matched        []ReconciliationRow `json:"matched"`
+	Unmatched      []ReconciliationRow `json:"unmatched"`
+	AmountMismatch []ReconciliationRow `json:"amountMismatch"`
+	AlreadyPaid    []ReconciliationRow `json:"alreadyPaid"`
+}
+
+// statementMatch is a statement row matched to the winner it pays
+type statementMatch struct {
+	row    ReconciliationRow
+	winner draw.Winner
+}
+
+// ReconcileWinnerPayments reads MSISDN, amount and reference columns from a bank statement and
+// matches each row to a winner whose approved or processing payment is for that amount. A winner
+// whose payout is processing under a reference is only matched by the row with that reference.
+// Matched winners are marked paid under the row's reference in a single transaction. Rows paying
+// a winner twice, for the wrong amount or for no payment awaiting it are reported and change nothing.
+func (s *ReconcileWinnerPaymentsService) ReconcileWinnerPayments(ctx context.Context, input ReconcileWinnerPaymentsInput) (*ReconcileWinnerPaymentsOutput, error) {
+	if input.Rows == nil {
+		return nil, errors.New("file rows are required")
+	}
+
+	if input.ReconciledBy == uuid.Nil {
+		return nil, errors.New("reconciled by is required")
+	}
+
+	output := &ReconcileWinnerPaymentsOutput{
+		DryRun:         input.DryRun,
+		Matched:        make([]ReconciliationRow, 0),
+		Unmatched:      make([]ReconciliationRow, 0),
+		AmountMismatch: make([]ReconciliationRow, 0),
+		AlreadyPaid:    make([]ReconciliationRow, 0),
+	}
+
+	rows, err := s.readStatement(input.Rows, output)
+	if err != nil {
+		return nil, err
+	}
+
+	winnersByMSISDN, err := s.loadWinners(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	prizeValues := make(map[uuid.UUID]float64)
+	matched := make(map[uuid.UUID]bool)
+	matches := make([]statementMatch, 0)
+	for _, row := range rows {
+		match, err := s.matchRow(row, winnersByMSISDN[row.MSISDN], prizeValues, matched, input.ReconciledBy, output)
+		if err != nil {
+			return nil, err
+		}
+		if match != nil {
+			matched[match.winner.ID] = true
+			matches = append(matches, *match)
+		}
+	}
+
+	if !input.DryRun && len(matches) > 0 {
+		if err := s.markPaid(matches, input, output); err != nil {
+			return nil, err
+		}
+	} else {
+		for _, match := range matches {
+			output.Matched = append(output.Matched, match.row)
+		}
+	}
+
+	if !input.DryRun {
+		// Log audit
+		if err := s.auditService.LogAudit(
+			"RECONCILE_WINNER_PAYMENTS",
+			"Winner",
+			uuid.New(),
+			input.ReconciledBy,
+			fmt.Sprintf("Winners marked paid from bank statement: %d", len(output.Matched)),
+			fmt.Sprintf("File: %s, Rows: %d, Unmatched: %d, Amount mismatches: %d, Already paid: %d",
+				input.FileName, output.TotalRows, len(output.Unmatched), len(output.AmountMismatch), len(output.AlreadyPaid)),
+		); err != nil {
+			// Log error but continue
+			fmt.Printf("Failed to log audit: %v\n", err)
+		}
+	}
+
+	return output, nil
+}
+
+// readStatement reads the statement rows, reporting rows that cannot be matched at all as unmatched
+func (s *ReconcileWinnerPaymentsService) readStatement(rows spreadsheet.RowReader, output *ReconcileWinnerPaymentsOutput) ([]ReconciliationRow, error) {
+	header, err := rows.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, draw.NewDrawError(draw.ErrInvalidStatementFile, "File is empty", nil)
+		}
+		return nil, draw.NewDrawError(draw.ErrInvalidStatementFile, "Failed to read header row", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		if canonical, ok := statementColumnAliases[spreadsheet.NormalizeHeader(name)]; ok {
+			if _, exists := columns[canonical]; !exists {
+				columns[canonical] = i
+			}
+		}
+	}
+	for _, column := range []string{statementColumnMSISDN, statementColumnAmount, statementColumnReference} {
+		if _, ok := columns[column]; !ok {
+			return nil, draw.NewDrawError(draw.ErrInvalidStatementFile, "Missing required column: "+column, nil)
+		}
+	}
+
+	statement := make([]ReconciliationRow, 0)
+	references := make(map[string]bool)
+
+	// Header is row 1, data starts on row 2
+	for rowNumber := 2; ; rowNumber++ {
+		row, err := rows.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				output.TotalRows++
+				output.Unmatched = append(output.Unmatched, ReconciliationRow{Row: rowNumber, Reason: fmt.Sprintf("malformed row: %v", parseErr.Err)})
+				continue
+			}
+			return nil, draw.NewDrawError(draw.ErrInvalidStatementFile, fmt.Sprintf("Failed to read row %d", rowNumber), err)
+		}
+
+		if isBlankStatementRow(row) {
+			continue
+		}
+		output.TotalRows++
+
+		reconciliationRow := ReconciliationRow{
+			Row:       rowNumber,
+			MSISDN:    participant.NormalizeMSISDN(statementCell(row, columns, statementColumnMSISDN)),
+			Reference: statementCell(row, columns, statementColumnReference),
+		}
+
+		amount, err := util.ParseCurrency(statementCell(row, columns, statementColumnAmount))
+		switch {
+		case reconciliationRow.MSISDN == "":
+			reconciliationRow.Reason = "MSISDN is required"
+		case err != nil || amount <= 0:
+			reconciliationRow.Reason = "Amount must be a number greater than zero"
+		case reconciliationRow.Reference == "":
+			reconciliationRow.Reason = "Reference is required"
+		case references[reconciliationRow.Reference]:
+			reconciliationRow.Reason = "Reference appears more than once in the statement"
+		}
+		reconciliationRow.Amount = amount
+		if reconciliationRow.Reason != "" {
+			output.Unmatched = append(output.Unmatched, reconciliationRow)
+			continue
+		}
+
+		references[reconciliationRow.Reference] = true
+		statement = append(statement, reconciliationRow)
+	}
+
+	if output.TotalRows == 0 {
+		return nil, draw.NewDrawError(draw.ErrInvalidStatementFile, "File contains no data rows", nil)
+	}
+
+	return statement, nil
+}
+
+// loadWinners returns the prize winners with the MSISDNs of the statement rows, by MSISDN
+func (s *ReconcileWinnerPaymentsService) loadWinners(rows []ReconciliationRow) (map[string][]draw.Winner, error) {
+	seen := make(map[string]bool)
+	msisdns := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if !seen[row.MSISDN] {
+			seen[row.MSISDN] = true
+			msisdns = append(msisdns, row.MSISDN)
+		}
+	}
+
+	winnersByMSISDN := make(map[string][]draw.Winner)
+	for start := 0; start < len(msisdns); start += statementLookupBatchSize {
+		end := start + statementLookupBatchSize
+		if end > len(msisdns) {
+			end = len(msisdns)
+		}
+
+		winners, err := s.drawRepository.ListPrizeWinnersByMSISDNs(msisdns[start:end])
+		if err != nil {
+			return nil, err
+		}
+		for _, winner := range winners {
+			winnersByMSISDN[winner.MSISDN] = append(winnersByMSISDN[winner.MSISDN], winner)
+		}
+	}
+
+	return winnersByMSISDN, nil
+}
+
+// matchRow finds the winner a statement row pays. It returns nil, after reporting the row,
+// when the row pays no winner whose payment is approved or processing.
+func (s *ReconcileWinnerPaymentsService) matchRow(
+	row ReconciliationRow,
+	candidates []draw.Winner,
+	prizeValues map[uuid.UUID]float64,
+	matched map[uuid.UUID]bool,
+	reconciledBy uuid.UUID,
+	output *ReconcileWinnerPaymentsOutput,
+) (*statementMatch, error) {
+	if len(candidates) == 0 {
+		row.Reason = "No prize winner with this MSISDN"
+		output.Unmatched = append(output.Unmatched, row)
+		return nil, nil
+	}
+
+	for i, winner := range candidates {
+		if winner.PaymentReference != row.Reference {
+			continue
+		}
+		if winner.PaymentStatus == draw.PaymentStatusPaid {
+			row.WinnerID = winner.ID.String()
+			row.Reason = "Winner was already paid under this reference"
+			output.AlreadyPaid = append(output.AlreadyPaid, row)
+			return nil, nil
+		}
+		// The row pays the payout started under its reference, and no other prize
+		if winner.PaymentStatus == draw.PaymentStatusProcessing {
+			candidates = candidates[i : i+1]
+			break
+		}
+	}
+
+	var mismatch *draw.Winner
+	var paid *draw.Winner
+	var blocked string
+	for i := range candidates {
+		winner := &candidates[i]
+		switch {
+		case winner.PaymentStatus == draw.PaymentStatusPaid:
+			if paid == nil {
+				paid = winner
+			}
+			continue
+		case matched[winner.ID] || !winner.HoldsPrize():
+			continue
+		case winner.PaymentStatus == draw.PaymentStatusProcessing && winner.PaymentReference != "" && winner.PaymentReference != row.Reference:
+			blocked = "Winner's payout is processing under reference " + winner.PaymentReference
+			continue
+		case winner.PaymentStatus != draw.PaymentStatusApproved && winner.PaymentStatus != draw.PaymentStatusProcessing:
+			blocked = fmt.Sprintf("Winner's payment is %s, only approved and processing payments are reconciled", winner.PaymentStatus)
+			continue
+		}
+
+		value, err := s.prizeValue(winner.PrizeTierID, prizeValues)
+		if err != nil {
+			return nil, err
+		}
+
+		if math.Abs(value-row.Amount) >= 0.005 {
+			if mismatch == nil {
+				mismatch = winner
+				row.ExpectedAmount = value
+			}
+			continue
+		}
+
+		// Maker-checker: the approver of the payment cannot also confirm it. A processing payout
+		// was already started by someone else.
+		if winner.PaymentStatus == draw.PaymentStatusApproved {
+			if err := winner.CheckNotApprover(reconciledBy); err != nil {
+				blocked = err.Error()
+				continue
+			}
+		}
+
+		row.WinnerID = winner.ID.String()
+		row.ExpectedAmount = 0
+		return &statementMatch{row: row, winner: *winner}, nil
+	}
+
+	switch {
+	case mismatch != nil:
+		row.WinnerID = mismatch.ID.String()
+		row.Reason = fmt.Sprintf("Statement amount %.2f does not match the prize value %.2f", row.Amount, row.ExpectedAmount)
+		output.AmountMismatch = append(output.AmountMismatch, row)
+	case blocked != "":
+		row.Reason = blocked
+		output.Unmatched = append(output.Unmatched, row)
+	case paid != nil:
+		row.WinnerID = paid.ID.String()
+		row.Reason = "Winner was already paid under reference " + paid.PaymentReference
+		output.AlreadyPaid = append(output.AlreadyPaid, row)
+	default:
+		row.Reason = "No prize awaiting payment for this MSISDN"
+		output.Unmatched = append(output.Unmatched, row)
+	}
+	return nil, nil
+}
+
+// prizeValue returns the value of a prize tier, caching it for later rows
+func (s *ReconcileWinnerPaymentsService) prizeValue(prizeTierID uuid.UUID, prizeValues map[uuid.UUID]float64) (float64, error) {
+	if value, ok := prizeValues[prizeTierID]; ok {
+		return value, nil
+	}
+
+	tier, err := s.prizeRepository.GetPrizeTierByID(prizeTierID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get prize tier: %w", err)
+	}
+
+	prizeValues[prizeTierID] = tier.Value
+	return tier.Value, nil
+}
+
+// markPaid marks the matched winners paid in one transaction. The draws are locked in a fixed
+// order first, as payouts and runner-up replacements lock them, and each winner is read again
+// so that a payment changed since the statement was matched is reported instead of overwritten.
+func (s *ReconcileWinnerPaymentsService) markPaid(matches []statementMatch, input ReconcileWinnerPaymentsInput, output *ReconcileWinnerPaymentsOutput) error {
+	drawIDs := make([]uuid.UUID, 0)
+	seen := make(map[uuid.UUID]bool)
+	for _, match := range matches {
+		if !seen[match.winner.DrawID] {
+			seen[match.winner.DrawID] = true
+			drawIDs = append(drawIDs, match.winner.DrawID)
+		}
+	}
+	sort.Slice(drawIDs, func(i, j int) bool { return drawIDs[i].String() < drawIDs[j].String() })
+
+	var paid, rejected []ReconciliationRow
+	err := s.unitOfWork.Do(func(drawRepository draw.DrawRepository) error {
+		paid, rejected = nil, nil
+
+		for _, drawID := range drawIDs {
+			if _, err := drawRepository.GetByIDForUpdate(drawID); err != nil {
+				return fmt.Errorf("failed to lock draw: %w", err)
+			}
+		}
+
+		now := time.Now()
+		for _, match := range matches {
+			winner, err := drawRepository.GetWinnerByID(match.winner.ID)
+			if err != nil {
+				return err
+			}
+
+			notes := fmt.Sprintf("Reconciled from bank statement %s, row %d", input.FileName, match.row.Row)
+			if err := winner.MarkPaid(input.ReconciledBy, match.row.Reference, notes, now); err != nil {
+				var drawErr *draw.DrawError
+				if !errors.As(err, &drawErr) {
+					return err
+				}
+				row := match.row
+				row.Reason = drawErr.Message
+				rejected = append(rejected, row)
+				continue
+			}
+
+			if err := drawRepository.UpdateWinner(winner); err != nil {
+				return err
+			}
+			paid = append(paid, match.row)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark winners paid: %w", err)
+	}
+
+	output.Matched = append(output.Matched, paid...)
+	output.Unmatched = append(output.Unmatched, rejected...)
+	return nil
+}
+
+// statementCell returns the trimmed value of a column, or an empty string when the column or cell is missing
+func statementCell(row []string, columns map[string]int, column string) string {
+	index, ok := columns[column]
+	if !ok || index >= len(row) {
+		return ""
+	}
+	return strings.TrimSpace(row[index])
+}
+
+// isBlankStatementRow reports whether every cell in the row is empty
+func isBlankStatementRow(row []string) bool {
+	for _, value := range row {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,273 @@
+package draw_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	drawApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/prize"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/spreadsheet"
+)
+
+func (r *fakeDrawRepository) ListPrizeWinnersByMSISDNs(msisdns []string) ([]draw.Winner, error) {
+	winners := make([]draw.Winner, 0)
+	for _, winner := range r.winners {
+		for _, msisdn := range msisdns {
+			if winner.MSISDN == msisdn {
+				winners = append(winners, winner)
+			}
+		}
+	}
+	return winners, nil
+}
+
+func (r *fakeDrawRepository) GetWinnerByID(id uuid.UUID) (*draw.Winner, error) {
+	for _, winner := range r.winners {
+		if winner.ID == id {
+			return &winner, nil
+		}
+	}
+	return nil, draw.NewDrawError(draw.ErrWinnerNotFound, "Winner not found", nil)
+}
+
+func (r *fakeDrawRepository) UpdateWinner(w *draw.Winner) error {
+	for i := range r.winners {
+		if r.winners[i].ID == w.ID {
+			r.writes = append(r.writes, "UpdateWinner")
+			r.winners[i] = *w
+			return nil
+		}
+	}
+	return draw.NewDrawError(draw.ErrWinnerNotFound, "Winner not found", nil)
+}
+
+func (r *fakePrizeRepository) GetPrizeTierByID(id uuid.UUID) (*prize.PrizeTier, error) {
+	for _, prizeStructure := range r.structures {
+		for i := range prizeStructure.Prizes {
+			if prizeStructure.Prizes[i].ID == id {
+				return &prizeStructure.Prizes[i], nil
+			}
+		}
+	}
+	return nil, errors.New("prize tier not found")
+}
+
+// reconcileFixture holds a completed draw whose winners are paid from statements
+type reconcileFixture struct {
+	service  *drawApp.ReconcileWinnerPaymentsService
+	drawRepo *fakeDrawRepository
+	drawID   uuid.UUID
+	tierID   uuid.UUID
+	approver uuid.UUID
+}
+
+func newReconcileFixture() *reconcileFixture {
+	drawRepo := newFakeDrawRepository()
+	drawID := uuid.New()
+	drawRepo.draws[drawID] = draw.Draw{ID: drawID, Status: draw.StatusCompleted}
+
+	tierID := uuid.New()
+	prizeRepo := &fakePrizeRepository{structures: map[uuid.UUID]*prize.PrizeStructure{
+		uuid.New(): {Prizes: []prize.PrizeTier{{ID: tierID, Name: "Jackpot", Value: 100000}}},
+	}}
+
+	return &reconcileFixture{
+		service:  drawApp.NewReconcileWinnerPaymentsService(drawRepo, prizeRepo, fakeUnitOfWork{drawRepository: drawRepo}, fakeAuditService{}),
+		drawRepo: drawRepo,
+		drawID:   drawID,
+		tierID:   tierID,
+		approver: uuid.New(),
+	}
+}
+
+// addWinner adds a winner of the fixture's prize tier with the given payment status and reference
+func (f *reconcileFixture) addWinner(msisdn, paymentStatus, reference string) uuid.UUID {
+	winner := draw.Winner{
+		ID:                uuid.New(),
+		DrawID:            f.drawID,
+		MSISDN:            msisdn,
+		PrizeTierID:       f.tierID,
+		Status:            draw.WinnerStatusConfirmed,
+		PaymentStatus:     paymentStatus,
+		PaymentReference:  reference,
+		PaymentApprovedBy: f.approver,
+	}
+	f.drawRepo.winners = append(f.drawRepo.winners, winner)
+	return winner.ID
+}
+
+func (f *reconcileFixture) winner(id uuid.UUID) draw.Winner {
+	winner, _ := f.drawRepo.GetWinnerByID(id)
+	return *winner
+}
+
+// reconciledRows maps each statement row to the section of the report it was listed in
+func reconciledRows(output *drawApp.ReconcileWinnerPaymentsOutput) map[int]string {
+	rows := make(map[int]string)
+	for section, reconciled := range map[string][]drawApp.ReconciliationRow{
+		"matched":        output.Matched,
+		"unmatched":      output.Unmatched,
+		"amountMismatch": output.AmountMismatch,
+		"alreadyPaid":    output.AlreadyPaid,
+	} {
+		for _, row := range reconciled {
+			rows[row.Row] = section
+		}
+	}
+	return rows
+}
+
+func TestReconcileWinnerPayments(t *testing.T) {
+	const msisdn = "2348030000001"
+
+	tests := []struct {
+		name         string
+		setup        func(f *reconcileFixture) uuid.UUID
+		statement    []string
+		reconciledBy func(f *reconcileFixture) uuid.UUID
+		rows         map[int]string
+		reason       string
+		status       string
+	}{
+		{
+			name:      "matched",
+			setup:     func(f *reconcileFixture) uuid.UUID { return f.addWinner(msisdn, draw.PaymentStatusApproved, "") },
+			statement: []string{"08030000001,\"100,000.00\",BNK-1"},
+			rows:      map[int]string{2: "matched"},
+			status:    draw.PaymentStatusPaid,
+		},
+		{
+			name: "no winner",
+			setup: func(f *reconcileFixture) uuid.UUID {
+				return f.addWinner("2348030000009", draw.PaymentStatusApproved, "")
+			},
+			statement: []string{msisdn + ",100000,BNK-1"},
+			rows:      map[int]string{2: "unmatched"},
+			reason:    "No prize winner with this MSISDN",
+			status:    draw.PaymentStatusApproved,
+		},
+		{
+			name:      "amount mismatch",
+			setup:     func(f *reconcileFixture) uuid.UUID { return f.addWinner(msisdn, draw.PaymentStatusApproved, "") },
+			statement: []string{msisdn + ",50000,BNK-1"},
+			rows:      map[int]string{2: "amountMismatch"},
+			reason:    "does not match the prize value 100000.00",
+			status:    draw.PaymentStatusApproved,
+		},
+		{
+			name:      "already paid",
+			setup:     func(f *reconcileFixture) uuid.UUID { return f.addWinner(msisdn, draw.PaymentStatusPaid, "BNK-1") },
+			statement: []string{msisdn + ",100000,BNK-1"},
+			rows:      map[int]string{2: "alreadyPaid"},
+			reason:    "already paid under this reference",
+			status:    draw.PaymentStatusPaid,
+		},
+		{
+			name: "duplicate reference",
+			setup: func(f *reconcileFixture) uuid.UUID {
+				f.addWinner("2348030000002", draw.PaymentStatusApproved, "")
+				return f.addWinner(msisdn, draw.PaymentStatusApproved, "")
+			},
+			statement: []string{"2348030000002,100000,BNK-1", msisdn + ",100000,BNK-1"},
+			rows:      map[int]string{2: "matched", 3: "unmatched"},
+			reason:    "Reference appears more than once in the statement",
+			status:    draw.PaymentStatusApproved,
+		},
+		{
+			name:         "maker-checker rejection",
+			setup:        func(f *reconcileFixture) uuid.UUID { return f.addWinner(msisdn, draw.PaymentStatusApproved, "") },
+			statement:    []string{msisdn + ",100000,BNK-1"},
+			reconciledBy: func(f *reconcileFixture) uuid.UUID { return f.approver },
+			rows:         map[int]string{2: "unmatched"},
+			reason:       "other than the one who approved it",
+			status:       draw.PaymentStatusApproved,
+		},
+		{
+			name: "processing payout matched by its reference",
+			setup: func(f *reconcileFixture) uuid.UUID {
+				return f.addWinner(msisdn, draw.PaymentStatusProcessing, "PAYOUT-7")
+			},
+			statement:    []string{msisdn + ",100000,PAYOUT-7"},
+			reconciledBy: func(f *reconcileFixture) uuid.UUID { return f.approver },
+			rows:         map[int]string{2: "matched"},
+			status:       draw.PaymentStatusPaid,
+		},
+		{
+			name: "processing payout under another reference",
+			setup: func(f *reconcileFixture) uuid.UUID {
+				return f.addWinner(msisdn, draw.PaymentStatusProcessing, "PAYOUT-7")
+			},
+			statement: []string{msisdn + ",100000,BNK-1"},
+			rows:      map[int]string{2: "unmatched"},
+			reason:    "processing under reference PAYOUT-7",
+			status:    draw.PaymentStatusProcessing,
+		},
+		{
+			name: "reference picks the processing payout over an approved prize",
+			setup: func(f *reconcileFixture) uuid.UUID {
+				f.addWinner(msisdn, draw.PaymentStatusApproved, "")
+				return f.addWinner(msisdn, draw.PaymentStatusProcessing, "PAYOUT-7")
+			},
+			statement: []string{msisdn + ",100000,PAYOUT-7"},
+			rows:      map[int]string{2: "matched"},
+			status:    draw.PaymentStatusPaid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixture := newReconcileFixture()
+			winnerID := tt.setup(fixture)
+			reconciledBy := uuid.New()
+			if tt.reconciledBy != nil {
+				reconciledBy = tt.reconciledBy(fixture)
+			}
+
+			output, err := fixture.service.ReconcileWinnerPayments(context.Background(), drawApp.ReconcileWinnerPaymentsInput{
+				Rows:         spreadsheet.NewCSVReader(strings.NewReader("MSISDN,Amount,Reference\n" + strings.Join(tt.statement, "\n"))),
+				ReconciledBy: reconciledBy,
+				FileName:     "statement.csv",
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, len(tt.statement), output.TotalRows)
+			assert.Equal(t, tt.rows, reconciledRows(output))
+			if tt.reason != "" {
+				reported := append(append(output.Unmatched, output.AmountMismatch...), output.AlreadyPaid...)
+				require.Len(t, reported, 1)
+				assert.Contains(t, reported[0].Reason, tt.reason)
+			}
+
+			winner := fixture.winner(winnerID)
+			assert.Equal(t, tt.status, winner.PaymentStatus)
+			if tt.status == draw.PaymentStatusPaid && tt.rows[2] == "matched" {
+				assert.Equal(t, winnerID.String(), output.Matched[0].WinnerID)
+				assert.NotNil(t, winner.PaidAt)
+			}
+		})
+	}
+}
+
+func TestReconcileWinnerPayments_DryRunMarksNothingPaid(t *testing.T) {
+	fixture := newReconcileFixture()
+	winnerID := fixture.addWinner("2348030000001", draw.PaymentStatusApproved, "")
+
+	output, err := fixture.service.ReconcileWinnerPayments(context.Background(), drawApp.ReconcileWinnerPaymentsInput{
+		Rows:         spreadsheet.NewCSVReader(strings.NewReader("MSISDN,Amount,Reference\n2348030000001,100000,BNK-1\n")),
+		ReconciledBy: uuid.New(),
+		DryRun:       true,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, output.Matched, 1)
+	assert.Equal(t, winnerID.String(), output.Matched[0].WinnerID)
+	assert.Equal(t, draw.PaymentStatusApproved, fixture.winner(winnerID).PaymentStatus)
+	assert.Empty(t, fixture.drawRepo.writes)
+}
@@ -22,6 +22,6 @@ type Repository interface {
 	GetWinnerByID(id uuid.UUID) (*drawDomain.Winner, error)
 	UpdateWinner(winner *drawDomain.Winner) error
 	GetRunnerUps(drawID uuid.UUID, prizeTierID uuid.UUID, limit int) ([]drawDomain.Winner, error)
-	ListWinners(ctx context.Context, page, pageSize int, startDate, endDate string) ([]*drawDomain.Winner, int, error)
+	ListWinners(ctx context.Context, filters drawDomain.WinnerFilters, page, pageSize int) ([]*drawDomain.Winner, int, error)
 	ExecuteDraw(drawDate time.Time, prizeStructureID uuid.UUID, executedByAdminID uuid.UUID, eligibleParticipants []participant.Participant, prizeTiers []prize.PrizeTier) (*drawDomain.Draw, error)
 }
@@ -0,0 +1,136 @@
+package draw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/prize"
+)
+
+// ScheduleDrawService provides functionality for scheduling draws ahead of their date
+type ScheduleDrawService struct {
+	drawRepository  draw.DrawRepository
+	prizeRepository prize.PrizeRepository
+	unitOfWork      draw.UnitOfWork
+	auditService    audit.AuditService
+}
+
+// NewScheduleDrawService creates a new ScheduleDrawService
+func NewScheduleDrawService(
+	drawRepository draw.DrawRepository,
+	prizeRepository prize.PrizeRepository,
+	unitOfWork draw.UnitOfWork,
+	auditService audit.AuditService,
+) *ScheduleDrawService {
+	return &ScheduleDrawService{
+		drawRepository:  drawRepository,
+		prizeRepository: prizeRepository,
+		unitOfWork:      unitOfWork,
+		auditService:    auditService,
+	}
+}
+
+// ScheduleDrawInput defines the input for the ScheduleDraw use case
+type ScheduleDrawInput struct {
+	DrawDate           time.Time
+	PrizeStructureID   uuid.UUID
+	ScheduledByAdminID uuid.UUID
+}
+
+// ScheduleDrawOutput defines the output for the ScheduleDraw use case
+type ScheduleDrawOutput struct {
+	DrawID           uuid.UUID
+	DrawDate         time.Time
+	PrizeStructureID uuid.UUID
+	Status           string
+	CreatedAt        time.Time
+}
+
+// ScheduleDraw creates a draw in the Scheduled status. It is executed in place when
+// ExecuteDraw runs for its date.
+func (s *ScheduleDrawService) ScheduleDraw(ctx context.Context, input ScheduleDrawInput) (*ScheduleDrawOutput, error) {
+	// Validate input
+	if err := draw.ValidateDrawDate(input.DrawDate); err != nil {
+		return nil, draw.NewDrawError(draw.ErrInvalidDrawDate, err.Error(), nil)
+	}
+
+	if input.PrizeStructureID == uuid.Nil {
+		return nil, errors.New("prize structure ID is required")
+	}
+
+	if input.ScheduledByAdminID == uuid.Nil {
+		return nil, errors.New("scheduled by admin ID is required")
+	}
+
+	today := time.Now().Format("2006-01-02")
+	if input.DrawDate.Format("2006-01-02") < today {
+		return nil, draw.NewDrawError(draw.ErrInvalidDrawDate, "Draw date cannot be in the past", nil)
+	}
+
+	// Make sure the prize structure exists
+	if _, err := s.prizeRepository.GetPrizeStructureByID(input.PrizeStructureID); err != nil {
+		return nil, fmt.Errorf("failed to get prize structure: %w", err)
+	}
+
+	now := time.Now()
+	scheduledDraw := &draw.Draw{
+		ID:               uuid.New(),
+		DrawDate:         input.DrawDate,
+		PrizeStructureID: input.PrizeStructureID,
+		Status:           draw.StatusScheduled,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+
+	// Check for a draw on the date with the date locked, so a draw executed or scheduled
+	// for it at the same time cannot slip in before this one is created
+	err := s.unitOfWork.Do(func(drawRepository draw.DrawRepository) error {
+		if err := drawRepository.LockDate(input.DrawDate); err != nil {
+			return err
+		}
+
+		existingDraw, err := drawRepository.GetByDate(input.DrawDate)
+		if err != nil {
+			return fmt.Errorf("failed to check for existing draw: %w", err)
+		}
+
+		if existingDraw != nil {
+			return draw.NewDrawError(draw.ErrDrawAlreadyExists, "Draw already exists for this date", nil)
+		}
+
+		if err := drawRepository.Create(scheduledDraw); err != nil {
+			return fmt.Errorf("failed to create draw: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Log audit
+	if err := s.auditService.LogAudit(
+		"SCHEDULE_DRAW",
+		"Draw",
+		scheduledDraw.ID,
+		input.ScheduledByAdminID,
+		fmt.Sprintf("Draw scheduled for date %s", input.DrawDate.Format("2006-01-02")),
+		fmt.Sprintf("Prize structure: %s", input.PrizeStructureID),
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
+	return &ScheduleDrawOutput{
+		DrawID:           scheduledDraw.ID,
+		DrawDate:         scheduledDraw.DrawDate,
+		PrizeStructureID: scheduledDraw.PrizeStructureID,
+		Status:           scheduledDraw.Status,
+		CreatedAt:        scheduledDraw.CreatedAt,
+	}, nil
+}
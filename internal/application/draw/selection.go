@@ -0,0 +1,250 @@
+package draw
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/prize"
+)
+
+// SelectionAlgorithmV1 draws entries with a SHA-256 counter mode generator
+// keyed by the committed seed. Each pick is a uniform ticket number in
+// [0, total entries); tickets belonging to an MSISDN that was already
+// selected are redrawn.
+const SelectionAlgorithmV1 = "sha256-ctr-v1"
+
+// SelectionAlgorithmV2 uses the same generator but samples without replacement:
+// each pick is a uniform ticket over the points of MSISDNs not yet selected,
+// resolved through a Fenwick tree, and the selected MSISDN's points are removed.
+// Memory is proportional to the number of MSISDNs, not the number of points.
+const SelectionAlgorithmV2 = "sha256-fenwick-v2"
+
+// CurrentSelectionAlgorithm is the algorithm used for new draws. Older versions
+// are kept so that draws executed with them can still be verified.
+const CurrentSelectionAlgorithm = SelectionAlgorithmV2
+
+// seedSize is the number of random bytes in a draw seed
+const seedSize = 32
+
+// Selection is a single pick made by the draw algorithm
+type Selection struct {
+	PrizeTierID  uuid.UUID `json:"prizeTierId"`
+	MSISDN       string    `json:"msisdn"`
+	IsRunnerUp   bool      `json:"isRunnerUp"`
+	RunnerUpRank int       `json:"runnerUpRank"`
+}
+
+// GenerateSeed returns a new random draw seed from the operating system CSPRNG
+func GenerateSeed() ([]byte, error) {
+	seed := make([]byte, seedSize)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, fmt.Errorf("failed to generate draw seed: %w", err)
+	}
+	return seed, nil
+}
+
+// HashSeed returns the hex encoded SHA-256 commitment of a seed
+func HashSeed(seed []byte) string {
+	sum := sha256.Sum256(seed)
+	return hex.EncodeToString(sum[:])
+}
+
+// HashEntries returns the hex encoded SHA-256 of an entry snapshot.
+// Each entry is hashed as "msisdn:points\n" in snapshot order.
+func HashEntries(entries []draw.Entry) string {
+	h := sha256.New()
+	for _, entry := range entries {
+		fmt.Fprintf(h, "%s:%d\n", entry.MSISDN, entry.Points)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// BuildSelectionPlan determines how many winners and runner-ups are drawn per prize tier, in rank order.
+// Each tier draws its configured number of runner-ups.
+func BuildSelectionPlan(prizeTiers []prize.PrizeTier) []draw.TierSelection {
+	tiers := make([]prize.PrizeTier, len(prizeTiers))
+	copy(tiers, prizeTiers)
+	sort.SliceStable(tiers, func(i, j int) bool {
+		return tiers[i].Rank < tiers[j].Rank
+	})
+
+	plan := make([]draw.TierSelection, 0, len(tiers))
+	for _, tier := range tiers {
+		plan = append(plan, draw.TierSelection{
+			PrizeTierID: tier.ID,
+			Winners:     tier.Quantity,
+			RunnerUps:   tier.NumberOfRunnerUps,
+		})
+	}
+
+	return plan
+}
+
+// RunSelection runs the given version of the draw algorithm. The same seed,
+// entries and plan always produce the same selections.
+func RunSelection(algorithmVersion string, seed []byte, entries []draw.Entry, plan []draw.TierSelection) ([]Selection, error) {
+	switch algorithmVersion {
+	case SelectionAlgorithmV1:
+		return selectV1(seed, entries, plan)
+	case SelectionAlgorithmV2:
+		return selectV2(seed, entries, plan)
+	default:
+		return nil, fmt.Errorf("unknown draw algorithm version %q", algorithmVersion)
+	}
+}
+
+// selectV1 implements SelectionAlgorithmV1
+func selectV1(seed []byte, entries []draw.Entry, plan []draw.TierSelection) ([]Selection, error) {
+	cumulative := make([]uint64, len(entries))
+	var total uint64
+	for i, entry := range entries {
+		if entry.Points < 0 {
+			return nil, fmt.Errorf("entry %s has negative points", entry.MSISDN)
+		}
+		total += uint64(entry.Points)
+		cumulative[i] = total
+	}
+
+	remaining := 0
+	for _, entry := range entries {
+		if entry.Points > 0 {
+			remaining++
+		}
+	}
+
+	rng := newSeededRandom(seed)
+	selected := make([]bool, len(entries))
+
+	pick := func() (int, bool) {
+		if remaining == 0 {
+			return 0, false
+		}
+		for {
+			ticket := rng.intn(total)
+			index := sort.Search(len(cumulative), func(i int) bool {
+				return cumulative[i] > ticket
+			})
+			if !selected[index] {
+				selected[index] = true
+				remaining--
+				return index, true
+			}
+		}
+	}
+
+	return selectByTier(entries, plan, pick)
+}
+
+// selectV2 implements SelectionAlgorithmV2
+func selectV2(seed []byte, entries []draw.Entry, plan []draw.TierSelection) ([]Selection, error) {
+	weights := make([]uint64, len(entries))
+	for i, entry := range entries {
+		if entry.Points < 0 {
+			return nil, fmt.Errorf("entry %s has negative points", entry.MSISDN)
+		}
+		weights[i] = uint64(entry.Points)
+	}
+
+	tree := newFenwickTree(weights)
+	rng := newSeededRandom(seed)
+
+	pick := func() (int, bool) {
+		if tree.total == 0 {
+			return 0, false
+		}
+		index := tree.find(rng.intn(tree.total))
+		tree.subtract(index, weights[index])
+		weights[index] = 0
+		return index, true
+	}
+
+	return selectByTier(entries, plan, pick)
+}
+
+// selectByTier applies the selection rules shared by all algorithm versions:
+// tiers in plan order, winners before runner-ups within a tier, and at most one
+// selection per MSISDN. pick returns false once no unselected entries remain.
+func selectByTier(entries []draw.Entry, plan []draw.TierSelection, pick func() (int, bool)) ([]Selection, error) {
+	selections := make([]Selection, 0)
+	for _, tier := range plan {
+		for i := 0; i < tier.Winners; i++ {
+			index, ok := pick()
+			if !ok {
+				return nil, draw.NewDrawError(draw.ErrInsufficientParticipants, "Not enough eligible participants for all prizes", nil)
+			}
+			selections = append(selections, Selection{
+				PrizeTierID: tier.PrizeTierID,
+				MSISDN:      entries[index].MSISDN,
+			})
+		}
+
+		for i := 0; i < tier.RunnerUps; i++ {
+			index, ok := pick()
+			if !ok {
+				// Not enough participants for runner-ups, but that's okay
+				break
+			}
+			selections = append(selections, Selection{
+				PrizeTierID:  tier.PrizeTierID,
+				MSISDN:       entries[index].MSISDN,
+				IsRunnerUp:   true,
+				RunnerUpRank: i + 1,
+			})
+		}
+	}
+
+	return selections, nil
+}
+
+// seededRandom is a deterministic generator producing SHA-256(seed || counter) blocks
+type seededRandom struct {
+	seed    []byte
+	counter uint64
+	block   [sha256.Size]byte
+	offset  int
+}
+
+// newSeededRandom creates a generator for the given seed
+func newSeededRandom(seed []byte) *seededRandom {
+	return &seededRandom{
+		seed:   seed,
+		offset: sha256.Size,
+	}
+}
+
+// uint64 returns the next 8 bytes of the stream as a big-endian integer
+func (r *seededRandom) uint64() uint64 {
+	if r.offset+8 > sha256.Size {
+		var counter [8]byte
+		binary.BigEndian.PutUint64(counter[:], r.counter)
+		h := sha256.New()
+		h.Write(r.seed)
+		h.Write(counter[:])
+		copy(r.block[:], h.Sum(nil))
+		r.counter++
+		r.offset = 0
+	}
+
+	v := binary.BigEndian.Uint64(r.block[r.offset:])
+	r.offset += 8
+	return v
+}
+
+// intn returns a uniform value in [0, n), rejecting values that would bias the modulo
+func (r *seededRandom) intn(n uint64) uint64 {
+	// 2^64 mod n values at the bottom of the range are discarded
+	threshold := -n % n
+	for {
+		v := r.uint64()
+		if v >= threshold {
+			return v % n
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package draw_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	drawApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/prize"
+)
+
+func testEntries() []draw.Entry {
+	return []draw.Entry{
+		{MSISDN: "2348030000001", Points: 5},
+		{MSISDN: "2348030000002", Points: 1},
+		{MSISDN: "2348030000003", Points: 20},
+		{MSISDN: "2348030000004", Points: 3},
+		{MSISDN: "2348030000005", Points: 8},
+		{MSISDN: "2348030000006", Points: 2},
+	}
+}
+
+var algorithmVersions = []string{
+	drawApp.SelectionAlgorithmV1,
+	drawApp.SelectionAlgorithmV2,
+}
+
+func TestBuildSelectionPlanUsesConfiguredRunnerUps(t *testing.T) {
+	first := prize.PrizeTier{ID: uuid.New(), Rank: 1, Quantity: 1, NumberOfRunnerUps: 3}
+	second := prize.PrizeTier{ID: uuid.New(), Rank: 2, Quantity: 10, NumberOfRunnerUps: 0}
+
+	plan := drawApp.BuildSelectionPlan([]prize.PrizeTier{second, first})
+
+	assert.Equal(t, []draw.TierSelection{
+		{PrizeTierID: first.ID, Winners: 1, RunnerUps: 3},
+		{PrizeTierID: second.ID, Winners: 10, RunnerUps: 0},
+	}, plan)
+}
+
+func TestRunSelectionIsReproducible(t *testing.T) {
+	seed, err := drawApp.GenerateSeed()
+	require.NoError(t, err)
+
+	plan := []draw.TierSelection{
+		{PrizeTierID: uuid.New(), Winners: 1, RunnerUps: 1},
+		{PrizeTierID: uuid.New(), Winners: 2, RunnerUps: 1},
+	}
+
+	for _, version := range algorithmVersions {
+		t.Run(version, func(t *testing.T) {
+			first, err := drawApp.RunSelection(version, seed, testEntries(), plan)
+			require.NoError(t, err)
+			second, err := drawApp.RunSelection(version, seed, testEntries(), plan)
+			require.NoError(t, err)
+
+			assert.Equal(t, first, second)
+			require.Len(t, first, 5)
+
+			// Tier order, winners before runner-ups
+			assert.Equal(t, plan[0].PrizeTierID, first[0].PrizeTierID)
+			assert.False(t, first[0].IsRunnerUp)
+			assert.True(t, first[1].IsRunnerUp)
+			assert.Equal(t, plan[1].PrizeTierID, first[2].PrizeTierID)
+			assert.True(t, first[4].IsRunnerUp)
+
+			seen := make(map[string]bool)
+			for _, selection := range first {
+				assert.False(t, seen[selection.MSISDN], "MSISDN %s selected twice", selection.MSISDN)
+				seen[selection.MSISDN] = true
+			}
+		})
+	}
+}
+
+func TestRunSelectionNotEnoughParticipants(t *testing.T) {
+	plan := []draw.TierSelection{{PrizeTierID: uuid.New(), Winners: 7}}
+
+	for _, version := range algorithmVersions {
+		_, err := drawApp.RunSelection(version, []byte("seed"), testEntries(), plan)
+		var drawErr *draw.DrawError
+		require.ErrorAs(t, err, &drawErr, version)
+		assert.Equal(t, draw.ErrInsufficientParticipants, drawErr.Code, version)
+	}
+}
+
+func TestRunSelectionRunnerUpsStopWhenExhausted(t *testing.T) {
+	plan := []draw.TierSelection{{PrizeTierID: uuid.New(), Winners: 5, RunnerUps: 3}}
+
+	for _, version := range algorithmVersions {
+		selections, err := drawApp.RunSelection(version, []byte("seed"), testEntries(), plan)
+		require.NoError(t, err, version)
+		assert.Len(t, selections, 6, version)
+	}
+}
+
+func TestRunSelectionIsWeightedByPoints(t *testing.T) {
+	entries := []draw.Entry{
+		{MSISDN: "2348030000001", Points: 1},
+		{MSISDN: "2348030000002", Points: 99},
+	}
+	plan := []draw.TierSelection{{PrizeTierID: uuid.New(), Winners: 1}}
+
+	wins := 0
+	for i := 0; i < 1000; i++ {
+		selections, err := drawApp.RunSelection(drawApp.SelectionAlgorithmV2, []byte(fmt.Sprintf("seed-%d", i)), entries, plan)
+		require.NoError(t, err)
+		if selections[0].MSISDN == "2348030000002" {
+			wins++
+		}
+	}
+
+	assert.InDelta(t, 990, wins, 25)
+}
+
+func TestHashSeedCommitment(t *testing.T) {
+	assert.Equal(t,
+		"2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+		drawApp.HashSeed([]byte("foo")))
+}
+
+// BenchmarkRunSelection draws 100 winners from 10,000 MSISDNs while the points held
+// by each MSISDN grow. Bytes and allocations per op stay flat because selection
+// works on cumulative point ranges rather than one slot per point.
+func BenchmarkRunSelection(b *testing.B) {
+	seed := []byte("benchmark-seed")
+	plan := []draw.TierSelection{
+		{PrizeTierID: uuid.New(), Winners: 10, RunnerUps: 5},
+		{PrizeTierID: uuid.New(), Winners: 90, RunnerUps: 45},
+	}
+
+	for _, pointsPerMSISDN := range []int{1, 100, 10000, 1000000} {
+		entries := make([]draw.Entry, 10000)
+		for i := range entries {
+			entries[i] = draw.Entry{
+				MSISDN: fmt.Sprintf("234803%07d", i),
+				Points: pointsPerMSISDN + i%7,
+			}
+		}
+
+		b.Run(fmt.Sprintf("totalEntries=%d", pointsPerMSISDN*len(entries)), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := drawApp.RunSelection(drawApp.CurrentSelectionAlgorithm, seed, entries, plan); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package draw
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+)
+
+// Tier fill statuses reported by a simulated draw
+const (
+	TierFillFilled            = "Filled"
+	TierFillRunnerUpShortfall = "RunnerUpShortfall" // All winners drawn but fewer runner-ups than planned
+	TierFillWinnerShortfall   = "WinnerShortfall"   // Not every prize can be awarded; the real draw would fail
+)
+
+// SimulateDrawOutput defines the output for the SimulateDraw use case
+type SimulateDrawOutput struct {
+	DrawDate             string               `json:"drawDate"`
+	PrizeStructureID     uuid.UUID            `json:"prizeStructureId"`
+	CandidateMSISDNs     int                  `json:"candidateMsisdns"`
+	CandidateEntries     int                  `json:"candidateEntries"`
+	TotalEligibleMSISDNs int                  `json:"totalEligibleMsisdns"`
+	TotalEntries         int                  `json:"totalEntries"`
+	Exclusions           []draw.RuleExclusion `json:"exclusions"`
+	AlgorithmVersion     string               `json:"algorithmVersion"`
+	EntriesHash          string               `json:"entriesHash"`
+	Tiers                []TierSimulation     `json:"tiers"`
+	Errors               []SimulationIssue    `json:"errors"`
+	WouldSucceed         bool                 `json:"wouldSucceed"`
+}
+
+// TierSimulation reports how far a prize tier would be filled
+type TierSimulation struct {
+	PrizeTierID        uuid.UUID `json:"prizeTierId"`
+	PrizeName          string    `json:"prizeName"`
+	Rank               int       `json:"rank"`
+	WinnersRequested   int       `json:"winnersRequested"`
+	WinnersFilled      int       `json:"winnersFilled"`
+	RunnerUpsRequested int       `json:"runnerUpsRequested"`
+	RunnerUpsFilled    int       `json:"runnerUpsFilled"`
+	Status             string    `json:"status"`
+}
+
+// SimulationIssue is an error the real draw would fail with
+type SimulationIssue struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// SimulateDraw runs eligibility and selection for a draw date exactly as ExecuteDraw would,
+// with a throwaway seed, and reports the outcome without persisting a draw or any winners.
+// Conditions that would make the real draw fail are reported in Errors rather than returned.
+func (uc *ExecuteDrawService) SimulateDraw(input ExecuteDrawInput) (*SimulateDrawOutput, error) {
+	// Validate input
+	if input.DrawDate.IsZero() {
+		return nil, errors.New("draw date is required")
+	}
+
+	if input.PrizeStructureID == uuid.Nil {
+		return nil, errors.New("prize structure ID is required")
+	}
+
+	if input.ExecutedByAdminID == uuid.Nil {
+		return nil, errors.New("executed by admin ID is required")
+	}
+
+	output := &SimulateDrawOutput{
+		DrawDate:         input.DrawDate.Format("2006-01-02"),
+		PrizeStructureID: input.PrizeStructureID,
+		AlgorithmVersion: CurrentSelectionAlgorithm,
+		Tiers:            make([]TierSimulation, 0),
+		Errors:           make([]SimulationIssue, 0),
+	}
+
+	existingDraw, err := uc.drawRepository.GetByDate(input.DrawDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for existing draw: %w", err)
+	}
+
+	if err := checkExistingDraw(existingDraw, input.PrizeStructureID); err != nil {
+		output.addIssue(err)
+	}
+
+	prizeStructure, err := uc.prizeRepository.GetPrizeStructureByID(input.PrizeStructureID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prize structure: %w", err)
+	}
+
+	eligibility, err := evaluateEligibility(uc.drawRepository, uc.participantRepository, uc.blacklistRepository, prizeStructure.EligibilityRules, input.DrawDate)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.checkEligibilityConsistency(input.DrawDate, eligibility); err != nil {
+		output.addIssue(err)
+	}
+
+	entries := eligibility.Entries
+	output.CandidateMSISDNs = eligibility.CandidateMSISDNs
+	output.CandidateEntries = eligibility.CandidateEntries
+	output.TotalEligibleMSISDNs = len(entries)
+	output.TotalEntries = eligibility.TotalEntries
+	output.Exclusions = eligibility.Exclusions
+	output.EntriesHash = HashEntries(entries)
+
+	if len(entries) == 0 {
+		output.addIssue(draw.NewDrawError(draw.ErrNoEligibleParticipants, "No eligible participants for draw", nil))
+	}
+
+	plan := BuildSelectionPlan(prizeStructure.Prizes)
+	tiers := make(map[uuid.UUID]int, len(prizeStructure.Prizes))
+	for i, prizeTier := range prizeStructure.Prizes {
+		tiers[prizeTier.ID] = i
+	}
+
+	// Each MSISDN can be selected once, so the tiers are filled in plan order from the
+	// eligible MSISDNs, winners before runner-ups, until none remain
+	available := len(entries)
+	for _, tier := range plan {
+		tierSimulation := TierSimulation{
+			PrizeTierID:        tier.PrizeTierID,
+			WinnersRequested:   tier.Winners,
+			WinnersFilled:      min(tier.Winners, available),
+			RunnerUpsRequested: tier.RunnerUps,
+		}
+		available -= tierSimulation.WinnersFilled
+		tierSimulation.RunnerUpsFilled = min(tier.RunnerUps, available)
+		available -= tierSimulation.RunnerUpsFilled
+
+		if i, ok := tiers[tier.PrizeTierID]; ok {
+			tierSimulation.PrizeName = prizeStructure.Prizes[i].Name
+			tierSimulation.Rank = prizeStructure.Prizes[i].Rank
+		}
+
+		switch {
+		case tierSimulation.WinnersFilled < tierSimulation.WinnersRequested:
+			tierSimulation.Status = TierFillWinnerShortfall
+		case tierSimulation.RunnerUpsFilled < tierSimulation.RunnerUpsRequested:
+			tierSimulation.Status = TierFillRunnerUpShortfall
+		default:
+			tierSimulation.Status = TierFillFilled
+		}
+
+		output.Tiers = append(output.Tiers, tierSimulation)
+	}
+
+	// Run the selection itself so that any error it would raise is reported
+	if len(entries) > 0 {
+		seed, err := GenerateSeed()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := RunSelection(CurrentSelectionAlgorithm, seed, entries, plan); err != nil {
+			output.addIssue(err)
+		}
+	}
+
+	output.WouldSucceed = len(output.Errors) == 0
+
+	// Log audit
+	if err := uc.auditService.LogAudit(
+		"SIMULATE_DRAW",
+		"Draw",
+		uuid.Nil,
+		input.ExecutedByAdminID,
+		fmt.Sprintf("Draw simulated for date %s", output.DrawDate),
+		fmt.Sprintf("Total eligible MSISDNs: %d, Total entries: %d, Would succeed: %t, Errors: %d, Excluded: %s",
+			output.TotalEligibleMSISDNs, output.TotalEntries, output.WouldSucceed, len(output.Errors), formatExclusions(eligibility.Exclusions)),
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
+	return output, nil
+}
+
+// addIssue records an error the real draw would fail with
+func (o *SimulateDrawOutput) addIssue(err error) {
+	var drawErr *draw.DrawError
+	if errors.As(err, &drawErr) {
+		o.Errors = append(o.Errors, SimulationIssue{Code: drawErr.Code, Message: drawErr.Message})
+		return
+	}
+	o.Errors = append(o.Errors, SimulationIssue{Message: err.Error()})
+}
@@ -0,0 +1,82 @@
+package draw_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	drawApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+)
+
+func TestSimulateDraw_MatchesExecuteDrawAndWritesNothing(t *testing.T) {
+	tests := []struct {
+		name       string
+		msisdns    []string
+		tierStatus string
+	}{
+		{
+			name:       "every tier filled",
+			msisdns:    []string{"2348030000003", "2348030000001", "2348030000002"},
+			tierStatus: drawApp.TierFillFilled,
+		},
+		{
+			name:       "runner-up shortfall",
+			msisdns:    []string{"2348030000001"},
+			tierStatus: drawApp.TierFillRunnerUpShortfall,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixture := newDrawFixture(tt.msisdns...)
+
+			simulation, err := fixture.service.SimulateDraw(fixture.input())
+			require.NoError(t, err)
+
+			assert.True(t, simulation.WouldSucceed, "errors: %v", simulation.Errors)
+			assert.Empty(t, fixture.drawRepo.writes)
+			assert.Empty(t, fixture.drawRepo.draws)
+
+			// The real draw over the same participants selects from the same entries with the
+			// same algorithm, and fills the tiers as the simulation reported
+			output, err := fixture.service.ExecuteDraw(fixture.input())
+			require.NoError(t, err)
+
+			executed := fixture.drawRepo.draws[output.DrawID]
+			assert.Equal(t, executed.AlgorithmVersion, simulation.AlgorithmVersion)
+			assert.Equal(t, executed.EntriesHash, simulation.EntriesHash)
+			assert.Equal(t, output.TotalEligibleMSISDNs, simulation.TotalEligibleMSISDNs)
+			assert.Equal(t, output.TotalEntries, simulation.TotalEntries)
+
+			require.Len(t, simulation.Tiers, 1)
+			require.Len(t, output.RunnerUps, 1)
+			tier := simulation.Tiers[0]
+			assert.Equal(t, tt.tierStatus, tier.Status)
+			assert.Equal(t, output.RunnerUps[0].RunnerUpsAvailable, tier.RunnerUpsFilled)
+			assert.Equal(t, len(output.Winners), tier.WinnersFilled+tier.RunnerUpsFilled)
+		})
+	}
+}
+
+func TestSimulateDraw_ReportsWhatWouldStopTheDraw(t *testing.T) {
+	fixture := newDrawFixture("2348030000001", "2348030000002")
+	scheduled := fixture.scheduleDraw(uuid.New())
+	fixture.drawRepo.statsMSISDNs++
+
+	simulation, err := fixture.service.SimulateDraw(fixture.input())
+	require.NoError(t, err)
+
+	assert.False(t, simulation.WouldSucceed)
+	codes := make([]string, 0, len(simulation.Errors))
+	for _, issue := range simulation.Errors {
+		codes = append(codes, issue.Code)
+	}
+	assert.Equal(t, []string{draw.ErrPrizeStructureMismatch, draw.ErrEligibilityMismatch}, codes)
+
+	// Unlike the real draw, the simulation leaves the scheduled draw as it was
+	assert.Empty(t, fixture.drawRepo.writes)
+	assert.Equal(t, scheduled, fixture.drawRepo.draws[scheduled.ID])
+}
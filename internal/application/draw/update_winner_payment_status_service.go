@@ -2,87 +2,151 @@ package draw
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
+
 	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
 )
 
 // UpdateWinnerPaymentStatusInput represents input for UpdateWinnerPaymentStatus
 type UpdateWinnerPaymentStatusInput struct {
-	WinnerID      string
-	PaymentStatus string
-	PaymentNotes  string
-	Notes         string // Added for handler compatibility
-	UpdatedBy     uuid.UUID // Added for handler compatibility
+	WinnerID         uuid.UUID
+	PaymentStatus    string // "Approved", "Paid" or "Failed"
+	PaymentReference string // Required for "Paid"
+	Notes            string
+	UpdatedBy        uuid.UUID
 }
 
 // UpdateWinnerPaymentStatusOutput represents output for UpdateWinnerPaymentStatus
 type UpdateWinnerPaymentStatusOutput struct {
-	Success       bool
-	ID            uuid.UUID
-	MSISDN        string
-	PrizeTierID   uuid.UUID
-	Status        string
-	PaymentStatus string
-	PaymentNotes  string
-	IsRunnerUp    bool
-	RunnerUpRank  int
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	Success           bool
+	ID                uuid.UUID
+	DrawID            uuid.UUID
+	MSISDN            string
+	PrizeTierID       uuid.UUID
+	Status            string
+	PaymentStatus     string
+	PaymentNotes      string
+	PaymentReference  string
+	PaymentApprovedBy uuid.UUID
+	PaymentApprovedAt *time.Time
+	PaidAt            *time.Time
+	PaymentHistory    []draw.PaymentEvent
+	IsRunnerUp        bool
+	RunnerUpRank      int
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
 }
 
-// UpdateWinnerPaymentStatusService handles updating winner payment status
+// UpdateWinnerPaymentStatusService moves a winner's payment through finance's approval flow
 type UpdateWinnerPaymentStatusService struct {
-	repository Repository
+	unitOfWork   draw.UnitOfWork
+	auditService audit.AuditService
 }
 
 // NewUpdateWinnerPaymentStatusService creates a new UpdateWinnerPaymentStatusService
-func NewUpdateWinnerPaymentStatusService(repository Repository) *UpdateWinnerPaymentStatusService {
+func NewUpdateWinnerPaymentStatusService(unitOfWork draw.UnitOfWork, auditService audit.AuditService) *UpdateWinnerPaymentStatusService {
 	return &UpdateWinnerPaymentStatusService{
-		repository: repository,
+		unitOfWork:   unitOfWork,
+		auditService: auditService,
 	}
 }
 
-// UpdateWinnerPaymentStatus updates a winner's payment status
+// UpdateWinnerPaymentStatus approves a winner's payment or records that it was paid or failed.
+// Prizes paid out through a payout provider are settled by the payout instead.
 func (s *UpdateWinnerPaymentStatusService) UpdateWinnerPaymentStatus(ctx context.Context, input UpdateWinnerPaymentStatusInput) (*UpdateWinnerPaymentStatusOutput, error) {
-	// Parse winner ID
-	winnerID, err := uuid.Parse(input.WinnerID)
-	if err != nil {
-		return nil, err
+	if input.WinnerID == uuid.Nil {
+		return nil, errors.New("winner ID is required")
 	}
 
-	// Get winner from repository
-	winner, err := s.repository.GetWinnerByID(winnerID)
-	if err != nil {
-		return nil, err
+	if input.UpdatedBy == uuid.Nil {
+		return nil, errors.New("updated by ID is required")
 	}
 
-	// Update payment status
-	winner.PaymentStatus = input.PaymentStatus
-	
-	// Use Notes if PaymentNotes is empty (for backward compatibility)
-	if input.PaymentNotes != "" {
-		winner.PaymentNotes = input.PaymentNotes
-	} else {
-		winner.PaymentNotes = input.Notes
-	}
+	var winner *draw.Winner
+	var previousStatus string
+	err := s.unitOfWork.Do(func(drawRepository draw.DrawRepository) error {
+		var err error
+		winner, err = drawRepository.GetWinnerByID(input.WinnerID)
+		if err != nil {
+			return err
+		}
 
-	// Save changes
-	err = s.repository.UpdateWinner(winner)
+		// Lock the draw as payouts and runner-up replacements do, then read the winner again
+		if _, err := drawRepository.GetByIDForUpdate(winner.DrawID); err != nil {
+			return fmt.Errorf("failed to lock draw: %w", err)
+		}
+		winner, err = drawRepository.GetWinnerByID(input.WinnerID)
+		if err != nil {
+			return err
+		}
+
+		previousStatus = winner.PaymentStatus
+		if previousStatus == draw.PaymentStatusProcessing {
+			return draw.NewDrawError(draw.ErrInvalidPaymentTransition, "A payout of the prize is in progress; its outcome sets the payment status", nil)
+		}
+
+		now := time.Now()
+		switch input.PaymentStatus {
+		case draw.PaymentStatusApproved:
+			err = winner.ApprovePayment(input.UpdatedBy, input.Notes, now)
+		case draw.PaymentStatusPaid:
+			err = winner.MarkPaid(input.UpdatedBy, input.PaymentReference, input.Notes, now)
+		case draw.PaymentStatusFailed:
+			err = winner.MarkPaymentFailed(input.UpdatedBy, input.PaymentReference, input.Notes, now)
+		default:
+			err = draw.NewDrawError(draw.ErrInvalidPaymentTransition, fmt.Sprintf("Payment status must be %s, %s or %s", draw.PaymentStatusApproved, draw.PaymentStatusPaid, draw.PaymentStatusFailed), nil)
+		}
+		if err != nil {
+			return err
+		}
+
+		return drawRepository.UpdateWinner(winner)
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	action := map[string]string{
+		draw.PaymentStatusApproved: "APPROVE_WINNER_PAYMENT",
+		draw.PaymentStatusPaid:     "MARK_WINNER_PAID",
+		draw.PaymentStatusFailed:   "MARK_WINNER_PAYMENT_FAILED",
+	}[winner.PaymentStatus]
+
+	// Log audit
+	if err := s.auditService.LogAudit(
+		action,
+		"Winner",
+		winner.ID,
+		input.UpdatedBy,
+		fmt.Sprintf("Payment of winner %s changed from %s to %s", winner.MSISDN, previousStatus, winner.PaymentStatus),
+		fmt.Sprintf("Draw: %s, Reference: %s, Notes: %s", winner.DrawID, input.PaymentReference, input.Notes),
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
 	return &UpdateWinnerPaymentStatusOutput{
-		Success:       true,
-		ID:            winner.ID,
-		MSISDN:        winner.MSISDN,
-		PrizeTierID:   winner.PrizeTierID,
-		Status:        winner.Status,
-		PaymentStatus: winner.PaymentStatus,
-		PaymentNotes:  winner.PaymentNotes,
-		IsRunnerUp:    winner.IsRunnerUp,
-		RunnerUpRank:  winner.RunnerUpRank,
-		CreatedAt:     winner.CreatedAt,
-		UpdatedAt:     time.Now(),
+		Success:           true,
+		ID:                winner.ID,
+		DrawID:            winner.DrawID,
+		MSISDN:            winner.MSISDN,
+		PrizeTierID:       winner.PrizeTierID,
+		Status:            winner.Status,
+		PaymentStatus:     winner.PaymentStatus,
+		PaymentNotes:      winner.PaymentNotes,
+		PaymentReference:  winner.PaymentReference,
+		PaymentApprovedBy: winner.PaymentApprovedBy,
+		PaymentApprovedAt: winner.PaymentApprovedAt,
+		PaidAt:            winner.PaidAt,
+		PaymentHistory:    winner.PaymentHistory,
+		IsRunnerUp:        winner.IsRunnerUp,
+		RunnerUpRank:      winner.RunnerUpRank,
+		CreatedAt:         winner.CreatedAt,
+		UpdatedAt:         winner.UpdatedAt,
 	}, nil
 }
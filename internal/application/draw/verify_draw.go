@@ -0,0 +1,141 @@
+package draw
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+)
+
+// VerifyDrawService re-runs a completed draw from its revealed seed and entry snapshot
+type VerifyDrawService struct {
+	drawRepository draw.DrawRepository
+}
+
+// NewVerifyDrawService creates a new VerifyDrawService
+func NewVerifyDrawService(drawRepository draw.DrawRepository) *VerifyDrawService {
+	return &VerifyDrawService{
+		drawRepository: drawRepository,
+	}
+}
+
+// VerifyDrawInput defines the input for the VerifyDraw use case
+type VerifyDrawInput struct {
+	DrawID uuid.UUID
+}
+
+// VerifyDrawOutput defines the output for the VerifyDraw use case
+type VerifyDrawOutput struct {
+	DrawID            uuid.UUID   `json:"drawId"`
+	AlgorithmVersion  string      `json:"algorithmVersion"`
+	SeedHash          string      `json:"seedHash"`
+	Seed              string      `json:"seed"`
+	SeedHashValid     bool        `json:"seedHashValid"`
+	EntriesHash       string      `json:"entriesHash"`
+	EntriesHashValid  bool        `json:"entriesHashValid"`
+	TotalEntries      int         `json:"totalEntries"`
+	ExpectedWinners   []Selection `json:"expectedWinners"`
+	WinnersMatch      bool        `json:"winnersMatch"`
+	Mismatches        []string    `json:"mismatches"`
+	Verified          bool        `json:"verified"`
+}
+
+// VerifyDraw checks the seed against its commitment, the stored entries against
+// their hash, and that re-running the selection produces the recorded winners
+func (s *VerifyDrawService) VerifyDraw(ctx context.Context, input VerifyDrawInput) (*VerifyDrawOutput, error) {
+	if input.DrawID == uuid.Nil {
+		return nil, errors.New("draw ID is required")
+	}
+
+	drawEntity, err := s.drawRepository.GetByID(input.DrawID)
+	if err != nil {
+		return nil, err
+	}
+
+	if drawEntity.SeedHash == "" || drawEntity.AlgorithmVersion == "" {
+		return nil, draw.NewDrawError(draw.ErrDrawNotVerifiable, "Draw was executed without a seed commitment", nil)
+	}
+
+	if drawEntity.Seed == "" {
+		return nil, draw.NewDrawError(draw.ErrDrawNotVerifiable, "Draw seed has not been revealed", nil)
+	}
+
+	seed, err := hex.DecodeString(drawEntity.Seed)
+	if err != nil {
+		return nil, draw.NewDrawError(draw.ErrDrawNotVerifiable, "Stored draw seed is malformed", err)
+	}
+
+	entries, err := s.drawRepository.ListEntries(drawEntity.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draw entries: %w", err)
+	}
+
+	totalEntries := 0
+	for _, entry := range entries {
+		totalEntries += entry.Points
+	}
+
+	output := &VerifyDrawOutput{
+		DrawID:           drawEntity.ID,
+		AlgorithmVersion: drawEntity.AlgorithmVersion,
+		SeedHash:         drawEntity.SeedHash,
+		Seed:             drawEntity.Seed,
+		SeedHashValid:    HashSeed(seed) == drawEntity.SeedHash,
+		EntriesHash:      drawEntity.EntriesHash,
+		EntriesHashValid: HashEntries(entries) == drawEntity.EntriesHash,
+		TotalEntries:     totalEntries,
+		Mismatches:       make([]string, 0),
+	}
+
+	expected, err := RunSelection(drawEntity.AlgorithmVersion, seed, entries, drawEntity.SelectionPlan)
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-run draw selection: %w", err)
+	}
+	output.ExpectedWinners = expected
+
+	output.Mismatches = compareSelections(expected, drawEntity.Winners)
+	output.WinnersMatch = len(output.Mismatches) == 0
+	output.Verified = output.SeedHashValid && output.EntriesHashValid && output.WinnersMatch
+
+	return output, nil
+}
+
+// compareSelections lists the differences between the re-run selections and the recorded winners.
+// Winners are matched on prize tier and runner-up rank (0 for winners), which are kept
+// when a runner-up is promoted, so later status changes do not cause mismatches.
+func compareSelections(expected []Selection, winners []draw.Winner) []string {
+	type key struct {
+		prizeTierID  uuid.UUID
+		runnerUpRank int
+		msisdn       string
+	}
+
+	recorded := make(map[key]int)
+	for _, w := range winners {
+		recorded[key{w.PrizeTierID, w.RunnerUpRank, w.MSISDN}]++
+	}
+
+	mismatches := make([]string, 0)
+	for _, e := range expected {
+		k := key{e.PrizeTierID, e.RunnerUpRank, e.MSISDN}
+		if recorded[k] > 0 {
+			recorded[k]--
+			continue
+		}
+		mismatches = append(mismatches, fmt.Sprintf("expected %s for prize tier %s (runner-up rank %d) was not recorded",
+			e.MSISDN, e.PrizeTierID, e.RunnerUpRank))
+	}
+
+	for k, count := range recorded {
+		for i := 0; i < count; i++ {
+			mismatches = append(mismatches, fmt.Sprintf("recorded %s for prize tier %s (runner-up rank %d) was not produced by the seed",
+				k.msisdn, k.prizeTierID, k.runnerUpRank))
+		}
+	}
+
+	return mismatches
+}
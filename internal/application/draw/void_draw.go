@@ -0,0 +1,89 @@
+package draw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/user"
+)
+
+// VoidDrawService provides functionality for voiding draws
+type VoidDrawService struct {
+	drawRepository draw.DrawRepository
+	auditService   audit.AuditService
+}
+
+// NewVoidDrawService creates a new VoidDrawService
+func NewVoidDrawService(
+	drawRepository draw.DrawRepository,
+	auditService audit.AuditService,
+) *VoidDrawService {
+	return &VoidDrawService{
+		drawRepository: drawRepository,
+		auditService:   auditService,
+	}
+}
+
+// VoidDrawInput defines the input for the VoidDraw use case
+type VoidDrawInput struct {
+	DrawID          uuid.UUID
+	Reason          string
+	VoidedByAdminID uuid.UUID
+	VoidedByRole    string
+}
+
+// VoidDrawOutput defines the output for the VoidDraw use case
+type VoidDrawOutput struct {
+	DrawID         uuid.UUID
+	PreviousStatus string
+	Status         string
+	VoidReason     string
+	VoidedAt       time.Time
+}
+
+// VoidDraw cancels a scheduled, failed or completed draw. Only a super admin may void a
+// draw and a reason is mandatory. A voided draw's date can then be redrawn.
+func (s *VoidDrawService) VoidDraw(ctx context.Context, input VoidDrawInput) (*VoidDrawOutput, error) {
+	// Validate input
+	if input.DrawID == uuid.Nil {
+		return nil, errors.New("draw ID is required")
+	}
+
+	if input.VoidedByAdminID == uuid.Nil {
+		return nil, errors.New("voided by admin ID is required")
+	}
+
+	if !user.HasPermission(input.VoidedByRole, user.PermDrawVoid) {
+		return nil, draw.NewDrawError(draw.ErrVoidNotAuthorized, "Only a super admin can void a draw", nil)
+	}
+
+	drawEntity, err := s.drawRepository.GetByID(input.DrawID)
+	if err != nil {
+		return nil, err
+	}
+
+	transition, err := drawEntity.Void(input.Reason, input.VoidedByAdminID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.drawRepository.Update(drawEntity); err != nil {
+		return nil, fmt.Errorf("failed to update draw: %w", err)
+	}
+
+	logStatusTransitions(s.auditService, input.VoidedByAdminID, []draw.StatusTransition{transition})
+
+	return &VoidDrawOutput{
+		DrawID:         drawEntity.ID,
+		PreviousStatus: transition.From,
+		Status:         drawEntity.Status,
+		VoidReason:     drawEntity.VoidReason,
+		VoidedAt:       transition.At,
+	}, nil
+}
@@ -0,0 +1,191 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/notification"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/prize"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/util"
+)
+
+// dispatchBatchSize is the number of winners enqueued, and of notifications sent, per run
+const dispatchBatchSize = 100
+
+// DispatchWinnerNotificationsService writes a notification to the outbox for every winner
+// awaiting one and sends the outbox notifications that are due, retrying failed sends
+type DispatchWinnerNotificationsService struct {
+	notificationRepository notification.NotificationRepository
+	drawRepository         draw.DrawRepository
+	prizeRepository        prize.PrizeRepository
+	notifier               notification.Notifier
+	lock                   notification.DispatchLock
+	maxAttempts            int
+}
+
+// NewDispatchWinnerNotificationsService creates a new DispatchWinnerNotificationsService
+func NewDispatchWinnerNotificationsService(
+	notificationRepository notification.NotificationRepository,
+	drawRepository draw.DrawRepository,
+	prizeRepository prize.PrizeRepository,
+	notifier notification.Notifier,
+	lock notification.DispatchLock,
+	maxAttempts int,
+) *DispatchWinnerNotificationsService {
+	return &DispatchWinnerNotificationsService{
+		notificationRepository: notificationRepository,
+		drawRepository:         drawRepository,
+		prizeRepository:        prizeRepository,
+		notifier:               notifier,
+		lock:                   lock,
+		maxAttempts:            maxAttempts,
+	}
+}
+
+// DispatchIssue describes a winner or notification the run could not handle
+type DispatchIssue struct {
+	WinnerID       uuid.UUID
+	NotificationID uuid.UUID // uuid.Nil when the winner could not be enqueued
+	Error          string
+}
+
+// DispatchWinnerNotificationsOutput defines the output for the DispatchWinnerNotifications use case
+type DispatchWinnerNotificationsOutput struct {
+	LockAcquired bool // False when another server is dispatching
+	Enqueued     int
+	Sent         int
+	Retrying     int
+	Failed       int // Gave up after the last attempt
+	Issues       []DispatchIssue
+}
+
+// DispatchWinnerNotifications enqueues and sends one batch of winner notifications
+func (s *DispatchWinnerNotificationsService) DispatchWinnerNotifications(ctx context.Context, now time.Time) (*DispatchWinnerNotificationsOutput, error) {
+	output := &DispatchWinnerNotificationsOutput{
+		Issues: make([]DispatchIssue, 0),
+	}
+
+	acquired, err := s.lock.TryRun(func() error {
+		if err := s.enqueue(output, now); err != nil {
+			return err
+		}
+		return s.send(ctx, output, now)
+	})
+	output.LockAcquired = acquired
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+// enqueue renders the message of each winner awaiting notification into the outbox
+func (s *DispatchWinnerNotificationsService) enqueue(output *DispatchWinnerNotificationsOutput, now time.Time) error {
+	winners, err := s.drawRepository.ListWinnersAwaitingNotification(dispatchBatchSize)
+	if err != nil {
+		return err
+	}
+
+	draws := make(map[uuid.UUID]*draw.Draw)
+	tiers := make(map[uuid.UUID]*prize.PrizeTier)
+
+	for _, winner := range winners {
+		text, err := s.renderMessage(&winner, draws, tiers)
+		if err != nil {
+			output.Issues = append(output.Issues, DispatchIssue{WinnerID: winner.ID, Error: err.Error()})
+			continue
+		}
+
+		n := notification.NewWinnerNotification(winner.ID, winner.DrawID, winner.MSISDN, text, s.maxAttempts, now)
+		created, err := s.notificationRepository.Create(n)
+		if err != nil {
+			output.Issues = append(output.Issues, DispatchIssue{WinnerID: winner.ID, Error: err.Error()})
+			continue
+		}
+		if created {
+			output.Enqueued++
+		}
+	}
+
+	return nil
+}
+
+// renderMessage renders a winner's message from the template of the winner's prize tier
+func (s *DispatchWinnerNotificationsService) renderMessage(winner *draw.Winner, draws map[uuid.UUID]*draw.Draw, tiers map[uuid.UUID]*prize.PrizeTier) (string, error) {
+	drawEntity, ok := draws[winner.DrawID]
+	if !ok {
+		var err error
+		drawEntity, err = s.drawRepository.GetByID(winner.DrawID)
+		if err != nil {
+			return "", fmt.Errorf("failed to get draw: %w", err)
+		}
+		draws[winner.DrawID] = drawEntity
+	}
+
+	tier, ok := tiers[winner.PrizeTierID]
+	if !ok {
+		var err error
+		tier, err = s.prizeRepository.GetPrizeTierByID(winner.PrizeTierID)
+		if err != nil {
+			return "", fmt.Errorf("failed to get prize tier: %w", err)
+		}
+		tiers[winner.PrizeTierID] = tier
+	}
+
+	data := notification.WinnerMessageData{
+		MSISDN:       winner.MSISDN,
+		MaskedMSISDN: util.MaskMSISDN(winner.MSISDN),
+		PrizeName:    tier.Name,
+		PrizeValue:   util.FormatCurrency(tier.Value, ""),
+		DrawDate:     drawEntity.DrawDate.Format("2 January 2006"),
+	}
+	if winner.ClaimDeadline != nil {
+		data.ClaimDeadline = winner.ClaimDeadline.Format("2 January 2006 15:04 MST")
+	}
+
+	return notification.RenderWinnerMessage(tier.NotificationTemplate, data)
+}
+
+// send sends the notifications that are due
+func (s *DispatchWinnerNotificationsService) send(ctx context.Context, output *DispatchWinnerNotificationsOutput, now time.Time) error {
+	due, err := s.notificationRepository.ListDue(now, dispatchBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for i := range due {
+		// Leave the rest for the next run when the server is shutting down
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		n := &due[i]
+		result, err := s.notifier.Send(ctx, notification.Message{
+			ID:     n.ID,
+			MSISDN: n.MSISDN,
+			Text:   n.Message,
+		})
+		if err != nil {
+			n.MarkFailed(err.Error(), true, time.Now())
+			if n.Status == notification.StatusFailed {
+				output.Failed++
+			} else {
+				output.Retrying++
+			}
+			output.Issues = append(output.Issues, DispatchIssue{WinnerID: n.WinnerID, NotificationID: n.ID, Error: err.Error()})
+		} else {
+			n.MarkSent(result.ProviderMessageID, time.Now())
+			output.Sent++
+		}
+
+		if err := s.notificationRepository.Update(n); err != nil {
+			output.Issues = append(output.Issues, DispatchIssue{WinnerID: n.WinnerID, NotificationID: n.ID, Error: err.Error()})
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/notification"
+)
+
+// HandleDeliveryReceiptService applies SMS gateway delivery receipts to the outbox and moves
+// winners whose notification was delivered to Notified
+type HandleDeliveryReceiptService struct {
+	notificationRepository notification.NotificationRepository
+	unitOfWork             draw.UnitOfWork
+	auditService           audit.AuditService
+}
+
+// NewHandleDeliveryReceiptService creates a new HandleDeliveryReceiptService
+func NewHandleDeliveryReceiptService(
+	notificationRepository notification.NotificationRepository,
+	unitOfWork draw.UnitOfWork,
+	auditService audit.AuditService,
+) *HandleDeliveryReceiptService {
+	return &HandleDeliveryReceiptService{
+		notificationRepository: notificationRepository,
+		unitOfWork:             unitOfWork,
+		auditService:           auditService,
+	}
+}
+
+// HandleDeliveryReceiptInput defines the input for the HandleDeliveryReceipt use case
+type HandleDeliveryReceiptInput struct {
+	ProviderMessageID string
+	Status            string     // Gateway delivery status, e.g. DELIVRD or UNDELIV
+	Error             string     // Gateway error code or description for failed deliveries
+	DeliveredAt       *time.Time // Defaults to the time the receipt arrives
+}
+
+// HandleDeliveryReceiptOutput defines the output for the HandleDeliveryReceipt use case
+type HandleDeliveryReceiptOutput struct {
+	NotificationID     uuid.UUID
+	WinnerID           uuid.UUID
+	NotificationStatus string
+	WinnerNotified     bool // True when this receipt moved the winner to Notified
+}
+
+// HandleDeliveryReceipt applies a delivery receipt. Receipts are idempotent: a repeated receipt
+// for a delivered notification does not change it again.
+func (s *HandleDeliveryReceiptService) HandleDeliveryReceipt(ctx context.Context, input HandleDeliveryReceiptInput) (*HandleDeliveryReceiptOutput, error) {
+	if strings.TrimSpace(input.ProviderMessageID) == "" {
+		return nil, notification.NewNotificationError(notification.ErrInvalidReceipt, "Message ID is required", nil)
+	}
+
+	outcome, err := notification.ParseReceiptStatus(input.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := s.notificationRepository.GetByProviderMessageID(input.ProviderMessageID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &HandleDeliveryReceiptOutput{
+		NotificationID: n.ID,
+		WinnerID:       n.WinnerID,
+	}
+
+	now := time.Now()
+	switch {
+	case outcome == notification.ReceiptDelivered:
+		if n.Status != notification.StatusDelivered {
+			deliveredAt := now
+			if input.DeliveredAt != nil {
+				deliveredAt = *input.DeliveredAt
+			}
+
+			n.MarkDelivered(deliveredAt)
+			if err := s.notificationRepository.Update(n); err != nil {
+				return nil, err
+			}
+		}
+
+		// Also runs for repeated receipts, so a receipt retried after a failure below still
+		// moves the winner on
+		output.WinnerNotified, err = s.markWinnerNotified(n, *n.DeliveredAt)
+		if err != nil {
+			return nil, err
+		}
+
+	case outcome == notification.ReceiptFailed && n.Status == notification.StatusSent:
+		reason := strings.TrimSpace(input.Error)
+		if reason == "" {
+			reason = "Delivery failed with status " + input.Status
+		}
+
+		// The attempt was counted when the message was sent
+		n.MarkFailed(reason, false, now)
+		if err := s.notificationRepository.Update(n); err != nil {
+			return nil, err
+		}
+	}
+
+	output.NotificationStatus = n.Status
+	return output, nil
+}
+
+// markWinnerNotified moves the notified winner to Notified. The winner's draw is locked, as
+// for runner-up replacements, so a winner forfeited meanwhile is left alone.
+func (s *HandleDeliveryReceiptService) markWinnerNotified(n *notification.Notification, at time.Time) (bool, error) {
+	var winner *draw.Winner
+	notified := false
+
+	err := s.unitOfWork.Do(func(drawRepository draw.DrawRepository) error {
+		if _, err := drawRepository.GetByIDForUpdate(n.DrawID); err != nil {
+			return fmt.Errorf("failed to lock draw: %w", err)
+		}
+
+		var err error
+		winner, err = drawRepository.GetWinnerByID(n.WinnerID)
+		if err != nil {
+			return fmt.Errorf("failed to get winner: %w", err)
+		}
+
+		if !winner.MarkNotified(at) {
+			return nil
+		}
+		notified = true
+
+		return drawRepository.UpdateWinner(winner)
+	})
+	if err != nil {
+		var drawErr *draw.DrawError
+		if errors.As(err, &drawErr) && drawErr.Code == draw.ErrWinnerNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if notified {
+		// Log audit
+		if err := s.auditService.LogAudit(
+			"WINNER_NOTIFIED",
+			"Winner",
+			winner.ID,
+			audit.SystemActorID,
+			fmt.Sprintf("Winner %s was notified by %s", winner.MSISDN, n.Channel),
+			fmt.Sprintf("Draw: %s, Notification: %s, Delivered at: %s", winner.DrawID, n.ID, at.Format(time.RFC3339)),
+		); err != nil {
+			// Log error but continue
+			fmt.Printf("Failed to log audit: %v\n", err)
+		}
+	}
+
+	return notified, nil
+}
@@ -0,0 +1,31 @@
+package notification
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/notification"
+)
+
+// ListWinnerNotificationsService provides functionality for tracking the notifications of a winner
+type ListWinnerNotificationsService struct {
+	notificationRepository notification.NotificationRepository
+}
+
+// NewListWinnerNotificationsService creates a new ListWinnerNotificationsService
+func NewListWinnerNotificationsService(notificationRepository notification.NotificationRepository) *ListWinnerNotificationsService {
+	return &ListWinnerNotificationsService{
+		notificationRepository: notificationRepository,
+	}
+}
+
+// ListWinnerNotifications lists a winner's notifications with their delivery status
+func (s *ListWinnerNotificationsService) ListWinnerNotifications(ctx context.Context, winnerID uuid.UUID) ([]notification.Notification, error) {
+	if winnerID == uuid.Nil {
+		return nil, errors.New("winner ID is required")
+	}
+
+	return s.notificationRepository.ListByWinnerID(winnerID)
+}
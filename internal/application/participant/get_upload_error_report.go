@@ -0,0 +1,59 @@
+package participant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/participant"
+)
+
+// GetUploadErrorReportService provides the per-row error report of a participant upload
+type GetUploadErrorReportService struct {
+	participantRepository participant.ParticipantRepository
+}
+
+// NewGetUploadErrorReportService creates a new GetUploadErrorReportService
+func NewGetUploadErrorReportService(
+	participantRepository participant.ParticipantRepository,
+) *GetUploadErrorReportService {
+	return &GetUploadErrorReportService{
+		participantRepository: participantRepository,
+	}
+}
+
+// GetUploadErrorReportInput defines the input for the GetUploadErrorReport use case
+type GetUploadErrorReportInput struct {
+	UploadID uuid.UUID
+}
+
+// GetUploadErrorReportOutput defines the output for the GetUploadErrorReport use case
+type GetUploadErrorReportOutput struct {
+	UploadID  uuid.UUID        `json:"uploadId"`
+	FileName  string           `json:"fileName"`
+	RowErrors []UploadRowError `json:"rowErrors"`
+}
+
+// GetUploadErrorReport retrieves the rows rejected during an upload
+func (s *GetUploadErrorReportService) GetUploadErrorReport(ctx context.Context, input GetUploadErrorReportInput) (*GetUploadErrorReportOutput, error) {
+	if input.UploadID == uuid.Nil {
+		return nil, fmt.Errorf("upload ID is required")
+	}
+
+	uploadAudit, err := s.participantRepository.GetUploadAuditByID(input.UploadID)
+	if err != nil {
+		return nil, err
+	}
+
+	rowErrors := make([]UploadRowError, 0, len(uploadAudit.ErrorDetails))
+	for _, line := range uploadAudit.ErrorDetails {
+		rowErrors = append(rowErrors, ParseUploadRowError(line))
+	}
+
+	return &GetUploadErrorReportOutput{
+		UploadID:  uploadAudit.ID,
+		FileName:  uploadAudit.FileName,
+		RowErrors: rowErrors,
+	}, nil
+}
@@ -1,30 +1,83 @@
 package participant
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
 	"errors"
 	"fmt"
+	"io"
+	"strconv"
+	"strings"
 	"time"
-	
+
 	"github.com/google/uuid"
-	
+
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/participant"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/blacklist"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/spreadsheet"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/util"
+)
+
+// uploadBatchSize is the number of participants written per CreateBatch call
+const uploadBatchSize = 1000
+
+// Upload statuses recorded on the upload audit
+const (
+	UploadStatusCompleted           = "Completed"
+	UploadStatusCompletedWithErrors = "CompletedWithErrors"
+	UploadStatusFailed              = "Failed"
 )
 
+// Canonical upload columns
+const (
+	columnMSISDN         = "msisdn"
+	columnRechargeAmount = "recharge amount"
+	columnRechargeDate   = "recharge date"
+)
+
+// uploadColumnAliases maps normalized header names to canonical upload columns
+var uploadColumnAliases = map[string]string{
+	"msisdn":          columnMSISDN,
+	"phone":           columnMSISDN,
+	"phone number":    columnMSISDN,
+	"mobile":          columnMSISDN,
+	"mobile number":   columnMSISDN,
+	"recharge amount": columnRechargeAmount,
+	"rechargeamount":  columnRechargeAmount,
+	"amount":          columnRechargeAmount,
+	"recharge date":   columnRechargeDate,
+	"rechargedate":    columnRechargeDate,
+	"date":            columnRechargeDate,
+}
+
+// rechargeDateLayouts are the date formats accepted in uploaded files
+var rechargeDateLayouts = []string{
+	"2006-01-02",
+	"2006-01-02 15:04:05",
+	time.RFC3339,
+	"02/01/2006",
+	"2/1/2006",
+	"02-01-2006",
+}
+
 // UploadParticipantsService provides functionality for uploading participants
 type UploadParticipantsService struct {
 	participantRepository participant.ParticipantRepository
+	blacklistRepository   blacklist.BlacklistRepository
 	auditService          audit.AuditService
 }
 
 // NewUploadParticipantsService creates a new UploadParticipantsService
 func NewUploadParticipantsService(
 	participantRepository participant.ParticipantRepository,
+	blacklistRepository blacklist.BlacklistRepository,
 	auditService audit.AuditService,
 ) *UploadParticipantsService {
 	return &UploadParticipantsService{
 		participantRepository: participantRepository,
+		blacklistRepository:   blacklistRepository,
 		auditService:          auditService,
 	}
 }
@@ -36,24 +89,43 @@ type UploadParticipantsInput struct {
 	FileName     string             `json:"fileName"`
 }
 
+// ImportParticipantsFileInput defines the input for the ImportParticipantsFile use case
+type ImportParticipantsFileInput struct {
+	Rows       spreadsheet.RowReader
+	UploadedBy uuid.UUID
+	FileName   string
+}
+
 // ParticipantInput defines the input for a participant
 type ParticipantInput struct {
+	Row            int     `json:"row"`
 	MSISDN         string  `json:"msisdn"`
 	RechargeAmount float64 `json:"rechargeAmount"`
 	RechargeDate   string  `json:"rechargeDate"`
 }
 
+// UploadRowError describes why a single uploaded row was rejected
+type UploadRowError struct {
+	Row    int    `json:"row"`
+	MSISDN string `json:"msisdn"`
+	Reason string `json:"reason"`
+}
+
 // UploadParticipantsOutput defines the output for the UploadParticipants use case
 type UploadParticipantsOutput struct {
-	TotalUploaded  int       `json:"totalUploaded"`
-	UploadID       uuid.UUID `json:"uploadId"`
-	ID             uuid.UUID `json:"id"`
-	FileName       string    `json:"fileName"`
-	UploadDate     time.Time `json:"uploadDate"`
-	RecordCount    int       `json:"recordCount"`
-	Status         string    `json:"status"`
-	ErrorMessage   string    `json:"errorMessage"`
-	ProcessingTime string    `json:"processingTime"`
+	TotalUploaded        int              `json:"totalUploaded"`
+	UploadID             uuid.UUID        `json:"uploadId"`
+	ID                   uuid.UUID        `json:"id"`
+	FileName             string           `json:"fileName"`
+	UploadDate           time.Time        `json:"uploadDate"`
+	RecordCount          int              `json:"recordCount"`
+	SuccessfullyImported int              `json:"successfullyImported"`
+	DuplicatesSkipped    int              `json:"duplicatesSkipped"`
+	ErrorsEncountered    int              `json:"errorsEncountered"`
+	RowErrors            []UploadRowError `json:"rowErrors"`
+	Status               string           `json:"status"`
+	ErrorMessage         string           `json:"errorMessage"`
+	ProcessingTime       string           `json:"processingTime"`
 }
 
 // UploadParticipants uploads a batch of participants
@@ -61,71 +133,397 @@ func (s *UploadParticipantsService) UploadParticipants(ctx context.Context, inpu
 	if len(input.Participants) == 0 {
 		return nil, errors.New("at least one participant is required")
 	}
-	
+
 	if input.UploadedBy == uuid.Nil {
 		return nil, errors.New("uploaded by is required")
 	}
-	
-	// Create upload record
-	uploadID := uuid.New()
-	now := time.Now()
-	
-	// Process participants
-	participants := make([]*participant.Participant, 0, len(input.Participants))
-	for _, p := range input.Participants {
-		// Parse recharge date
-		rechargeDate, err := time.Parse("2006-01-02", p.RechargeDate)
+
+	imp, err := s.newImport(input.FileName, input.UploadedBy)
+	if err != nil {
+		return nil, err
+	}
+	for i, p := range input.Participants {
+		if p.Row == 0 {
+			p.Row = i + 1
+		}
+		if err := imp.add(p); err != nil {
+			return nil, err
+		}
+	}
+
+	return imp.finish()
+}
+
+// ImportParticipantsFile streams rows from an uploaded CSV or XLSX file, validating
+// each one and importing the valid rows in batches
+func (s *UploadParticipantsService) ImportParticipantsFile(ctx context.Context, input ImportParticipantsFileInput) (*UploadParticipantsOutput, error) {
+	if input.Rows == nil {
+		return nil, errors.New("file rows are required")
+	}
+
+	if input.UploadedBy == uuid.Nil {
+		return nil, errors.New("uploaded by is required")
+	}
+
+	header, err := input.Rows.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, participant.NewParticipantError(participant.ErrInvalidCSVFormat, "File is empty", nil)
+		}
+		return nil, participant.NewParticipantError(participant.ErrInvalidCSVFormat, "Failed to read header row", err)
+	}
+
+	columns, err := mapUploadColumns(header)
+	if err != nil {
+		return nil, err
+	}
+
+	imp, err := s.newImport(input.FileName, input.UploadedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// Header is row 1, data starts on row 2
+	for rowNumber := 2; ; rowNumber++ {
+		row, err := input.Rows.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				imp.reject(rowNumber, "", fmt.Sprintf("malformed row: %v", parseErr.Err))
+				continue
+			}
+			return nil, participant.NewParticipantError(participant.ErrInvalidCSVFormat, fmt.Sprintf("Failed to read row %d", rowNumber), err)
+		}
+
+		if isBlankRow(row) {
+			continue
+		}
+
+		msisdn := cell(row, columns[columnMSISDN])
+		amount, err := util.ParseCurrency(cell(row, columns[columnRechargeAmount]))
 		if err != nil {
-			return nil, fmt.Errorf("invalid recharge date for MSISDN %s: %w", p.MSISDN, err)
+			imp.reject(rowNumber, msisdn, "invalid recharge amount")
+			continue
 		}
-		
-		// Calculate points (1 point per N100)
-		points := int(p.RechargeAmount / 100)
-		
-		participant := &participant.Participant{
-			ID:             uuid.New(),
-			MSISDN:         p.MSISDN,
-			RechargeAmount: p.RechargeAmount,
-			RechargeDate:   rechargeDate,
-			Points:         points,
-			UploadID:       uploadID,
-			CreatedAt:      now,
+
+		if err := imp.add(ParticipantInput{
+			Row:            rowNumber,
+			MSISDN:         msisdn,
+			RechargeAmount: amount,
+			RechargeDate:   cell(row, columns[columnRechargeDate]),
+		}); err != nil {
+			return nil, err
 		}
-		
-		participants = append(participants, participant)
 	}
-	
-	// Save participants
-	successCount, _, err := s.participantRepository.CreateBatch(participants)
+
+	if imp.totalRows == 0 {
+		return nil, participant.NewParticipantError(participant.ErrInvalidCSVFormat, "File contains no data rows", nil)
+	}
+
+	return imp.finish()
+}
+
+// participantImport accumulates the state of a single upload
+type participantImport struct {
+	service    *UploadParticipantsService
+	uploadID   uuid.UUID
+	uploadedBy uuid.UUID
+	fileName   string
+	startedAt  time.Time
+	blacklist  map[string]string // Reason per blacklisted MSISDN
+	seen       map[string]bool
+	batch      []*participant.Participant
+	totalRows  int
+	imported   int
+	duplicates int
+	rowErrors  []UploadRowError
+}
+
+// newImport starts a new upload, loading the blacklist rows are checked against
+func (s *UploadParticipantsService) newImport(fileName string, uploadedBy uuid.UUID) (*participantImport, error) {
+	startedAt := time.Now()
+
+	entries, err := s.blacklistRepository.ListActive(startedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blacklist: %w", err)
+	}
+
+	blacklisted := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		blacklisted[entry.MSISDN] = entry.Reason
+	}
+
+	return &participantImport{
+		service:    s,
+		uploadID:   uuid.New(),
+		uploadedBy: uploadedBy,
+		fileName:   fileName,
+		startedAt:  startedAt,
+		blacklist:  blacklisted,
+		seen:       make(map[string]bool),
+		batch:      make([]*participant.Participant, 0, uploadBatchSize),
+		rowErrors:  make([]UploadRowError, 0),
+	}, nil
+}
+
+// reject records a row that failed validation
+func (imp *participantImport) reject(row int, msisdn, reason string) {
+	imp.totalRows++
+	imp.rowErrors = append(imp.rowErrors, UploadRowError{
+		Row:    row,
+		MSISDN: msisdn,
+		Reason: reason,
+	})
+}
+
+// add validates a row and queues it for insertion; only repository failures are returned
+func (imp *participantImport) add(p ParticipantInput) error {
+	msisdn := participant.NormalizeMSISDN(p.MSISDN)
+	if err := participant.ValidateMSISDN(msisdn); err != nil {
+		imp.reject(p.Row, p.MSISDN, err.Error())
+		return nil
+	}
+
+	if reason, ok := imp.blacklist[msisdn]; ok {
+		imp.reject(p.Row, msisdn, fmt.Sprintf("MSISDN is blacklisted: %s", reason))
+		return nil
+	}
+
+	if p.RechargeAmount <= 0 {
+		imp.reject(p.Row, msisdn, "recharge amount must be greater than zero")
+		return nil
+	}
+
+	rechargeDate, err := parseRechargeDate(p.RechargeDate)
+	if err != nil {
+		imp.reject(p.Row, msisdn, err.Error())
+		return nil
+	}
+
+	imp.totalRows++
+
+	// The same recharge appearing twice in one file is only counted once
+	key := fmt.Sprintf("%s|%s|%.2f", msisdn, rechargeDate.Format("2006-01-02"), p.RechargeAmount)
+	if imp.seen[key] {
+		imp.duplicates++
+		return nil
+	}
+	imp.seen[key] = true
+
+	imp.batch = append(imp.batch, &participant.Participant{
+		ID:             uuid.New(),
+		MSISDN:         msisdn,
+		RechargeAmount: p.RechargeAmount,
+		RechargeDate:   rechargeDate,
+		Points:         participant.CalculatePoints(p.RechargeAmount),
+		UploadID:       imp.uploadID,
+		CreatedAt:      imp.startedAt,
+		UpdatedAt:      imp.startedAt,
+	})
+
+	if len(imp.batch) >= uploadBatchSize {
+		return imp.flush()
+	}
+
+	return nil
+}
+
+// flush writes the queued participants
+func (imp *participantImport) flush() error {
+	if len(imp.batch) == 0 {
+		return nil
+	}
+
+	successCount, errorDetails, err := imp.service.participantRepository.CreateBatch(imp.batch)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create participants: %w", err)
+		return fmt.Errorf("failed to create participants: %w", err)
+	}
+
+	imp.imported += successCount
+	for _, detail := range errorDetails {
+		imp.rowErrors = append(imp.rowErrors, UploadRowError{Reason: detail})
+	}
+
+	imp.batch = imp.batch[:0]
+	return nil
+}
+
+// finish flushes the remaining rows, records the upload audit and builds the output
+func (imp *participantImport) finish() (*UploadParticipantsOutput, error) {
+	if err := imp.flush(); err != nil {
+		return nil, err
+	}
+
+	status := UploadStatusCompleted
+	switch {
+	case imp.imported == 0 && imp.totalRows > 0 && imp.duplicates < imp.totalRows:
+		status = UploadStatusFailed
+	case len(imp.rowErrors) > 0:
+		status = UploadStatusCompletedWithErrors
+	}
+
+	errorDetails := make([]string, 0, len(imp.rowErrors))
+	for _, rowErr := range imp.rowErrors {
+		errorDetails = append(errorDetails, FormatUploadRowError(rowErr))
+	}
+
+	now := time.Now()
+	uploadAudit := &participant.UploadAudit{
+		ID:             imp.uploadID,
+		UploadedBy:     imp.uploadedBy,
+		UploadDate:     imp.startedAt,
+		FileName:       imp.fileName,
+		Status:         status,
+		TotalRows:      imp.totalRows,
+		SuccessfulRows: imp.imported,
+		ErrorCount:     len(imp.rowErrors),
+		DuplicateCount: imp.duplicates,
+		ErrorDetails:   errorDetails,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := imp.service.participantRepository.CreateUploadAudit(uploadAudit); err != nil {
+		return nil, fmt.Errorf("failed to record upload audit: %w", err)
 	}
-	
+
 	// Log audit
-	if err := s.auditService.LogAudit(
+	if err := imp.service.auditService.LogAudit(
 		"UPLOAD_PARTICIPANTS",
 		"Participant",
-		uploadID,
-		input.UploadedBy,
-		fmt.Sprintf("Participants uploaded: %d", len(participants)),
-		"",
+		imp.uploadID,
+		imp.uploadedBy,
+		fmt.Sprintf("Participants uploaded: %d", imp.imported),
+		fmt.Sprintf("File: %s, Rows: %d, Duplicates skipped: %d, Errors: %d",
+			imp.fileName, imp.totalRows, imp.duplicates, len(imp.rowErrors)),
 	); err != nil {
 		// Log error but continue
 		fmt.Printf("Failed to log audit: %v\n", err)
 	}
-	
-	// Calculate processing time (just a placeholder)
-	processingTime := "0.5s"
-	
+
+	errorMessage := ""
+	if len(imp.rowErrors) > 0 {
+		errorMessage = fmt.Sprintf("%d row(s) could not be imported", len(imp.rowErrors))
+	}
+
 	return &UploadParticipantsOutput{
-		TotalUploaded:  successCount,
-		UploadID:       uploadID,
-		ID:             uploadID,
-		FileName:       input.FileName,
-		UploadDate:     now,
-		RecordCount:    len(participants),
-		Status:         "Completed",
-		ErrorMessage:   "",
-		ProcessingTime: processingTime,
+		TotalUploaded:        imp.imported,
+		UploadID:             imp.uploadID,
+		ID:                   imp.uploadID,
+		FileName:             imp.fileName,
+		UploadDate:           imp.startedAt,
+		RecordCount:          imp.totalRows,
+		SuccessfullyImported: imp.imported,
+		DuplicatesSkipped:    imp.duplicates,
+		ErrorsEncountered:    len(imp.rowErrors),
+		RowErrors:            imp.rowErrors,
+		Status:               status,
+		ErrorMessage:         errorMessage,
+		ProcessingTime:       time.Since(imp.startedAt).Round(time.Millisecond).String(),
 	}, nil
 }
+
+// FormatUploadRowError encodes a row error as a single CSV line for storage on the upload audit
+func FormatUploadRowError(rowErr UploadRowError) string {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	reason := strings.ReplaceAll(rowErr.Reason, "\n", " ")
+	_ = w.Write([]string{strconv.Itoa(rowErr.Row), rowErr.MSISDN, reason})
+	w.Flush()
+	return strings.TrimRight(buf.String(), "\r\n")
+}
+
+// ParseUploadRowError decodes a row error stored by FormatUploadRowError.
+// Lines that are not in the stored format are returned as a reason-only error.
+func ParseUploadRowError(line string) UploadRowError {
+	record, err := csv.NewReader(strings.NewReader(line)).Read()
+	if err != nil || len(record) != 3 {
+		return UploadRowError{Reason: line}
+	}
+
+	row, err := strconv.Atoi(record[0])
+	if err != nil {
+		return UploadRowError{Reason: line}
+	}
+
+	return UploadRowError{
+		Row:    row,
+		MSISDN: record[1],
+		Reason: record[2],
+	}
+}
+
+// mapUploadColumns resolves the position of each required column from the header row
+func mapUploadColumns(header []string) (map[string]int, error) {
+	columns := make(map[string]int)
+	for i, name := range header {
+		canonical, ok := uploadColumnAliases[spreadsheet.NormalizeHeader(name)]
+		if !ok {
+			continue
+		}
+		if _, exists := columns[canonical]; !exists {
+			columns[canonical] = i
+		}
+	}
+
+	missing := make([]string, 0)
+	for _, required := range []string{columnMSISDN, columnRechargeAmount, columnRechargeDate} {
+		if _, ok := columns[required]; !ok {
+			missing = append(missing, required)
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, participant.NewParticipantError(
+			participant.ErrInvalidCSVFormat,
+			fmt.Sprintf("Missing required column(s): %s", strings.Join(missing, ", ")),
+			nil,
+		)
+	}
+
+	return columns, nil
+}
+
+// parseRechargeDate parses a recharge date in any of the accepted layouts,
+// including the serial day numbers spreadsheets use for date cells
+func parseRechargeDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, errors.New("recharge date is required")
+	}
+
+	for _, layout := range rechargeDateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	// Excel stores dates as days since 1899-12-30
+	if serial, err := strconv.ParseFloat(value, 64); err == nil && serial > 0 && serial < 2958466 {
+		excelEpoch := time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+		return excelEpoch.AddDate(0, 0, int(serial)), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid recharge date %q, expected YYYY-MM-DD", value)
+}
+
+// cell returns the trimmed value at index, or an empty string for short rows
+func cell(row []string, index int) string {
+	if index < 0 || index >= len(row) {
+		return ""
+	}
+	return strings.TrimSpace(row[index])
+}
+
+// isBlankRow reports whether every cell in the row is empty
+func isBlankRow(row []string) bool {
+	for _, value := range row {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,197 @@
+package participant_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	participantApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/participant"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/blacklist"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/participant"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/spreadsheet"
+)
+
+// fakeParticipantRepository keeps imported participants and upload audits in memory
+type fakeParticipantRepository struct {
+	participant.ParticipantRepository
+	participants []*participant.Participant
+	audits       map[uuid.UUID]*participant.UploadAudit
+}
+
+func newFakeParticipantRepository() *fakeParticipantRepository {
+	return &fakeParticipantRepository{audits: map[uuid.UUID]*participant.UploadAudit{}}
+}
+
+func (r *fakeParticipantRepository) CreateBatch(participants []*participant.Participant) (int, []string, error) {
+	r.participants = append(r.participants, participants...)
+	return len(participants), nil, nil
+}
+
+func (r *fakeParticipantRepository) CreateUploadAudit(audit *participant.UploadAudit) error {
+	r.audits[audit.ID] = audit
+	return nil
+}
+
+func (r *fakeParticipantRepository) GetUploadAuditByID(id uuid.UUID) (*participant.UploadAudit, error) {
+	audit, ok := r.audits[id]
+	if !ok {
+		return nil, participant.NewParticipantError(participant.ErrUploadAuditNotFound, "Upload audit not found", nil)
+	}
+	return audit, nil
+}
+
+// fakeBlacklistRepository returns a fixed set of active entries
+type fakeBlacklistRepository struct {
+	blacklist.BlacklistRepository
+	entries []blacklist.BlacklistEntry
+}
+
+func (r *fakeBlacklistRepository) ListActive(at time.Time) ([]blacklist.BlacklistEntry, error) {
+	return r.entries, nil
+}
+
+type fakeAuditService struct{}
+
+func (fakeAuditService) LogAudit(action, entityType string, entityID uuid.UUID, userID uuid.UUID, summary, details string) error {
+	return nil
+}
+
+func importCSV(t *testing.T, service *participantApp.UploadParticipantsService, content string) (*participantApp.UploadParticipantsOutput, error) {
+	t.Helper()
+	return service.ImportParticipantsFile(context.Background(), participantApp.ImportParticipantsFileInput{
+		Rows:       spreadsheet.NewCSVReader(strings.NewReader(content)),
+		UploadedBy: uuid.New(),
+		FileName:   "recharges.csv",
+	})
+}
+
+func TestImportParticipantsFile_ValidatesRows(t *testing.T) {
+	participantRepo := newFakeParticipantRepository()
+	service := participantApp.NewUploadParticipantsService(participantRepo, &fakeBlacklistRepository{}, fakeAuditService{})
+
+	output, err := importCSV(t, service, strings.Join([]string{
+		"Phone Number,Amount,Recharge Date",
+		"08031234567,250,2026-03-01",
+		"2348031234567,250,2026-03-01", // Same recharge as row 2
+		"12345,100,2026-03-01",
+		"2348031234568,0,2026-03-01",
+		"2348031234569,abc,2026-03-01",
+		"2348031234570,100,March 1st",
+		",,",
+		"+234 803 123 4571,1000,01/03/2026",
+	}, "\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, participantApp.UploadStatusCompletedWithErrors, output.Status)
+	assert.Equal(t, 7, output.RecordCount)
+	assert.Equal(t, 2, output.SuccessfullyImported)
+	assert.Equal(t, 1, output.DuplicatesSkipped)
+	assert.Equal(t, 4, output.ErrorsEncountered)
+
+	rejectedRows := make([]int, 0, len(output.RowErrors))
+	for _, rowErr := range output.RowErrors {
+		rejectedRows = append(rejectedRows, rowErr.Row)
+	}
+	assert.Equal(t, []int{4, 5, 6, 7}, rejectedRows)
+	assert.Equal(t, "invalid recharge amount", output.RowErrors[2].Reason)
+
+	require.Len(t, participantRepo.participants, 2)
+	first := participantRepo.participants[0]
+	assert.Equal(t, "2348031234567", first.MSISDN)
+	assert.Equal(t, 2, first.Points)
+	assert.Equal(t, output.UploadID, first.UploadID)
+
+	second := participantRepo.participants[1]
+	assert.Equal(t, "2348031234571", second.MSISDN)
+	assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), second.RechargeDate)
+}
+
+func TestImportParticipantsFile_RejectsFilesWithoutRequiredColumns(t *testing.T) {
+	participantRepo := newFakeParticipantRepository()
+	service := participantApp.NewUploadParticipantsService(participantRepo, &fakeBlacklistRepository{}, fakeAuditService{})
+
+	_, err := importCSV(t, service, "MSISDN,Amount\n2348031234567,250\n")
+
+	var participantErr *participant.ParticipantError
+	require.True(t, errors.As(err, &participantErr))
+	assert.Equal(t, participant.ErrInvalidCSVFormat, participantErr.Code)
+	assert.Contains(t, participantErr.Message, "recharge date")
+	assert.Empty(t, participantRepo.audits)
+}
+
+func TestImportParticipantsFile_FailsWhenNoRowIsValid(t *testing.T) {
+	service := participantApp.NewUploadParticipantsService(newFakeParticipantRepository(), &fakeBlacklistRepository{}, fakeAuditService{})
+
+	output, err := importCSV(t, service, "MSISDN,Amount,Date\n12345,100,2026-03-01\n")
+	require.NoError(t, err)
+
+	assert.Equal(t, participantApp.UploadStatusFailed, output.Status)
+	assert.Zero(t, output.SuccessfullyImported)
+}
+
+func TestGetUploadErrorReport(t *testing.T) {
+	participantRepo := newFakeParticipantRepository()
+	service := participantApp.NewUploadParticipantsService(participantRepo, &fakeBlacklistRepository{}, fakeAuditService{})
+
+	output, err := importCSV(t, service, strings.Join([]string{
+		"MSISDN,Amount,Date",
+		"2348031234567,250,2026-03-01",
+		"12345,100,2026-03-01",
+		"2348031234570,100,\"March 1st, 2026\"",
+	}, "\n"))
+	require.NoError(t, err)
+
+	report, err := participantApp.NewGetUploadErrorReportService(participantRepo).GetUploadErrorReport(context.Background(), participantApp.GetUploadErrorReportInput{
+		UploadID: output.UploadID,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "recharges.csv", report.FileName)
+	assert.Equal(t, output.RowErrors, report.RowErrors)
+	require.Len(t, report.RowErrors, 2)
+	assert.Equal(t, "12345", report.RowErrors[0].MSISDN)
+	assert.Contains(t, report.RowErrors[1].Reason, "March 1st, 2026")
+}
+
+func TestParseUploadRowError_KeepsUnstructuredLines(t *testing.T) {
+	rowErr := participantApp.ParseUploadRowError("duplicate key value violates unique constraint")
+
+	assert.Zero(t, rowErr.Row)
+	assert.Equal(t, "duplicate key value violates unique constraint", rowErr.Reason)
+}
+
+func TestImportParticipantsFile_FlagsBlacklistedMSISDNs(t *testing.T) {
+	participantRepo := newFakeParticipantRepository()
+	blacklistRepo := &fakeBlacklistRepository{entries: []blacklist.BlacklistEntry{
+		{ID: uuid.New(), MSISDN: "2348031234567", Reason: "Chargeback"},
+	}}
+	service := participantApp.NewUploadParticipantsService(participantRepo, blacklistRepo, fakeAuditService{})
+
+	output, err := importCSV(t, service, strings.Join([]string{
+		"MSISDN,Amount,Date",
+		"08031234567,250,2026-03-01",
+		"2348031234568,250,2026-03-01",
+	}, "\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, output.SuccessfullyImported)
+	assert.Equal(t, []participantApp.UploadRowError{
+		{Row: 2, MSISDN: "2348031234567", Reason: "MSISDN is blacklisted: Chargeback"},
+	}, output.RowErrors)
+
+	require.Len(t, participantRepo.participants, 1)
+	assert.Equal(t, "2348031234568", participantRepo.participants[0].MSISDN)
+
+	// The upload report keeps the flagged row
+	report, err := participantApp.NewGetUploadErrorReportService(participantRepo).GetUploadErrorReport(context.Background(), participantApp.GetUploadErrorReportInput{
+		UploadID: output.UploadID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, output.RowErrors, report.RowErrors)
+}
@@ -0,0 +1,36 @@
+package payout
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/payout"
+)
+
+// GetWinnerPayoutService provides functionality for retrieving the payout of a winner
+type GetWinnerPayoutService struct {
+	payoutRepository payout.PayoutRepository
+}
+
+// NewGetWinnerPayoutService creates a new GetWinnerPayoutService
+func NewGetWinnerPayoutService(payoutRepository payout.PayoutRepository) *GetWinnerPayoutService {
+	return &GetWinnerPayoutService{
+		payoutRepository: payoutRepository,
+	}
+}
+
+// GetWinnerPayout retrieves the payout of a winner
+func (s *GetWinnerPayoutService) GetWinnerPayout(ctx context.Context, winnerID uuid.UUID) (*PayoutOutput, error) {
+	if winnerID == uuid.Nil {
+		return nil, errors.New("winner ID is required")
+	}
+
+	p, err := s.payoutRepository.GetByWinnerID(winnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPayoutOutput(p), nil
+}
@@ -0,0 +1,73 @@
+package payout
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/payout"
+)
+
+// HandlePayoutCallbackService applies the outcome a payout provider reports by callback
+type HandlePayoutCallbackService struct {
+	settlement
+}
+
+// NewHandlePayoutCallbackService creates a new HandlePayoutCallbackService
+func NewHandlePayoutCallbackService(
+	payoutRepository payout.PayoutRepository,
+	providers *payout.ProviderSet,
+	unitOfWork draw.UnitOfWork,
+	auditService audit.AuditService,
+) *HandlePayoutCallbackService {
+	return &HandlePayoutCallbackService{
+		settlement: settlement{
+			payoutRepository: payoutRepository,
+			providers:        providers,
+			unitOfWork:       unitOfWork,
+			auditService:     auditService,
+		},
+	}
+}
+
+// HandlePayoutCallbackInput defines the input for the HandlePayoutCallback use case
+type HandlePayoutCallbackInput struct {
+	Reference        string // Our reference of the attempt
+	ProviderPayoutID string
+	Status           string
+	FailureReason    string
+}
+
+// HandlePayoutCallback applies a provider callback. Callbacks for an earlier attempt or for a
+// settled payout are ignored, so repeated callbacks are harmless.
+func (s *HandlePayoutCallbackService) HandlePayoutCallback(ctx context.Context, input HandlePayoutCallbackInput) (*PayoutOutput, error) {
+	if strings.TrimSpace(input.Reference) == "" {
+		return nil, payout.NewPayoutError(payout.ErrInvalidCallback, "Reference is required", nil)
+	}
+
+	status, err := payout.ParseProviderStatus(input.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.payoutRepository.GetByProviderReference(input.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Settled() {
+		return toPayoutOutput(p), nil
+	}
+
+	err = s.apply(p, &payout.ProviderResult{
+		ProviderPayoutID: input.ProviderPayoutID,
+		Status:           status,
+		FailureReason:    input.FailureReason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toPayoutOutput(p), nil
+}
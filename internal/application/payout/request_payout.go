@@ -0,0 +1,204 @@
+package payout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/payout"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/prize"
+)
+
+// RequestPayoutService pays a winner's prize through the payout provider of its method:
+// airtime for prizes up to the airtime limit, mobile money above it
+type RequestPayoutService struct {
+	settlement
+	drawRepository  draw.DrawRepository
+	prizeRepository prize.PrizeRepository
+	airtimeMaxValue float64
+}
+
+// NewRequestPayoutService creates a new RequestPayoutService
+func NewRequestPayoutService(
+	payoutRepository payout.PayoutRepository,
+	drawRepository draw.DrawRepository,
+	prizeRepository prize.PrizeRepository,
+	providers *payout.ProviderSet,
+	unitOfWork draw.UnitOfWork,
+	auditService audit.AuditService,
+	airtimeMaxValue float64,
+) *RequestPayoutService {
+	return &RequestPayoutService{
+		settlement: settlement{
+			payoutRepository: payoutRepository,
+			providers:        providers,
+			unitOfWork:       unitOfWork,
+			auditService:     auditService,
+		},
+		drawRepository:  drawRepository,
+		prizeRepository: prizeRepository,
+		airtimeMaxValue: airtimeMaxValue,
+	}
+}
+
+// RequestPayoutInput defines the input for the RequestPayout use case
+type RequestPayoutInput struct {
+	WinnerID    uuid.UUID
+	RequestedBy uuid.UUID
+}
+
+// RequestPayout starts paying a winner's prize, or retries a failed payout. Each winner has one
+// payout, so repeated requests never pay a prize twice.
+func (s *RequestPayoutService) RequestPayout(ctx context.Context, input RequestPayoutInput) (*PayoutOutput, error) {
+	if input.WinnerID == uuid.Nil {
+		return nil, errors.New("winner ID is required")
+	}
+
+	if input.RequestedBy == uuid.Nil {
+		return nil, errors.New("requested by ID is required")
+	}
+
+	winner, err := s.drawRepository.GetWinnerByID(input.WinnerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get winner: %w", err)
+	}
+
+	drawEntity, err := s.drawRepository.GetByID(winner.DrawID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draw: %w", err)
+	}
+
+	if drawEntity.Status != draw.StatusCompleted || !winner.HoldsPrize() {
+		return nil, payout.NewPayoutError(payout.ErrWinnerNotPayable, fmt.Sprintf("Winner does not hold a prize of a completed draw, status is %s", winner.Status), nil)
+	}
+
+	switch winner.PaymentStatus {
+	case draw.PaymentStatusApproved:
+	case draw.PaymentStatusPaid:
+		return nil, payout.NewPayoutError(payout.ErrPayoutAlreadyPaid, "Prize has already been paid", nil)
+	case draw.PaymentStatusProcessing:
+		return nil, payout.NewPayoutError(payout.ErrPayoutInProgress, "A payout of the prize is in progress", nil)
+	default:
+		return nil, payout.NewPayoutError(payout.ErrWinnerNotPayable, fmt.Sprintf("Payment must be approved before it is paid out, payment status is %s", winner.PaymentStatus), nil)
+	}
+
+	// Maker-checker: the approver of the payment cannot also pay it out
+	if err := winner.CheckNotApprover(input.RequestedBy); err != nil {
+		return nil, err
+	}
+
+	p, err := s.getOrCreatePayout(winner, input.RequestedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	previousAttempts := p.Attempts
+	if err := p.StartAttempt(input.RequestedBy, now); err != nil {
+		return nil, err
+	}
+
+	// The attempt and its reference are stored before the provider is contacted, so a crash
+	// in between leaves a pending payout that the next check sends again under the same reference
+	saved, err := s.payoutRepository.SaveAttempt(p, previousAttempts)
+	if err != nil {
+		return nil, err
+	}
+	if !saved {
+		return nil, payout.NewPayoutError(payout.ErrPayoutInProgress, "Another request started this payout meanwhile", nil)
+	}
+
+	if err := s.markWinnerProcessing(p, now); err != nil {
+		// Close the attempt so that it is never sent to the provider
+		p.Apply(&payout.ProviderResult{Status: payout.ProviderStatusFailed, FailureReason: err.Error()}, time.Now())
+		if updateErr := s.payoutRepository.Update(p); updateErr != nil {
+			return nil, updateErr
+		}
+		return nil, err
+	}
+
+	// Log audit
+	if err := s.auditService.LogAudit(
+		"REQUEST_PAYOUT",
+		"Payout",
+		p.ID,
+		input.RequestedBy,
+		fmt.Sprintf("Requested %s payout of %s %.2f to winner %s", p.Method, p.Currency, p.Amount, p.MSISDN),
+		fmt.Sprintf("Winner: %s, Provider: %s, Attempt: %d, Reference: %s", p.WinnerID, p.Provider, p.Attempts, p.ProviderReference),
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
+	if err := s.initiate(ctx, p); err != nil {
+		return nil, err
+	}
+
+	return toPayoutOutput(p), nil
+}
+
+// getOrCreatePayout returns the winner's payout, creating it on the first request
+func (s *RequestPayoutService) getOrCreatePayout(winner *draw.Winner, requestedBy uuid.UUID) (*payout.Payout, error) {
+	p, err := s.payoutRepository.GetByWinnerID(winner.ID)
+	if err == nil {
+		return p, nil
+	}
+
+	var payoutErr *payout.PayoutError
+	if !errors.As(err, &payoutErr) || payoutErr.Code != payout.ErrPayoutNotFound {
+		return nil, err
+	}
+
+	tier, err := s.prizeRepository.GetPrizeTierByID(winner.PrizeTierID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prize tier: %w", err)
+	}
+
+	method := payout.MethodFor(tier.Value, s.airtimeMaxValue)
+	provider, err := s.providers.ForMethod(method)
+	if err != nil {
+		return nil, err
+	}
+
+	p = payout.NewPayout(winner.ID, winner.DrawID, winner.MSISDN, method, tier.Value, provider.Name(), requestedBy, time.Now())
+	created, err := s.payoutRepository.Create(p)
+	if err != nil {
+		return nil, err
+	}
+
+	// A concurrent request created the payout first
+	if !created {
+		return s.payoutRepository.GetByWinnerID(winner.ID)
+	}
+
+	return p, nil
+}
+
+// markWinnerProcessing records on the winner that the payout is under way
+func (s *RequestPayoutService) markWinnerProcessing(p *payout.Payout, at time.Time) error {
+	return s.unitOfWork.Do(func(drawRepository draw.DrawRepository) error {
+		if _, err := drawRepository.GetByIDForUpdate(p.DrawID); err != nil {
+			return fmt.Errorf("failed to lock draw: %w", err)
+		}
+
+		winner, err := drawRepository.GetWinnerByID(p.WinnerID)
+		if err != nil {
+			return fmt.Errorf("failed to get winner: %w", err)
+		}
+
+		// The prize may have been forfeited since the winner was read
+		if !winner.HoldsPrize() {
+			return payout.NewPayoutError(payout.ErrWinnerNotPayable, fmt.Sprintf("Winner no longer holds the prize, status is %s", winner.Status), nil)
+		}
+
+		if err := winner.MarkPaymentProcessing(p.RequestedBy, p.ProviderReference, at); err != nil {
+			return err
+		}
+		return drawRepository.UpdateWinner(winner)
+	})
+}
@@ -0,0 +1,99 @@
+package payout
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/payout"
+)
+
+// settleBatchSize is the number of unsettled payouts checked per run
+const settleBatchSize = 100
+
+// SettlePayoutsService polls the providers of unsettled payouts for their outcome, for providers
+// without callbacks and for callbacks that never arrived
+type SettlePayoutsService struct {
+	settlement
+	lock payout.SettleLock
+}
+
+// NewSettlePayoutsService creates a new SettlePayoutsService
+func NewSettlePayoutsService(
+	payoutRepository payout.PayoutRepository,
+	providers *payout.ProviderSet,
+	unitOfWork draw.UnitOfWork,
+	lock payout.SettleLock,
+	auditService audit.AuditService,
+) *SettlePayoutsService {
+	return &SettlePayoutsService{
+		settlement: settlement{
+			payoutRepository: payoutRepository,
+			providers:        providers,
+			unitOfWork:       unitOfWork,
+			auditService:     auditService,
+		},
+		lock: lock,
+	}
+}
+
+// SettleIssue describes a payout that could not be checked
+type SettleIssue struct {
+	PayoutID uuid.UUID
+	Error    string
+}
+
+// SettlePayoutsOutput defines the output for the SettlePayouts use case
+type SettlePayoutsOutput struct {
+	LockAcquired bool // False when another server is settling payouts
+	Checked      int
+	Succeeded    int
+	Failed       int
+	Issues       []SettleIssue
+}
+
+// SettlePayouts checks one batch of unsettled payouts that are due for a check
+func (s *SettlePayoutsService) SettlePayouts(ctx context.Context, now time.Time) (*SettlePayoutsOutput, error) {
+	output := &SettlePayoutsOutput{
+		Issues: make([]SettleIssue, 0),
+	}
+
+	acquired, err := s.lock.TryRun(func() error {
+		payouts, err := s.payoutRepository.ListUnsettled(now, settleBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for i := range payouts {
+			// Leave the rest for the next run when the server is shutting down
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			p := &payouts[i]
+			output.Checked++
+			if err := s.check(ctx, p); err != nil {
+				output.Issues = append(output.Issues, SettleIssue{PayoutID: p.ID, Error: err.Error()})
+				continue
+			}
+
+			switch p.Status {
+			case payout.StatusSucceeded:
+				output.Succeeded++
+			case payout.StatusFailed:
+				output.Failed++
+			}
+		}
+
+		return nil
+	})
+	output.LockAcquired = acquired
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
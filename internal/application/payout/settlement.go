@@ -0,0 +1,169 @@
+package payout
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/payout"
+)
+
+// settlement moves payouts forward with their provider and records outcomes on the winner.
+// It is shared by the services that request, poll and receive callbacks for payouts.
+type settlement struct {
+	payoutRepository payout.PayoutRepository
+	providers        *payout.ProviderSet
+	unitOfWork       draw.UnitOfWork
+	auditService     audit.AuditService
+}
+
+// initiate sends the payout's current attempt to its provider. When the provider cannot be
+// reached the payout stays pending and is sent again, with the same reference, by the next check.
+func (s *settlement) initiate(ctx context.Context, p *payout.Payout) error {
+	provider, err := s.providers.ByName(p.Provider)
+	if err != nil {
+		return err
+	}
+
+	result, err := provider.Initiate(ctx, p.Request())
+	if err != nil {
+		p.DeferCheck(err.Error(), time.Now())
+		return s.payoutRepository.Update(p)
+	}
+
+	return s.apply(p, result)
+}
+
+// check asks the provider for the outcome of a payout sent earlier
+func (s *settlement) check(ctx context.Context, p *payout.Payout) error {
+	if p.Status == payout.StatusPending {
+		return s.initiate(ctx, p)
+	}
+
+	provider, err := s.providers.ByName(p.Provider)
+	if err != nil {
+		return err
+	}
+
+	result, err := provider.Status(ctx, p.ProviderReference)
+	if err != nil {
+		p.DeferCheck(err.Error(), time.Now())
+		return s.payoutRepository.Update(p)
+	}
+
+	return s.apply(p, result)
+}
+
+// apply records a provider report, settling the winner's payment when the payout has an outcome
+func (s *settlement) apply(p *payout.Payout, result *payout.ProviderResult) error {
+	now := time.Now()
+	settled := p.Apply(result, now)
+
+	if err := s.payoutRepository.Update(p); err != nil {
+		return err
+	}
+
+	if settled {
+		return s.settleWinner(p, now)
+	}
+	return nil
+}
+
+// settleWinner sets the winner's payment status from a settled payout. The winner's draw is
+// locked, as for runner-up replacements and notifications.
+func (s *settlement) settleWinner(p *payout.Payout, at time.Time) error {
+	var winner *draw.Winner
+	err := s.unitOfWork.Do(func(drawRepository draw.DrawRepository) error {
+		if _, err := drawRepository.GetByIDForUpdate(p.DrawID); err != nil {
+			return fmt.Errorf("failed to lock draw: %w", err)
+		}
+
+		var err error
+		winner, err = drawRepository.GetWinnerByID(p.WinnerID)
+		if err != nil {
+			return fmt.Errorf("failed to get winner: %w", err)
+		}
+
+		if p.Status == payout.StatusSucceeded {
+			err = winner.MarkPaid(audit.SystemActorID, p.ProviderReference, fmt.Sprintf("%s payout by %s", p.Method, p.Provider), at)
+		} else {
+			err = winner.MarkPaymentFailed(audit.SystemActorID, p.ProviderReference, fmt.Sprintf("%s payout by %s failed: %s", p.Method, p.Provider, p.FailureReason), at)
+		}
+		if err != nil {
+			return err
+		}
+
+		return drawRepository.UpdateWinner(winner)
+	})
+	if err != nil {
+		return err
+	}
+
+	action, summary := "PAYOUT_SUCCEEDED", fmt.Sprintf("Paid %s %.2f to winner %s", p.Currency, p.Amount, winner.MSISDN)
+	if p.Status == payout.StatusFailed {
+		action, summary = "PAYOUT_FAILED", fmt.Sprintf("Payout of %s %.2f to winner %s failed", p.Currency, p.Amount, winner.MSISDN)
+	}
+
+	// Log audit
+	if err := s.auditService.LogAudit(
+		action,
+		"Payout",
+		p.ID,
+		audit.SystemActorID,
+		summary,
+		fmt.Sprintf("Winner: %s, Method: %s, Provider: %s, Reference: %s, Provider ID: %s, Reason: %s", p.WinnerID, p.Method, p.Provider, p.ProviderReference, p.ProviderPayoutID, p.FailureReason),
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
+	return nil
+}
+
+// PayoutOutput describes a payout
+type PayoutOutput struct {
+	ID                uuid.UUID
+	WinnerID          uuid.UUID
+	DrawID            uuid.UUID
+	MSISDN            string
+	Method            string
+	Amount            float64
+	Currency          string
+	Status            string
+	Provider          string
+	Attempts          int
+	ProviderReference string
+	ProviderPayoutID  string
+	FailureReason     string
+	RequestedBy       uuid.UUID
+	CompletedAt       *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// toPayoutOutput converts a domain payout to a PayoutOutput
+func toPayoutOutput(p *payout.Payout) *PayoutOutput {
+	return &PayoutOutput{
+		ID:                p.ID,
+		WinnerID:          p.WinnerID,
+		DrawID:            p.DrawID,
+		MSISDN:            p.MSISDN,
+		Method:            p.Method,
+		Amount:            p.Amount,
+		Currency:          p.Currency,
+		Status:            p.Status,
+		Provider:          p.Provider,
+		Attempts:          p.Attempts,
+		ProviderReference: p.ProviderReference,
+		ProviderPayoutID:  p.ProviderPayoutID,
+		FailureReason:     p.FailureReason,
+		RequestedBy:       p.RequestedBy,
+		CompletedAt:       p.CompletedAt,
+		CreatedAt:         p.CreatedAt,
+		UpdatedAt:         p.UpdatedAt,
+	}
+}
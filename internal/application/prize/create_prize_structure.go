@@ -8,6 +8,8 @@ import (
 	
 	"github.com/google/uuid"
 	
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/participant"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/prize"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
 )
@@ -36,6 +38,7 @@ type CreatePrizeStructureInput struct {
 	StartDate   time.Time
 	EndDate     time.Time
 	Prizes      []PrizeInput
+	EligibilityRules draw.EligibilityRules
 	CreatedBy   uuid.UUID
 	IsActive    bool
 }
@@ -47,6 +50,8 @@ type PrizeInput struct {
 	Value             float64
 	Quantity          int
 	NumberOfRunnerUps int
+	ClaimWindowHours  int
+	NotificationTemplate string
 }
 
 // CreatePrizeStructureOutput defines the output for the CreatePrizeStructure use case
@@ -57,6 +62,7 @@ type CreatePrizeStructureOutput struct {
 	StartDate   time.Time
 	EndDate     time.Time
 	Prizes      []CreatePrizeOutput
+	EligibilityRules draw.EligibilityRules
 	CreatedBy   uuid.UUID
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
@@ -71,6 +77,8 @@ type CreatePrizeOutput struct {
 	Value             float64
 	Quantity          int
 	NumberOfRunnerUps int
+	ClaimWindowHours  int
+	NotificationTemplate string
 }
 
 // CreatePrizeStructure creates a new prize structure
@@ -84,6 +92,11 @@ func (s *CreatePrizeStructureService) CreatePrizeStructure(ctx context.Context,
 		return nil, errors.New("at least one prize is required")
 	}
 	
+	eligibilityRules, err := normalizeEligibilityRules(input.EligibilityRules)
+	if err != nil {
+		return nil, err
+	}
+	
 	// Create prize structure
 	prizeStructureID := uuid.New()
 	now := time.Now()
@@ -99,12 +112,13 @@ func (s *CreatePrizeStructureService) CreatePrizeStructure(ctx context.Context,
 		UpdatedAt:   now,
 		IsActive:    input.IsActive,
 		Prizes:      make([]prize.PrizeTier, 0, len(input.Prizes)),
+		EligibilityRules: eligibilityRules,
 	}
 	
 	// Create prizes
 	for i, prizeInput := range input.Prizes {
 		prizeItem := prize.PrizeTier{
-			ID:                prizeStructureID,
+			ID:                uuid.New(),
 			PrizeStructureID:  prizeStructureID,
 			Rank:              i + 1,
 			Name:              prizeInput.Name,
@@ -113,7 +127,12 @@ func (s *CreatePrizeStructureService) CreatePrizeStructure(ctx context.Context,
 			ValueNGN:          0, // Default value, can be calculated if needed
 			Quantity:          prizeInput.Quantity,
 			NumberOfRunnerUps: prizeInput.NumberOfRunnerUps,
-		}	
+			ClaimWindowHours:  prizeInput.ClaimWindowHours,
+			NotificationTemplate: prizeInput.NotificationTemplate,
+		}
+		if err := prize.ValidatePrizeTier(&prizeItem); err != nil {
+			return nil, prize.NewPrizeError(prize.ErrInvalidPrizeTier, fmt.Sprintf("Invalid prize %d", i+1), err)
+		}
 		prizeStructure.Prizes = append(prizeStructure.Prizes, prizeItem)
 	}
 	
@@ -145,6 +164,8 @@ func (s *CreatePrizeStructureService) CreatePrizeStructure(ctx context.Context,
 			Value:             prizeTier.Value,
 			Quantity:          prizeTier.Quantity,
 			NumberOfRunnerUps: prizeTier.NumberOfRunnerUps,
+			ClaimWindowHours:  prizeTier.ClaimWindowHours,
+			NotificationTemplate: prizeTier.NotificationTemplate,
 		})
 	}
 	
@@ -155,9 +176,34 @@ func (s *CreatePrizeStructureService) CreatePrizeStructure(ctx context.Context,
 		StartDate:   input.StartDate,
 		EndDate:     input.EndDate,
 		Prizes:      prizeOutputs,
+		EligibilityRules: eligibilityRules,
 		CreatedBy:   input.CreatedBy,
 		CreatedAt:   now,
 		UpdatedAt:   now,
 		IsActive:    input.IsActive,
 	}, nil
 }
+
+// normalizeEligibilityRules validates a rule set and converts blacklisted MSISDNs to the 234 format
+func normalizeEligibilityRules(rules draw.EligibilityRules) (draw.EligibilityRules, error) {
+	if err := draw.ValidateEligibilityRules(rules); err != nil {
+		return draw.EligibilityRules{}, err
+	}
+	
+	seen := make(map[string]bool, len(rules.BlacklistedMSISDNs))
+	blacklist := make([]string, 0, len(rules.BlacklistedMSISDNs))
+	for _, msisdn := range rules.BlacklistedMSISDNs {
+		msisdn = participant.NormalizeMSISDN(msisdn)
+		if err := participant.ValidateMSISDN(msisdn); err != nil {
+			return draw.EligibilityRules{}, fmt.Errorf("invalid blacklisted MSISDN %q: %w", msisdn, err)
+		}
+		if seen[msisdn] {
+			continue
+		}
+		seen[msisdn] = true
+		blacklist = append(blacklist, msisdn)
+	}
+	rules.BlacklistedMSISDNs = blacklist
+	
+	return rules, nil
+}
@@ -7,6 +7,7 @@ import (
 	
 	"github.com/google/uuid"
 	
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
 	prizeDomain "github.com/ArowuTest/GP-Backend-Promo/internal/domain/prize"
 )
 
@@ -35,6 +36,7 @@ type GetPrizeStructureOutput struct {
 	StartDate   time.Time
 	EndDate     time.Time
 	Prizes      []PrizeOutput
+	EligibilityRules draw.EligibilityRules
 	IsActive    bool
 	CreatedBy   uuid.UUID
 	CreatedAt   time.Time
@@ -49,6 +51,8 @@ type PrizeOutput struct {
 	Value             float64
 	Quantity          int
 	NumberOfRunnerUps int
+	ClaimWindowHours  int
+	NotificationTemplate string
 }
 
 // GetPrizeStructure retrieves a prize structure by ID
@@ -72,6 +76,8 @@ func (s *GetPrizeStructureService) GetPrizeStructure(ctx context.Context, input
 			Value:             prize.Value,
 			Quantity:          prize.Quantity,
 			NumberOfRunnerUps: prize.NumberOfRunnerUps,
+			ClaimWindowHours:  prize.ClaimWindowHours,
+			NotificationTemplate: prize.NotificationTemplate,
 		})
 	}
 	
@@ -82,6 +88,7 @@ func (s *GetPrizeStructureService) GetPrizeStructure(ctx context.Context, input
 		StartDate:   prizeStructure.StartDate,
 		EndDate:     prizeStructure.EndDate,
 		Prizes:      prizeOutputs,
+		EligibilityRules: prizeStructure.EligibilityRules,
 		IsActive:    prizeStructure.IsActive,
 		CreatedBy:   prizeStructure.CreatedBy,
 		CreatedAt:   prizeStructure.CreatedAt,
@@ -8,6 +8,7 @@ import (
 	
 	"github.com/google/uuid"
 	
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/prize"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
 )
@@ -37,6 +38,7 @@ type UpdatePrizeStructureInput struct {
 	StartDate   time.Time
 	EndDate     time.Time
 	Prizes      []UpdatePrizeInput
+	EligibilityRules *draw.EligibilityRules // Optional, the current rules are kept when nil
 	UpdatedBy   uuid.UUID
 	IsActive    bool
 }
@@ -49,6 +51,8 @@ type UpdatePrizeInput struct {
 	Value             float64
 	Quantity          int
 	NumberOfRunnerUps int
+	ClaimWindowHours  int
+	NotificationTemplate string
 }
 
 // UpdatePrizeStructureOutput defines the output for the UpdatePrizeStructure use case
@@ -59,6 +63,7 @@ type UpdatePrizeStructureOutput struct {
 	StartDate   time.Time
 	EndDate     time.Time
 	Prizes      []UpdatePrizeOutput
+	EligibilityRules draw.EligibilityRules
 	IsActive    bool
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
@@ -73,6 +78,8 @@ type UpdatePrizeOutput struct {
 	Value             float64
 	Quantity          int
 	NumberOfRunnerUps int
+	ClaimWindowHours  int
+	NotificationTemplate string
 }
 
 // UpdatePrizeStructure updates an existing prize structure
@@ -104,6 +111,14 @@ func (s *UpdatePrizeStructureService) UpdatePrizeStructure(ctx context.Context,
 	existingPrizeStructure.EndDate = input.EndDate
 	existingPrizeStructure.IsActive = input.IsActive
 	
+	if input.EligibilityRules != nil {
+		eligibilityRules, err := normalizeEligibilityRules(*input.EligibilityRules)
+		if err != nil {
+			return nil, err
+		}
+		existingPrizeStructure.EligibilityRules = eligibilityRules
+	}
+	
 	// Update prizes
 	existingPrizeStructure.Prizes = make([]prize.PrizeTier, 0, len(input.Prizes))
 	// Update prize tier with NumberOfRunnerUps
@@ -117,6 +132,8 @@ func (s *UpdatePrizeStructureService) UpdatePrizeStructure(ctx context.Context,
 			ValueNGN:         0, // Default value, can be calculated if needed
 			Quantity:         prizeInput.Quantity,
 			NumberOfRunnerUps: prizeInput.NumberOfRunnerUps,
+			ClaimWindowHours:  prizeInput.ClaimWindowHours,
+			NotificationTemplate: prizeInput.NotificationTemplate,
 		}
 		
 		if prizeInput.ID == uuid.Nil {
@@ -127,6 +144,10 @@ func (s *UpdatePrizeStructureService) UpdatePrizeStructure(ctx context.Context,
 			prizeTier.ID = prizeInput.ID
 		}
 		
+		if err := prize.ValidatePrizeTier(&prizeTier); err != nil {
+			return nil, prize.NewPrizeError(prize.ErrInvalidPrizeTier, fmt.Sprintf("Invalid prize %d", i+1), err)
+		}
+		
 		existingPrizeStructure.Prizes = append(existingPrizeStructure.Prizes, prizeTier)
 	}
 	
@@ -158,6 +179,8 @@ func (s *UpdatePrizeStructureService) UpdatePrizeStructure(ctx context.Context,
 			Value:             prizeTier.Value,
 			Quantity:          prizeTier.Quantity,
 			NumberOfRunnerUps: prizeTier.NumberOfRunnerUps,
+			ClaimWindowHours:  prizeTier.ClaimWindowHours,
+			NotificationTemplate: prizeTier.NotificationTemplate,
 		})
 	}
 	
@@ -168,6 +191,7 @@ func (s *UpdatePrizeStructureService) UpdatePrizeStructure(ctx context.Context,
 		StartDate:   existingPrizeStructure.StartDate,
 		EndDate:     existingPrizeStructure.EndDate,
 		Prizes:      prizeOutputs,
+		EligibilityRules: existingPrizeStructure.EligibilityRules,
 		IsActive:    existingPrizeStructure.IsActive,
 		CreatedAt:   existingPrizeStructure.CreatedAt,
 		UpdatedAt:   existingPrizeStructure.UpdatedAt,
@@ -0,0 +1,71 @@
+package report
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/report"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/util"
+)
+
+// ExportInput defines the input for exporting a report
+type ExportInput struct {
+	Filters    report.Filters
+	Format     string // Recorded in the audit log; the RowWriter decides the file format
+	MaskMSISDN bool
+	ExportedBy uuid.UUID
+}
+
+// ExportOutput defines the output of exporting a report
+type ExportOutput struct {
+	Rows int // Data rows written, excluding the header and totals
+}
+
+// msisdn returns the MSISDN as it appears in a report
+func (input ExportInput) msisdn(msisdn string) string {
+	if input.MaskMSISDN {
+		return util.MaskMSISDN(msisdn)
+	}
+	return msisdn
+}
+
+// logExport records who exported a report and what it covered
+func logExport(auditService audit.AuditService, action string, input ExportInput, rows int) {
+	// Log audit
+	if err := auditService.LogAudit(
+		action,
+		"Report",
+		input.Filters.DrawID,
+		input.ExportedBy,
+		fmt.Sprintf("Exported %d rows as %s", rows, input.Format),
+		fmt.Sprintf("Draw: %s, Start date: %s, End date: %s, Masked MSISDNs: %t",
+			input.Filters.DrawID, formatDate(input.Filters.StartDate), formatDate(input.Filters.EndDate), input.MaskMSISDN),
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+}
+
+// formatDate formats a day for a report, leaving zero days empty
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
+
+// formatOptionalTime formats an optional time for a report
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// formatAmount formats an amount for a report without currency symbols, so spreadsheets read it as a number
+func formatAmount(amount float64) string {
+	return fmt.Sprintf("%.2f", amount)
+}
@@ -0,0 +1,90 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/report"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/spreadsheet"
+)
+
+// prizeLiabilityHeader is the header row of the prize liability report
+var prizeLiabilityHeader = []string{
+	"Draw Date", "Draw ID", "Prize Tier", "Prize Value", "Winners", "Paid", "In Payment", "Outstanding",
+	"Paid Value", "Liability",
+}
+
+// ExportPrizeLiabilityService exports what is owed on the prizes of completed draws
+type ExportPrizeLiabilityService struct {
+	reportRepository report.ReportRepository
+	auditService     audit.AuditService
+}
+
+// NewExportPrizeLiabilityService creates a new ExportPrizeLiabilityService
+func NewExportPrizeLiabilityService(reportRepository report.ReportRepository, auditService audit.AuditService) *ExportPrizeLiabilityService {
+	return &ExportPrizeLiabilityService{
+		reportRepository: reportRepository,
+		auditService:     auditService,
+	}
+}
+
+// ExportPrizeLiability writes one row per prize tier of each draw selected by the filters to w,
+// followed by a totals row
+func (s *ExportPrizeLiabilityService) ExportPrizeLiability(ctx context.Context, input ExportInput, w spreadsheet.RowWriter) (*ExportOutput, error) {
+	if err := input.Filters.Validate(); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.reportRepository.ListPrizeLiability(input.Filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export prize liability: %w", err)
+	}
+
+	if err := w.Write(prizeLiabilityHeader); err != nil {
+		return nil, fmt.Errorf("failed to write report header: %w", err)
+	}
+
+	var total report.PrizeLiabilityRow
+	var totalPaidValue, totalLiability float64
+	for _, row := range rows {
+		paidValue := float64(row.Paid) * row.PrizeValue
+		if err := w.Write([]string{
+			formatDate(row.DrawDate),
+			row.DrawID.String(),
+			row.PrizeTierName,
+			formatAmount(row.PrizeValue),
+			strconv.Itoa(row.Winners),
+			strconv.Itoa(row.Paid),
+			strconv.Itoa(row.InPayment),
+			strconv.Itoa(row.Outstanding()),
+			formatAmount(paidValue),
+			formatAmount(row.Liability()),
+		}); err != nil {
+			return nil, err
+		}
+
+		total.Winners += row.Winners
+		total.Paid += row.Paid
+		total.InPayment += row.InPayment
+		totalPaidValue += paidValue
+		totalLiability += row.Liability()
+	}
+
+	if err := w.Write([]string{
+		"Total", "", "", "",
+		strconv.Itoa(total.Winners),
+		strconv.Itoa(total.Paid),
+		strconv.Itoa(total.InPayment),
+		strconv.Itoa(total.Outstanding()),
+		formatAmount(totalPaidValue),
+		formatAmount(totalLiability),
+	}); err != nil {
+		return nil, err
+	}
+
+	logExport(s.auditService, "EXPORT_PRIZE_LIABILITY", input, len(rows))
+
+	return &ExportOutput{Rows: len(rows)}, nil
+}
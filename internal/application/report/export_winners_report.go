@@ -0,0 +1,74 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/report"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/spreadsheet"
+)
+
+// winnersReportHeader is the header row of the winners report
+var winnersReportHeader = []string{
+	"Draw Date", "Draw ID", "Prize Tier", "Prize Value", "MSISDN", "Runner-Up", "Runner-Up Rank",
+	"Status", "Payment Status", "Payment Reference", "Claim Deadline", "Claimed At", "Paid At",
+}
+
+// ExportWinnersReportService exports the winners and runner-ups of completed draws
+type ExportWinnersReportService struct {
+	reportRepository report.ReportRepository
+	auditService     audit.AuditService
+}
+
+// NewExportWinnersReportService creates a new ExportWinnersReportService
+func NewExportWinnersReportService(reportRepository report.ReportRepository, auditService audit.AuditService) *ExportWinnersReportService {
+	return &ExportWinnersReportService{
+		reportRepository: reportRepository,
+		auditService:     auditService,
+	}
+}
+
+// ExportWinnersReport writes the winners of the draws selected by the filters to w, one row per winner
+func (s *ExportWinnersReportService) ExportWinnersReport(ctx context.Context, input ExportInput, w spreadsheet.RowWriter) (*ExportOutput, error) {
+	if err := input.Filters.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := w.Write(winnersReportHeader); err != nil {
+		return nil, fmt.Errorf("failed to write report header: %w", err)
+	}
+
+	rows := 0
+	err := s.reportRepository.StreamWinners(input.Filters, func(row report.WinnerRow) error {
+		rows++
+		runnerUpRank := ""
+		if row.IsRunnerUp {
+			runnerUpRank = strconv.Itoa(row.RunnerUpRank)
+		}
+
+		return w.Write([]string{
+			formatDate(row.DrawDate),
+			row.DrawID.String(),
+			row.PrizeTierName,
+			formatAmount(row.PrizeValue),
+			input.msisdn(row.MSISDN),
+			strconv.FormatBool(row.IsRunnerUp),
+			runnerUpRank,
+			row.Status,
+			row.PaymentStatus,
+			row.PaymentReference,
+			formatOptionalTime(row.ClaimDeadline),
+			formatOptionalTime(row.ClaimedAt),
+			formatOptionalTime(row.PaidAt),
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export winners report: %w", err)
+	}
+
+	logExport(s.auditService, "EXPORT_WINNERS_REPORT", input, rows)
+
+	return &ExportOutput{Rows: rows}, nil
+}
@@ -0,0 +1,147 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/report"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/pdf"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/util"
+)
+
+// GenerateWinnersCertificateOutput defines the output of generating a winners certificate
+type GenerateWinnersCertificateOutput struct {
+	DrawID    uuid.UUID
+	PDF       []byte
+	Signature string // Base64 encoded Ed25519 signature of the certificate text
+	KeyID     string
+}
+
+// GenerateWinnersCertificateService renders the signed winners certificate of a completed draw
+type GenerateWinnersCertificateService struct {
+	drawRepository   draw.DrawRepository
+	reportRepository report.ReportRepository
+	signingKey       ed25519.PrivateKey
+	auditService     audit.AuditService
+}
+
+// NewGenerateWinnersCertificateService creates a new GenerateWinnersCertificateService.
+// Certificates cannot be generated while signingKey is nil.
+func NewGenerateWinnersCertificateService(
+	drawRepository draw.DrawRepository,
+	reportRepository report.ReportRepository,
+	signingKey ed25519.PrivateKey,
+	auditService audit.AuditService,
+) *GenerateWinnersCertificateService {
+	return &GenerateWinnersCertificateService{
+		drawRepository:   drawRepository,
+		reportRepository: reportRepository,
+		signingKey:       signingKey,
+		auditService:     auditService,
+	}
+}
+
+// GenerateWinnersCertificate renders a PDF certificate listing the prize winners of a completed draw,
+// with masked MSISDNs, signed so that the printed text can be verified against the public key
+func (s *GenerateWinnersCertificateService) GenerateWinnersCertificate(ctx context.Context, drawID, generatedBy uuid.UUID) (*GenerateWinnersCertificateOutput, error) {
+	if drawID == uuid.Nil {
+		return nil, errors.New("draw ID is required")
+	}
+
+	if s.signingKey == nil {
+		return nil, report.NewReportError(report.ErrSigningKeyMissing, "No certificate signing key is configured", nil)
+	}
+
+	drawEntity, err := s.drawRepository.GetByID(drawID)
+	if err != nil {
+		return nil, err
+	}
+
+	if drawEntity.Status != draw.StatusCompleted {
+		return nil, report.NewReportError(report.ErrDrawNotCertifiable, "Only completed draws have a winners certificate, draw status is "+drawEntity.Status, nil)
+	}
+
+	certificate := report.Certificate{
+		DrawID:               drawEntity.ID,
+		DrawDate:             drawEntity.DrawDate,
+		AlgorithmVersion:     drawEntity.AlgorithmVersion,
+		SeedHash:             drawEntity.SeedHash,
+		EntriesHash:          drawEntity.EntriesHash,
+		TotalEligibleMSISDNs: drawEntity.TotalEligibleMSISDNs,
+		TotalEntries:         drawEntity.TotalEntries,
+		IssuedAt:             time.Now(),
+	}
+
+	err = s.reportRepository.StreamWinners(report.Filters{DrawID: drawID}, func(row report.WinnerRow) error {
+		// List the current holders of the prizes, including runner-ups promoted to a prize
+		holder := draw.Winner{Status: row.Status, IsRunnerUp: row.IsRunnerUp}
+		if !holder.HoldsPrize() {
+			return nil
+		}
+
+		certificate.Winners = append(certificate.Winners, report.CertificateWinner{
+			PrizeTierName: row.PrizeTierName,
+			PrizeValue:    row.PrizeValue,
+			MSISDN:        util.MaskMSISDN(row.MSISDN),
+			Status:        row.Status,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draw winners: %w", err)
+	}
+
+	signature := base64.StdEncoding.EncodeToString(certificate.Sign(s.signingKey))
+	publicKey := s.signingKey.Public().(ed25519.PublicKey)
+	keyID := report.KeyID(publicKey)
+
+	document := pdf.NewDocument("Winners Certificate " + drawEntity.DrawDate.Format("2006-01-02"))
+	document.SetInfo("Subject", "Draw "+drawEntity.ID.String())
+	document.SetInfo("Keywords", "signature="+signature+" keyId="+keyID)
+	document.Heading("Winners Certificate", 18)
+	document.Space(11)
+	for _, line := range certificate.Lines() {
+		document.Text(line, 11)
+	}
+	document.Space(11)
+	document.Heading("Signature", 12)
+	document.Text("The Ed25519 signature below covers the lines from \"Draw ID\" to the last winner, joined by newlines.", 9)
+	document.Text("Key ID: "+keyID, 9)
+	document.Text("Public key: "+hex.EncodeToString(publicKey), 9)
+	document.Text("Signature: "+signature, 9)
+
+	var buf bytes.Buffer
+	if _, err := document.WriteTo(&buf); err != nil {
+		return nil, fmt.Errorf("failed to render certificate: %w", err)
+	}
+
+	// Log audit
+	if err := s.auditService.LogAudit(
+		"GENERATE_WINNERS_CERTIFICATE",
+		"Draw",
+		drawEntity.ID,
+		generatedBy,
+		fmt.Sprintf("Winners certificate generated for draw of %s", drawEntity.DrawDate.Format("2006-01-02")),
+		fmt.Sprintf("Winners: %d, Key ID: %s, Signature: %s", len(certificate.Winners), keyID, signature),
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
+	return &GenerateWinnersCertificateOutput{
+		DrawID:    drawEntity.ID,
+		PDF:       buf.Bytes(),
+		Signature: signature,
+		KeyID:     keyID,
+	}, nil
+}
@@ -0,0 +1,157 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/prize"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/schedule"
+)
+
+// DefaultTimeZone is used for schedules created without a time zone
+const DefaultTimeZone = "Africa/Lagos"
+
+// CreateDrawScheduleService provides functionality for creating draw schedules
+type CreateDrawScheduleService struct {
+	scheduleRepository schedule.DrawScheduleRepository
+	prizeRepository    prize.PrizeRepository
+	auditService       audit.AuditService
+}
+
+// NewCreateDrawScheduleService creates a new CreateDrawScheduleService
+func NewCreateDrawScheduleService(
+	scheduleRepository schedule.DrawScheduleRepository,
+	prizeRepository prize.PrizeRepository,
+	auditService audit.AuditService,
+) *CreateDrawScheduleService {
+	return &CreateDrawScheduleService{
+		scheduleRepository: scheduleRepository,
+		prizeRepository:    prizeRepository,
+		auditService:       auditService,
+	}
+}
+
+// CreateDrawScheduleInput defines the input for the CreateDrawSchedule use case
+type CreateDrawScheduleInput struct {
+	Name             string
+	CronExpression   string
+	TimeZone         string    // Optional, defaults to DefaultTimeZone
+	PrizeStructureID uuid.UUID // Optional, the structure active on each draw date is used when nil
+	Enabled          bool
+	CreatedBy        uuid.UUID
+}
+
+// CreateDrawSchedule creates a draw schedule. Its first run is the first time the cron
+// expression fires after creation; earlier dates are not caught up.
+func (s *CreateDrawScheduleService) CreateDrawSchedule(ctx context.Context, input CreateDrawScheduleInput) (*schedule.DrawSchedule, error) {
+	if input.CreatedBy == uuid.Nil {
+		return nil, errors.New("created by is required")
+	}
+
+	if input.TimeZone == "" {
+		input.TimeZone = DefaultTimeZone
+	}
+
+	if err := validateSchedule(s.prizeRepository, input.Name, input.CronExpression, input.TimeZone, input.PrizeStructureID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	drawSchedule := &schedule.DrawSchedule{
+		ID:               uuid.New(),
+		Name:             strings.TrimSpace(input.Name),
+		CronExpression:   strings.TrimSpace(input.CronExpression),
+		TimeZone:         input.TimeZone,
+		PrizeStructureID: input.PrizeStructureID,
+		Enabled:          input.Enabled,
+		CreatedBy:        input.CreatedBy,
+		UpdatedBy:        input.CreatedBy,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+
+	if err := checkOverlap(s.scheduleRepository, drawSchedule); err != nil {
+		return nil, err
+	}
+
+	if err := s.scheduleRepository.Create(drawSchedule); err != nil {
+		return nil, fmt.Errorf("failed to create draw schedule: %w", err)
+	}
+
+	// Log audit
+	if err := s.auditService.LogAudit(
+		"CREATE_DRAW_SCHEDULE",
+		"DrawSchedule",
+		drawSchedule.ID,
+		input.CreatedBy,
+		fmt.Sprintf("Draw schedule created: %s", drawSchedule.Name),
+		describeSchedule(drawSchedule),
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
+	return drawSchedule, nil
+}
+
+// validateSchedule validates a schedule's fields and checks that a fixed prize structure exists
+func validateSchedule(prizeRepository prize.PrizeRepository, name, cronExpression, timeZone string, prizeStructureID uuid.UUID) error {
+	if err := schedule.ValidateSchedule(name, cronExpression, timeZone); err != nil {
+		return schedule.NewScheduleError(schedule.ErrInvalidSchedule, err.Error(), nil)
+	}
+
+	if prizeStructureID != uuid.Nil {
+		if _, err := prizeRepository.GetPrizeStructureByID(prizeStructureID); err != nil {
+			return schedule.NewScheduleError(schedule.ErrInvalidSchedule, "Prize structure not found", err)
+		}
+	}
+
+	return nil
+}
+
+// checkOverlap rejects an enabled schedule that can fire on the draw date of another enabled
+// schedule. A date has only one draw, so one of the two runs would always be skipped.
+func checkOverlap(scheduleRepository schedule.DrawScheduleRepository, drawSchedule *schedule.DrawSchedule) error {
+	if !drawSchedule.Enabled {
+		return nil
+	}
+
+	schedules, err := scheduleRepository.ListEnabled()
+	if err != nil {
+		return fmt.Errorf("failed to list draw schedules: %w", err)
+	}
+
+	for i := range schedules {
+		other := &schedules[i]
+		if other.ID == drawSchedule.ID {
+			continue
+		}
+
+		overlaps, err := drawSchedule.SharesDrawDates(other)
+		if err != nil {
+			// The other schedule cannot run either, so it cannot take a date
+			continue
+		}
+		if overlaps {
+			return schedule.NewScheduleError(schedule.ErrScheduleOverlap,
+				fmt.Sprintf("Draw schedule can fire on the same draw date as schedule %q; only one draw is held per date", other.Name), nil)
+		}
+	}
+
+	return nil
+}
+
+// describeSchedule summarises a schedule's settings for audit logs
+func describeSchedule(s *schedule.DrawSchedule) string {
+	prizeStructure := "active on draw date"
+	if s.PrizeStructureID != uuid.Nil {
+		prizeStructure = s.PrizeStructureID.String()
+	}
+	return fmt.Sprintf("Cron: %s, Time zone: %s, Prize structure: %s, Enabled: %t", s.CronExpression, s.TimeZone, prizeStructure, s.Enabled)
+}
@@ -0,0 +1,64 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/schedule"
+)
+
+// DeleteDrawScheduleService provides functionality for deleting draw schedules
+type DeleteDrawScheduleService struct {
+	scheduleRepository schedule.DrawScheduleRepository
+	auditService       audit.AuditService
+}
+
+// NewDeleteDrawScheduleService creates a new DeleteDrawScheduleService
+func NewDeleteDrawScheduleService(
+	scheduleRepository schedule.DrawScheduleRepository,
+	auditService audit.AuditService,
+) *DeleteDrawScheduleService {
+	return &DeleteDrawScheduleService{
+		scheduleRepository: scheduleRepository,
+		auditService:       auditService,
+	}
+}
+
+// DeleteDrawSchedule deletes a draw schedule. Draws it already ran are kept.
+func (s *DeleteDrawScheduleService) DeleteDrawSchedule(ctx context.Context, id uuid.UUID, deletedBy uuid.UUID) error {
+	if id == uuid.Nil {
+		return errors.New("draw schedule ID is required")
+	}
+
+	if deletedBy == uuid.Nil {
+		return errors.New("deleted by is required")
+	}
+
+	drawSchedule, err := s.scheduleRepository.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.scheduleRepository.Delete(id); err != nil {
+		return fmt.Errorf("failed to delete draw schedule: %w", err)
+	}
+
+	// Log audit
+	if err := s.auditService.LogAudit(
+		"DELETE_DRAW_SCHEDULE",
+		"DrawSchedule",
+		id,
+		deletedBy,
+		fmt.Sprintf("Draw schedule deleted: %s", drawSchedule.Name),
+		describeSchedule(drawSchedule),
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
+	return nil
+}
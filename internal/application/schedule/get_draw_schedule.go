@@ -0,0 +1,31 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/schedule"
+)
+
+// GetDrawScheduleService provides functionality for retrieving a draw schedule
+type GetDrawScheduleService struct {
+	scheduleRepository schedule.DrawScheduleRepository
+}
+
+// NewGetDrawScheduleService creates a new GetDrawScheduleService
+func NewGetDrawScheduleService(scheduleRepository schedule.DrawScheduleRepository) *GetDrawScheduleService {
+	return &GetDrawScheduleService{
+		scheduleRepository: scheduleRepository,
+	}
+}
+
+// GetDrawSchedule retrieves a draw schedule by ID
+func (s *GetDrawScheduleService) GetDrawSchedule(ctx context.Context, id uuid.UUID) (*schedule.DrawSchedule, error) {
+	if id == uuid.Nil {
+		return nil, errors.New("draw schedule ID is required")
+	}
+
+	return s.scheduleRepository.GetByID(id)
+}
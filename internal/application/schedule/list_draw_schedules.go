@@ -0,0 +1,30 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/schedule"
+)
+
+// ListDrawSchedulesService provides functionality for listing draw schedules
+type ListDrawSchedulesService struct {
+	scheduleRepository schedule.DrawScheduleRepository
+}
+
+// NewListDrawSchedulesService creates a new ListDrawSchedulesService
+func NewListDrawSchedulesService(scheduleRepository schedule.DrawScheduleRepository) *ListDrawSchedulesService {
+	return &ListDrawSchedulesService{
+		scheduleRepository: scheduleRepository,
+	}
+}
+
+// ListDrawSchedules retrieves every draw schedule
+func (s *ListDrawSchedulesService) ListDrawSchedules(ctx context.Context) ([]schedule.DrawSchedule, error) {
+	schedules, err := s.scheduleRepository.List()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list draw schedules: %w", err)
+	}
+
+	return schedules, nil
+}
@@ -0,0 +1,198 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	drawApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/prize"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/schedule"
+)
+
+// DrawExecutor executes a draw, as ExecuteDrawService does
+type DrawExecutor interface {
+	ExecuteDraw(input drawApp.ExecuteDrawInput) (*drawApp.ExecuteDrawOutput, error)
+}
+
+// RunDueDrawSchedulesService executes the draws of every schedule that has fired since it last ran
+type RunDueDrawSchedulesService struct {
+	scheduleRepository schedule.DrawScheduleRepository
+	prizeRepository    prize.PrizeRepository
+	drawExecutor       DrawExecutor
+	runLock            schedule.RunLock
+	auditService       audit.AuditService
+	maxCatchUpRuns     int
+}
+
+// NewRunDueDrawSchedulesService creates a new RunDueDrawSchedulesService. maxCatchUpRuns
+// limits how many missed runs of one schedule are executed, the latest being kept.
+func NewRunDueDrawSchedulesService(
+	scheduleRepository schedule.DrawScheduleRepository,
+	prizeRepository prize.PrizeRepository,
+	drawExecutor DrawExecutor,
+	runLock schedule.RunLock,
+	auditService audit.AuditService,
+	maxCatchUpRuns int,
+) *RunDueDrawSchedulesService {
+	return &RunDueDrawSchedulesService{
+		scheduleRepository: scheduleRepository,
+		prizeRepository:    prizeRepository,
+		drawExecutor:       drawExecutor,
+		runLock:            runLock,
+		auditService:       auditService,
+		maxCatchUpRuns:     maxCatchUpRuns,
+	}
+}
+
+// ScheduleRunOutput describes one scheduled draw execution
+type ScheduleRunOutput struct {
+	ScheduleID uuid.UUID
+	RunAt      time.Time
+	DrawDate   time.Time
+	Status     string
+	DrawID     uuid.UUID
+	Error      string
+}
+
+// RunDueDrawSchedulesOutput defines the output for the RunDueDrawSchedules use case
+type RunDueDrawSchedulesOutput struct {
+	LockAcquired bool // False when another server is running the schedules
+	Runs         []ScheduleRunOutput
+}
+
+// RunDueDrawSchedules executes every run that is due at now, oldest first, including runs
+// missed while no server was up. Each run is recorded on its schedule as soon as it finishes,
+// so a crash part way through does not repeat the runs already made.
+func (s *RunDueDrawSchedulesService) RunDueDrawSchedules(ctx context.Context, now time.Time) (*RunDueDrawSchedulesOutput, error) {
+	output := &RunDueDrawSchedulesOutput{
+		Runs: make([]ScheduleRunOutput, 0),
+	}
+
+	acquired, err := s.runLock.TryRun(func() error {
+		schedules, err := s.scheduleRepository.ListEnabled()
+		if err != nil {
+			return fmt.Errorf("failed to list draw schedules: %w", err)
+		}
+
+		for i := range schedules {
+			runs, err := s.runSchedule(&schedules[i], now)
+			output.Runs = append(output.Runs, runs...)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	output.LockAcquired = acquired
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+// runSchedule executes the due runs of one schedule
+func (s *RunDueDrawSchedulesService) runSchedule(drawSchedule *schedule.DrawSchedule, now time.Time) ([]ScheduleRunOutput, error) {
+	dueRuns, err := drawSchedule.DueRuns(now, s.maxCatchUpRuns)
+	if err != nil {
+		// An invalid schedule cannot run; record it rather than stopping the others
+		run := ScheduleRunOutput{
+			ScheduleID: drawSchedule.ID,
+			RunAt:      now,
+			Status:     schedule.RunStatusFailed,
+			Error:      fmt.Sprintf("schedule cannot be evaluated: %v", err),
+		}
+
+		// The error is recorded once rather than on every check
+		if drawSchedule.LastRunStatus != run.Status || drawSchedule.LastRunError != run.Error {
+			drawSchedule.LastRunStatus = run.Status
+			drawSchedule.LastRunError = run.Error
+			drawSchedule.UpdatedAt = time.Now()
+			if err := s.scheduleRepository.Update(drawSchedule); err != nil {
+				return []ScheduleRunOutput{run}, fmt.Errorf("failed to record draw schedule run: %w", err)
+			}
+		}
+		return []ScheduleRunOutput{run}, nil
+	}
+
+	loc, _ := drawSchedule.Location()
+	outputs := make([]ScheduleRunOutput, 0, len(dueRuns))
+	for _, runAt := range dueRuns {
+		run := s.executeRun(drawSchedule, runAt, schedule.DrawDate(runAt, loc))
+		outputs = append(outputs, run)
+
+		drawSchedule.LastRunAt = &run.RunAt
+		drawSchedule.LastRunStatus = run.Status
+		drawSchedule.LastRunError = run.Error
+		if run.DrawID != uuid.Nil {
+			drawSchedule.LastDrawID = run.DrawID
+		}
+		drawSchedule.UpdatedAt = time.Now()
+		if err := s.scheduleRepository.Update(drawSchedule); err != nil {
+			return outputs, fmt.Errorf("failed to record draw schedule run: %w", err)
+		}
+
+		// Log audit
+		if err := s.auditService.LogAudit(
+			"RUN_DRAW_SCHEDULE",
+			"DrawSchedule",
+			drawSchedule.ID,
+			schedule.SystemActorID,
+			fmt.Sprintf("Scheduled draw for %s: %s", run.DrawDate.Format("2006-01-02"), run.Status),
+			fmt.Sprintf("Schedule: %s, Fired at: %s, Draw: %s, Error: %s", drawSchedule.Name, run.RunAt.Format(time.RFC3339), run.DrawID, run.Error),
+		); err != nil {
+			// Log error but continue
+			fmt.Printf("Failed to log audit: %v\n", err)
+		}
+	}
+
+	return outputs, nil
+}
+
+// executeRun executes the draw for one fire time
+func (s *RunDueDrawSchedulesService) executeRun(drawSchedule *schedule.DrawSchedule, runAt, drawDate time.Time) ScheduleRunOutput {
+	run := ScheduleRunOutput{
+		ScheduleID: drawSchedule.ID,
+		RunAt:      runAt,
+		DrawDate:   drawDate,
+	}
+
+	prizeStructureID := drawSchedule.PrizeStructureID
+	if prizeStructureID == uuid.Nil {
+		prizeStructure, err := s.prizeRepository.GetActivePrizeStructure(drawDate)
+		if err != nil {
+			run.Status = schedule.RunStatusFailed
+			run.Error = fmt.Sprintf("failed to get active prize structure: %v", err)
+			return run
+		}
+		prizeStructureID = prizeStructure.ID
+	}
+
+	result, err := s.drawExecutor.ExecuteDraw(drawApp.ExecuteDrawInput{
+		DrawDate:          drawDate,
+		PrizeStructureID:  prizeStructureID,
+		ExecutedByAdminID: schedule.SystemActorID,
+	})
+	if err != nil {
+		var drawErr *draw.DrawError
+		if errors.As(err, &drawErr) && drawErr.Code == draw.ErrDrawAlreadyExists {
+			run.Status = schedule.RunStatusSkipped
+			run.Error = drawErr.Message
+			return run
+		}
+		run.Status = schedule.RunStatusFailed
+		run.Error = err.Error()
+		return run
+	}
+
+	run.Status = schedule.RunStatusSucceeded
+	run.DrawID = result.DrawID
+	return run
+}
@@ -6,33 +6,39 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/user"
 )
 
 // AuthenticateUserService provides functionality for authenticating users
 type AuthenticateUserService struct {
-	userRepository user.UserRepository
-	auditService   audit.AuditService
-	jwtSecret      string
+	userRepository      user.UserRepository
+	twoFactorRepository auth.TwoFactorRepository
+	auditService        audit.AuditService
+	tokenIssuer         *tokenIssuer
+	loginGuard          *loginGuard
 }
 
 // NewAuthenticateUserService creates a new AuthenticateUserService
 func NewAuthenticateUserService(
 	userRepository user.UserRepository,
+	tokenRepository auth.TokenRepository,
+	loginAttemptRepository auth.LoginAttemptRepository,
+	twoFactorRepository auth.TwoFactorRepository,
 	auditService audit.AuditService,
+	tokenSettings TokenSettings,
+	lockoutPolicy auth.LockoutPolicy,
 ) *AuthenticateUserService {
-	// Get JWT secret from environment variable or use default
-	jwtSecret := getEnvOrDefault("JWT_SECRET", "mynumba-donwin-jwt-secret-key-2025")
-	
 	return &AuthenticateUserService{
-		userRepository: userRepository,
-		auditService:   auditService,
-		jwtSecret:      jwtSecret,
+		userRepository:      userRepository,
+		twoFactorRepository: twoFactorRepository,
+		auditService:        auditService,
+		tokenIssuer:         newTokenIssuer(tokenRepository, tokenSettings),
+		loginGuard:          newLoginGuard(loginAttemptRepository, auditService, lockoutPolicy),
 	}
 }
 
@@ -60,11 +66,19 @@ type AuthenticateUserInput struct {
 	UserAgent string
 }
 
-// AuthenticateUserOutput defines the output for the AuthenticateUser use case
+// AuthenticateUserOutput defines the output for the AuthenticateUser use case. Users with
+// two-factor authentication get no tokens from their password alone: TwoFactorRequired is set
+// and ChallengeToken must be exchanged with a TOTP or recovery code through VerifyTwoFactorLogin.
 type AuthenticateUserOutput struct {
-	Token     string
-	User      UserOutput
-	ExpiresAt time.Time
+	Token            string
+	User             UserOutput
+	ExpiresAt        time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+	
+	TwoFactorRequired  bool
+	ChallengeToken     string
+	ChallengeExpiresAt time.Time
 }
 
 // UserOutput defines the output for a user
@@ -86,6 +100,12 @@ func (s *AuthenticateUserService) AuthenticateUser(ctx context.Context, input Au
 		return nil, errors.New("password is required")
 	}
 	
+	// Refuse attempts while the account or IP address is locked out or must wait
+	accountSubject := auth.NormalizeLoginSubject(input.Email)
+	if err := s.loginGuard.check(accountSubject, input.IPAddress); err != nil {
+		return nil, err
+	}
+	
 	// Get user by email - Using GetByEmail to match interface
 	userEntity, err := s.userRepository.GetByEmail(input.Email)
 	if err != nil {
@@ -102,6 +122,11 @@ func (s *AuthenticateUserService) AuthenticateUser(ctx context.Context, input Au
 			fmt.Printf("Failed to log audit: %v\n", err)
 		}
 		
+		// Unknown accounts are counted too, so lockouts do not reveal which emails exist
+		if err := s.loginGuard.recordFailure(uuid.Nil, accountSubject, input.IPAddress, input.UserAgent); err != nil {
+			return nil, err
+		}
+		
 		return nil, errors.New("invalid email or password")
 	}
 	
@@ -138,33 +163,44 @@ func (s *AuthenticateUserService) AuthenticateUser(ctx context.Context, input Au
 			fmt.Printf("Failed to log audit: %v\n", err)
 		}
 		
+		if err := s.loginGuard.recordFailure(userEntity.ID, accountSubject, input.IPAddress, input.UserAgent); err != nil {
+			return nil, err
+		}
+		
 		return nil, errors.New("invalid email or password")
 	}
 	
-	// Generate JWT token
-	expiresAt := time.Now().Add(24 * time.Hour) // Token expires in 24 hours
-	
-	// Create the claims
-	claims := jwt.MapClaims{
-		"user_id":  userEntity.ID.String(),
-		"email":    userEntity.Email,
-		"username": userEntity.Username,
-		"role":     userEntity.Role,           // Single role for backward compatibility
-		"roles":    []string{userEntity.Role}, // Array of roles for future extensibility
-		"exp":      jwt.NewNumericDate(expiresAt).Unix(),
-		"iat":      jwt.NewNumericDate(time.Now()).Unix(),
-		"nbf":      jwt.NewNumericDate(time.Now()).Unix(),
-		"iss":      "mynumba-donwin-api",
-		"sub":      userEntity.ID.String(),
+	// Users with two-factor authentication finish logging in with their second factor
+	twoFactor, err := s.twoFactorRepository.GetTwoFactor(userEntity.ID)
+	if err != nil && !hasAuthErrorCode(err, auth.ErrTwoFactorNotEnrolled) {
+		return nil, err
+	}
+	if err == nil && twoFactor.IsEnabled() {
+		challengeToken, challengeExpiresAt, err := s.tokenIssuer.issueLoginChallenge(userEntity)
+		if err != nil {
+			return nil, err
+		}
+		
+		return &AuthenticateUserOutput{
+			User:               toUserOutput(userEntity),
+			TwoFactorRequired:  true,
+			ChallengeToken:     challengeToken,
+			ChallengeExpiresAt: challengeExpiresAt,
+		}, nil
 	}
 	
-	// Create the token
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return s.completeLogin(userEntity, input.IPAddress, input.UserAgent, "password")
+}
+
+// completeLogin clears the account's failed logins and starts a new session for a user who
+// has presented every factor required. factors names them in the audit log.
+func (s *AuthenticateUserService) completeLogin(userEntity *user.User, ipAddress, userAgent, factors string) (*AuthenticateUserOutput, error) {
+	s.loginGuard.reset(auth.NormalizeLoginSubject(userEntity.Email))
 	
-	// Sign the token with the secret key
-	tokenString, err := token.SignedString([]byte(s.jwtSecret))
+	// Issue an access token and the refresh token of a new session
+	tokens, err := s.tokenIssuer.issue(userEntity, uuid.Nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate token: %w", err)
+		return nil, err
 	}
 	
 	// Log successful login - Using string format for details parameter
@@ -173,21 +209,28 @@ func (s *AuthenticateUserService) AuthenticateUser(ctx context.Context, input Au
 		"User",
 		userEntity.ID,
 		userEntity.ID,
-		fmt.Sprintf("Successful login for user: %s", input.Email),
-		fmt.Sprintf("ip_address: %s, user_agent: %s", input.IPAddress, input.UserAgent),
+		fmt.Sprintf("Successful login for user: %s", userEntity.Email),
+		fmt.Sprintf("ip_address: %s, user_agent: %s, factors: %s", ipAddress, userAgent, factors),
 	); err != nil {
 		// Log error but continue
 		fmt.Printf("Failed to log audit: %v\n", err)
 	}
 	
 	return &AuthenticateUserOutput{
-		Token: tokenString,
-		User: UserOutput{
-			ID:       userEntity.ID,
-			Email:    userEntity.Email,
-			Username: userEntity.Username,
-			Role:     userEntity.Role,
-		},
-		ExpiresAt: expiresAt,
+		Token:            tokens.AccessToken,
+		User:             toUserOutput(userEntity),
+		ExpiresAt:        tokens.AccessExpiresAt,
+		RefreshToken:     tokens.RefreshToken,
+		RefreshExpiresAt: tokens.RefreshExpiresAt,
 	}, nil
 }
+
+// toUserOutput converts a user to the output of the login use cases
+func toUserOutput(userEntity *user.User) UserOutput {
+	return UserOutput{
+		ID:       userEntity.ID,
+		Email:    userEntity.Email,
+		Username: userEntity.Username,
+		Role:     userEntity.Role,
+	}
+}
@@ -69,6 +69,13 @@ func (s *CreateUserService) CreateUser(ctx context.Context, input CreateUserInpu
 		return nil, errors.New("role is required")
 	}
 	
+	// Store the canonical spelling of the role
+	role, ok := user.NormalizeRole(input.Role)
+	if !ok {
+		return nil, user.NewUserError(user.ErrInvalidRole, "Invalid role: "+input.Role, nil)
+	}
+	input.Role = role
+	
 	// Check if username already exists
 	existingUser, err := s.userRepository.GetByUsername(input.Username)
 	if err == nil && existingUser != nil {
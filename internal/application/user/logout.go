@@ -0,0 +1,78 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
+)
+
+// LogoutService ends login sessions
+type LogoutService struct {
+	tokenRepository auth.TokenRepository
+	auditService    audit.AuditService
+}
+
+// NewLogoutService creates a new LogoutService
+func NewLogoutService(
+	tokenRepository auth.TokenRepository,
+	auditService audit.AuditService,
+) *LogoutService {
+	return &LogoutService{
+		tokenRepository: tokenRepository,
+		auditService:    auditService,
+	}
+}
+
+// LogoutInput defines the input for the Logout use case
+type LogoutInput struct {
+	UserID          uuid.UUID
+	SessionID       uuid.UUID // sid claim of the access token; uuid.Nil for tokens issued before sessions
+	AccessTokenID   string    // jti claim of the access token
+	AccessExpiresAt time.Time
+}
+
+// Logout revokes the access token used for the request and every token of its session
+func (s *LogoutService) Logout(ctx context.Context, input LogoutInput) error {
+	if input.UserID == uuid.Nil {
+		return errors.New("user ID is required")
+	}
+
+	now := time.Now()
+	if input.SessionID != uuid.Nil {
+		if err := s.tokenRepository.RevokeFamily(input.SessionID, now); err != nil {
+			return err
+		}
+	}
+
+	if input.AccessTokenID != "" {
+		if err := s.tokenRepository.RevokeAccessToken(auth.RevokedToken{
+			TokenID:   input.AccessTokenID,
+			UserID:    input.UserID,
+			ExpiresAt: input.AccessExpiresAt,
+			RevokedAt: now,
+		}); err != nil {
+			return err
+		}
+	}
+
+	// Log audit
+	if err := s.auditService.LogAudit(
+		"LOGOUT",
+		"User",
+		input.UserID,
+		input.UserID,
+		"User logged out",
+		fmt.Sprintf("session_id: %s", input.SessionID),
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
+	return nil
+}
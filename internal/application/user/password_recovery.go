@@ -0,0 +1,348 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/mail"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/user"
+)
+
+// PasswordResetSettings configures the password reset links mailed to users
+type PasswordResetSettings struct {
+	TokenExpiry time.Duration
+	ResetURL    string // Page of the admin portal the token is appended to; the bare token is mailed while empty
+
+	// Limits on reset requests, each counted over RequestWindow. Zero values use
+	// auth.DefaultPasswordResetPolicy.
+	MaxRequestsPerEmail int
+	MaxRequestsPerIP    int
+	RequestWindow       time.Duration
+}
+
+// PasswordRecoveryService lets users who forgot their password choose a new one through a
+// single-use token mailed to them
+type PasswordRecoveryService struct {
+	userRepository               user.UserRepository
+	passwordResetTokenRepository auth.PasswordResetTokenRepository
+	tokenRepository              auth.TokenRepository
+	loginAttemptRepository       auth.LoginAttemptRepository
+	mailer                       mail.Mailer
+	auditService                 audit.AuditService
+	settings                     PasswordResetSettings
+	requestPolicy                auth.LockoutPolicy
+}
+
+// NewPasswordRecoveryService creates a new PasswordRecoveryService. Reset requests are counted
+// where failed logins are, under scopes of their own.
+func NewPasswordRecoveryService(
+	userRepository user.UserRepository,
+	passwordResetTokenRepository auth.PasswordResetTokenRepository,
+	tokenRepository auth.TokenRepository,
+	loginAttemptRepository auth.LoginAttemptRepository,
+	mailer mail.Mailer,
+	auditService audit.AuditService,
+	settings PasswordResetSettings,
+) *PasswordRecoveryService {
+	if settings.TokenExpiry <= 0 {
+		settings.TokenExpiry = 30 * time.Minute
+	}
+
+	requestPolicy := auth.DefaultPasswordResetPolicy()
+	if settings.MaxRequestsPerEmail > 0 {
+		requestPolicy.MaxAccountFailures = settings.MaxRequestsPerEmail
+	}
+	if settings.MaxRequestsPerIP > 0 {
+		requestPolicy.MaxIPFailures = settings.MaxRequestsPerIP
+	}
+	if settings.RequestWindow > 0 {
+		requestPolicy.LockoutDuration = settings.RequestWindow
+		requestPolicy.FailureWindow = settings.RequestWindow
+	}
+
+	return &PasswordRecoveryService{
+		userRepository:               userRepository,
+		passwordResetTokenRepository: passwordResetTokenRepository,
+		tokenRepository:              tokenRepository,
+		loginAttemptRepository:       loginAttemptRepository,
+		mailer:                       mailer,
+		auditService:                 auditService,
+		settings:                     settings,
+		requestPolicy:                requestPolicy,
+	}
+}
+
+// ForgotPasswordInput defines the input for the ForgotPassword use case
+type ForgotPasswordInput struct {
+	Email     string
+	IPAddress string
+	UserAgent string
+}
+
+// ForgotPassword mails a password reset link to the user with the given email, superseding any
+// earlier link. Unknown and inactive accounts get nothing, and the caller is told nothing either
+// way, so the endpoint does not reveal which emails are registered. Requests beyond the limits
+// for the email or the IP address are refused.
+func (s *PasswordRecoveryService) ForgotPassword(ctx context.Context, input ForgotPasswordInput) error {
+	// Validate input
+	if input.Email == "" {
+		return errors.New("email is required")
+	}
+
+	if err := s.throttleRequest(auth.NormalizeLoginSubject(input.Email), input.IPAddress); err != nil {
+		return err
+	}
+
+	userEntity, err := s.userRepository.GetByEmail(input.Email)
+	if err != nil {
+		var userErr *user.UserError
+		if !errors.As(err, &userErr) || userErr.Code != user.ErrUserNotFound {
+			return err
+		}
+
+		// Log audit
+		if err := s.auditService.LogAudit(
+			"PASSWORD_RESET_REQUESTED",
+			"User",
+			uuid.Nil,
+			uuid.Nil,
+			fmt.Sprintf("Password reset requested for unknown email: %s", input.Email),
+			fmt.Sprintf("ip_address: %s, user_agent: %s, reason: user not found", input.IPAddress, input.UserAgent),
+		); err != nil {
+			// Log error but continue
+			fmt.Printf("Failed to log audit: %v\n", err)
+		}
+		return nil
+	}
+
+	if !userEntity.IsActive {
+		// Log audit
+		if err := s.auditService.LogAudit(
+			"PASSWORD_RESET_REQUESTED",
+			"User",
+			userEntity.ID,
+			userEntity.ID,
+			fmt.Sprintf("Password reset requested for inactive user: %s", userEntity.Username),
+			fmt.Sprintf("ip_address: %s, user_agent: %s, reason: user inactive", input.IPAddress, input.UserAgent),
+		); err != nil {
+			// Log error but continue
+			fmt.Printf("Failed to log audit: %v\n", err)
+		}
+		return nil
+	}
+
+	now := time.Now()
+
+	// Only the latest link works
+	if err := s.passwordResetTokenRepository.InvalidateUserTokens(userEntity.ID, now); err != nil {
+		return err
+	}
+
+	token, err := auth.NewOpaqueToken()
+	if err != nil {
+		return fmt.Errorf("failed to generate password reset token: %w", err)
+	}
+
+	expiresAt := now.Add(s.settings.TokenExpiry)
+	if err := s.passwordResetTokenRepository.Create(&auth.PasswordResetToken{
+		ID:        uuid.New(),
+		UserID:    userEntity.ID,
+		TokenHash: auth.HashToken(token),
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}); err != nil {
+		return err
+	}
+
+	// A failed delivery is audited but not reported, as that would reveal the account exists
+	delivery := "sent"
+	if err := s.mailer.Send(ctx, s.resetMessage(userEntity, token)); err != nil {
+		fmt.Printf("Failed to send password reset email: %v\n", err)
+		delivery = "failed"
+	}
+
+	// Log audit
+	if err := s.auditService.LogAudit(
+		"PASSWORD_RESET_REQUESTED",
+		"User",
+		userEntity.ID,
+		userEntity.ID,
+		fmt.Sprintf("Password reset requested for user: %s", userEntity.Username),
+		fmt.Sprintf("ip_address: %s, user_agent: %s, expires_at: %s, delivery: %s", input.IPAddress, input.UserAgent, expiresAt.Format(time.RFC3339), delivery),
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
+	return nil
+}
+
+// ResetForgottenPasswordInput defines the input for the ResetForgottenPassword use case
+type ResetForgottenPasswordInput struct {
+	Token       string // From the mailed link
+	NewPassword string
+	IPAddress   string
+	UserAgent   string
+}
+
+// ResetForgottenPassword sets a new password with a password reset token, using the token up.
+// Every session of the user ends, as do their other reset links.
+func (s *PasswordRecoveryService) ResetForgottenPassword(ctx context.Context, input ResetForgottenPasswordInput) error {
+	// Validate input
+	if input.Token == "" {
+		return errors.New("token is required")
+	}
+
+	if err := validatePasswordStrength(input.NewPassword); err != nil {
+		return user.NewUserError(user.ErrInvalidPassword, err.Error(), nil)
+	}
+
+	now := time.Now()
+	invalid := auth.NewAuthError(auth.ErrInvalidResetToken, "Password reset token is invalid or has expired", nil)
+
+	resetToken, err := s.passwordResetTokenRepository.GetByTokenHash(auth.HashToken(input.Token))
+	if err != nil {
+		return err
+	}
+	if resetToken.UsedAt != nil || resetToken.IsExpired(now) {
+		return invalid
+	}
+
+	userEntity, err := s.userRepository.GetByID(resetToken.UserID)
+	if err != nil {
+		var userErr *user.UserError
+		if errors.As(err, &userErr) && userErr.Code == user.ErrUserNotFound {
+			return invalid
+		}
+		return err
+	}
+	if !userEntity.IsActive {
+		return invalid
+	}
+
+	// Another request may have used the token since it was read
+	used, err := s.passwordResetTokenRepository.Use(resetToken.ID, now)
+	if err != nil {
+		return err
+	}
+	if !used {
+		return invalid
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	userEntity.PasswordHash = string(hashedPassword)
+	userEntity.UpdatedAt = now
+
+	// Sessions opened with the old password end
+	userEntity.RevokeTokens()
+
+	if err := s.userRepository.Update(userEntity); err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+
+	// The token version already ends every access token; revoking the refresh tokens as well
+	// keeps the sessions from showing up as live
+	if err := s.tokenRepository.RevokeUserTokens(userEntity.ID, now); err != nil {
+		fmt.Printf("Failed to revoke sessions: %v\n", err)
+	}
+
+	if err := s.passwordResetTokenRepository.InvalidateUserTokens(userEntity.ID, now); err != nil {
+		fmt.Printf("Failed to invalidate password reset tokens: %v\n", err)
+	}
+
+	// Log audit
+	if err := s.auditService.LogAudit(
+		"PASSWORD_RESET_COMPLETED",
+		"User",
+		userEntity.ID,
+		userEntity.ID,
+		fmt.Sprintf("Password reset with emailed token for user: %s", userEntity.Username),
+		fmt.Sprintf("ip_address: %s, user_agent: %s", input.IPAddress, input.UserAgent),
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
+	return nil
+}
+
+// throttleRequest refuses a reset request once the email or the IP address has made the
+// policy's maximum number of requests, and counts it otherwise. Unknown emails are counted
+// alike, so being refused does not reveal whether an email is registered.
+func (s *PasswordRecoveryService) throttleRequest(emailSubject, ipAddress string) error {
+	now := time.Now()
+
+	scopes := map[string]string{auth.ScopePasswordResetEmail: emailSubject}
+	if ipAddress != "" {
+		scopes[auth.ScopePasswordResetIP] = ipAddress
+	}
+
+	for _, scope := range []string{auth.ScopePasswordResetEmail, auth.ScopePasswordResetIP} {
+		subject, ok := scopes[scope]
+		if !ok {
+			continue
+		}
+
+		attempt, err := s.loginAttemptRepository.Get(scope, subject)
+		if err != nil {
+			return err
+		}
+		if attempt.IsLocked(now) {
+			return auth.NewAuthError(auth.ErrPasswordResetThrottled, fmt.Sprintf("Too many password reset requests, try again in %s", formatWait(attempt.LockedUntil.Sub(now))), nil)
+		}
+	}
+
+	for _, scope := range []string{auth.ScopePasswordResetEmail, auth.ScopePasswordResetIP} {
+		subject, ok := scopes[scope]
+		if !ok {
+			continue
+		}
+
+		if _, err := s.loginAttemptRepository.RecordFailure(scope, subject, now, s.requestPolicy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resetMessage returns the email carrying a password reset token
+func (s *PasswordRecoveryService) resetMessage(userEntity *user.User, token string) mail.Message {
+	link := token
+	if s.settings.ResetURL != "" {
+		resetURL, err := url.Parse(s.settings.ResetURL)
+		if err == nil {
+			query := resetURL.Query()
+			query.Set("token", token)
+			resetURL.RawQuery = query.Encode()
+			link = resetURL.String()
+		}
+	}
+
+	name := userEntity.FullName
+	if name == "" {
+		name = userEntity.Username
+	}
+
+	return mail.Message{
+		To:      userEntity.Email,
+		Subject: "Reset your MyNumba DonWin password",
+		Body: fmt.Sprintf("Hello %s,\n\n"+
+			"A password reset was requested for your account. Use the link below to choose a new password:\n\n"+
+			"%s\n\n"+
+			"The link can be used once and expires in %s. If you did not ask for a reset, ignore this email; your password stays unchanged.\n",
+			name, link, s.settings.TokenExpiry),
+	}
+}
@@ -0,0 +1,78 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	userApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/user"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
+)
+
+// fakeLoginAttemptRepository counts failures in memory, locking a subject once it reaches the
+// policy's maximum
+type fakeLoginAttemptRepository struct {
+	attempts map[string]*auth.LoginAttempt
+}
+
+func newFakeLoginAttemptRepository() *fakeLoginAttemptRepository {
+	return &fakeLoginAttemptRepository{attempts: map[string]*auth.LoginAttempt{}}
+}
+
+func (r *fakeLoginAttemptRepository) Get(scope, subject string) (*auth.LoginAttempt, error) {
+	if attempt, ok := r.attempts[scope+"/"+subject]; ok {
+		copied := *attempt
+		return &copied, nil
+	}
+	return &auth.LoginAttempt{Scope: scope, Subject: subject}, nil
+}
+
+func (r *fakeLoginAttemptRepository) RecordFailure(scope, subject string, now time.Time, policy auth.LockoutPolicy) (*auth.LoginAttempt, error) {
+	attempt, _ := r.Get(scope, subject)
+	attempt.Failures++
+	attempt.LastFailureAt = now
+	if attempt.Failures >= policy.MaxFailures(scope) {
+		lockedUntil := now.Add(policy.LockoutDuration)
+		attempt.LockedUntil = &lockedUntil
+	}
+	r.attempts[scope+"/"+subject] = attempt
+	return attempt, nil
+}
+
+func (r *fakeLoginAttemptRepository) Reset(scope, subject string) error {
+	delete(r.attempts, scope+"/"+subject)
+	return nil
+}
+
+func assertResetThrottled(t *testing.T, err error) {
+	t.Helper()
+	var authErr *auth.AuthError
+	require.True(t, errors.As(err, &authErr), "got %v", err)
+	assert.Equal(t, auth.ErrPasswordResetThrottled, authErr.Code)
+}
+
+func TestForgotPasswordThrottlesRequests(t *testing.T) {
+	service := userApp.NewPasswordRecoveryService(newFakeUserRepository(), nil, nil, newFakeLoginAttemptRepository(), nil, fakeAuditService{}, userApp.PasswordResetSettings{
+		MaxRequestsPerEmail: 2,
+		MaxRequestsPerIP:    3,
+	})
+	forgot := func(email, ip string) error {
+		return service.ForgotPassword(context.Background(), userApp.ForgotPasswordInput{Email: email, IPAddress: ip})
+	}
+
+	// Unknown emails are counted like registered ones, in any letter case
+	require.NoError(t, forgot("someone@example.com", "10.0.0.1"))
+	require.NoError(t, forgot("Someone@Example.com ", "10.0.0.2"))
+	assertResetThrottled(t, forgot("someone@example.com", "10.0.0.3"))
+
+	// An IP address is limited across emails
+	require.NoError(t, forgot("a@example.com", "10.0.0.9"))
+	require.NoError(t, forgot("b@example.com", "10.0.0.9"))
+	require.NoError(t, forgot("c@example.com", "10.0.0.9"))
+	assertResetThrottled(t, forgot("d@example.com", "10.0.0.9"))
+	require.NoError(t, forgot("d@example.com", "10.0.0.10"))
+}
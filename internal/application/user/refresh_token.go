@@ -0,0 +1,137 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/user"
+)
+
+// RefreshTokenService exchanges refresh tokens for new token pairs
+type RefreshTokenService struct {
+	userRepository  user.UserRepository
+	tokenRepository auth.TokenRepository
+	auditService    audit.AuditService
+	tokenIssuer     *tokenIssuer
+}
+
+// NewRefreshTokenService creates a new RefreshTokenService
+func NewRefreshTokenService(
+	userRepository user.UserRepository,
+	tokenRepository auth.TokenRepository,
+	auditService audit.AuditService,
+	tokenSettings TokenSettings,
+) *RefreshTokenService {
+	return &RefreshTokenService{
+		userRepository:  userRepository,
+		tokenRepository: tokenRepository,
+		auditService:    auditService,
+		tokenIssuer:     newTokenIssuer(tokenRepository, tokenSettings),
+	}
+}
+
+// RefreshTokenInput defines the input for the RefreshToken use case
+type RefreshTokenInput struct {
+	RefreshToken string
+	IPAddress    string
+	UserAgent    string
+}
+
+// RefreshTokenOutput defines the output for the RefreshToken use case
+type RefreshTokenOutput struct {
+	Tokens TokenPair
+	User   UserOutput
+}
+
+// RefreshToken rotates a refresh token: the presented token is used up and a new access token
+// and refresh token of the same session are issued. Presenting a token that was already used
+// means it has leaked, so the whole session is revoked.
+func (s *RefreshTokenService) RefreshToken(ctx context.Context, input RefreshTokenInput) (*RefreshTokenOutput, error) {
+	if input.RefreshToken == "" {
+		return nil, errors.New("refresh token is required")
+	}
+
+	now := time.Now()
+	token, err := s.tokenRepository.GetRefreshTokenByHash(auth.HashToken(input.RefreshToken))
+	if err != nil {
+		return nil, err
+	}
+
+	if token.RevokedAt != nil {
+		return nil, auth.NewAuthError(auth.ErrInvalidRefreshToken, "Refresh token has been revoked", nil)
+	}
+
+	if token.UsedAt != nil {
+		return nil, s.revokeReusedSession(token, input, now)
+	}
+
+	if token.IsExpired(now) {
+		return nil, auth.NewAuthError(auth.ErrInvalidRefreshToken, "Refresh token has expired", nil)
+	}
+
+	used, err := s.tokenRepository.UseRefreshToken(token.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	if !used {
+		// Another request exchanged or revoked the token since it was read
+		return nil, s.revokeReusedSession(token, input, now)
+	}
+
+	userEntity, err := s.userRepository.GetByID(token.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// Deactivation, a role change or a password reset ends the session
+	if !userEntity.IsActive || userEntity.TokenVersion != token.TokenVersion {
+		if err := s.tokenRepository.RevokeFamily(token.FamilyID, now); err != nil {
+			return nil, err
+		}
+		if !userEntity.IsActive {
+			return nil, auth.NewAuthError(auth.ErrInvalidRefreshToken, "User is inactive", nil)
+		}
+		return nil, auth.NewAuthError(auth.ErrInvalidRefreshToken, "Session has been ended, please log in again", nil)
+	}
+
+	tokens, err := s.tokenIssuer.issue(userEntity, token.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RefreshTokenOutput{
+		Tokens: *tokens,
+		User: UserOutput{
+			ID:       userEntity.ID,
+			Email:    userEntity.Email,
+			Username: userEntity.Username,
+			Role:     userEntity.Role,
+		},
+	}, nil
+}
+
+// revokeReusedSession revokes the session of a refresh token presented again after use
+func (s *RefreshTokenService) revokeReusedSession(token *auth.RefreshToken, input RefreshTokenInput, now time.Time) error {
+	if err := s.tokenRepository.RevokeFamily(token.FamilyID, now); err != nil {
+		return err
+	}
+
+	// Log audit
+	if err := s.auditService.LogAudit(
+		"REFRESH_TOKEN_REUSED",
+		"User",
+		token.UserID,
+		token.UserID,
+		"Used refresh token presented again, session revoked",
+		fmt.Sprintf("session_id: %s, ip_address: %s, user_agent: %s", token.FamilyID, input.IPAddress, input.UserAgent),
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
+	return auth.NewAuthError(auth.ErrRefreshTokenReused, "Refresh token has already been used, the session has been revoked", nil)
+}
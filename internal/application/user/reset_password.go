@@ -68,6 +68,11 @@ func (s *ResetPasswordService) ResetPassword(ctx context.Context, input ResetPas
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 	
+	// Admins cannot take over the accounts of their peers or superiors
+	if err := checkCanManageUser(s.userRepository, input.AdminUserID, user); err != nil {
+		return nil, err
+	}
+	
 	// Generate bcrypt hash
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.NewPassword), bcrypt.DefaultCost)
 	if err != nil {
@@ -78,6 +83,9 @@ func (s *ResetPasswordService) ResetPassword(ctx context.Context, input ResetPas
 	user.PasswordHash = string(hashedPassword)
 	user.UpdatedAt = time.Now()
 	
+	// Sessions opened with the old password end
+	user.RevokeTokens()
+	
 	// Save user
 	err = s.userRepository.Update(user)
 	if err != nil {
@@ -143,12 +151,27 @@ func IsAdminUser(ctx context.Context, userRepository user.UserRepository) (bool,
 		return false, errors.New("user not authenticated")
 	}
 	
-	user, err := userRepository.GetByID(userID)
+	userEntity, err := userRepository.GetByID(userID)
 	if err != nil {
 		return false, fmt.Errorf("failed to get user: %w", err)
 	}
 	
-	return user.Role == "SUPER_ADMIN" || user.Role == "ADMIN", nil
+	return user.HasPermission(userEntity.Role, user.PermUserResetPassword), nil
+}
+
+// checkCanManageUser checks that the acting user's role lets them take over the target user's
+// account, as resetting their password or second factor does
+func checkCanManageUser(userRepository user.UserRepository, actorID uuid.UUID, target *user.User) error {
+	actor, err := userRepository.GetByID(actorID)
+	if err != nil {
+		return fmt.Errorf("failed to get acting user: %w", err)
+	}
+	
+	if !user.CanManageRole(actor.Role, target.Role) {
+		return user.NewUserError(user.ErrInsufficientRole, fmt.Sprintf("A %s cannot manage the account of a %s", actor.Role, target.Role), nil)
+	}
+	
+	return nil
 }
 
 // GetCurrentUserID gets the current user ID from context
@@ -0,0 +1,131 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	userApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/user"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/user"
+)
+
+// fakeUserRepository keeps users in memory and counts updates
+type fakeUserRepository struct {
+	user.UserRepository
+	users   map[uuid.UUID]*user.User
+	updates int
+}
+
+func newFakeUserRepository(users ...*user.User) *fakeUserRepository {
+	repo := &fakeUserRepository{users: map[uuid.UUID]*user.User{}}
+	for _, u := range users {
+		repo.users[u.ID] = u
+	}
+	return repo
+}
+
+func (r *fakeUserRepository) GetByID(id uuid.UUID) (*user.User, error) {
+	u, ok := r.users[id]
+	if !ok {
+		return nil, user.NewUserError(user.ErrUserNotFound, "User not found", nil)
+	}
+	copied := *u
+	return &copied, nil
+}
+
+func (r *fakeUserRepository) GetByEmail(email string) (*user.User, error) {
+	for _, u := range r.users {
+		if u.Email == email {
+			copied := *u
+			return &copied, nil
+		}
+	}
+	return nil, user.NewUserError(user.ErrUserNotFound, "User not found", nil)
+}
+
+func (r *fakeUserRepository) Update(u *user.User) error {
+	r.updates++
+	r.users[u.ID] = u
+	return nil
+}
+
+// fakeTwoFactorRepository counts second factor deletions
+type fakeTwoFactorRepository struct {
+	auth.TwoFactorRepository
+	deletes int
+}
+
+func (r *fakeTwoFactorRepository) DeleteTwoFactor(userID uuid.UUID) error {
+	r.deletes++
+	return nil
+}
+
+type fakeAuditService struct{}
+
+func (fakeAuditService) LogAudit(action, entityType string, entityID uuid.UUID, userID uuid.UUID, summary, details string) error {
+	return nil
+}
+
+func newTestUser(role string) *user.User {
+	id := uuid.New()
+	return &user.User{ID: id, Username: role + "-" + id.String()[:8], Role: role, IsActive: true}
+}
+
+func assertInsufficientRole(t *testing.T, err error) {
+	t.Helper()
+	var userErr *user.UserError
+	require.True(t, errors.As(err, &userErr), "got %v", err)
+	assert.Equal(t, user.ErrInsufficientRole, userErr.Code)
+}
+
+func TestAdminCannotTakeOverSuperAdmin(t *testing.T) {
+	admin := newTestUser(user.RoleAdmin)
+	superAdmin := newTestUser(user.RoleSuperAdmin)
+	userRepo := newFakeUserRepository(admin, superAdmin)
+	twoFactorRepo := &fakeTwoFactorRepository{}
+
+	resetPassword := userApp.NewResetPasswordService(userRepo, fakeAuditService{})
+	_, err := resetPassword.ResetPassword(context.Background(), userApp.ResetPasswordInput{
+		UserID:      superAdmin.ID,
+		NewPassword: "N3w-Passw0rd!",
+		AdminUserID: admin.ID,
+	})
+	assertInsufficientRole(t, err)
+	assert.Zero(t, userRepo.updates)
+
+	twoFactor := userApp.NewTwoFactorService(userRepo, twoFactorRepo, nil, fakeAuditService{}, auth.LockoutPolicy{})
+	err = twoFactor.ResetTwoFactor(context.Background(), userApp.ResetTwoFactorInput{
+		UserID:  superAdmin.ID,
+		ResetBy: admin.ID,
+	})
+	assertInsufficientRole(t, err)
+	assert.Zero(t, twoFactorRepo.deletes)
+}
+
+func TestAdminResetsLessPrivilegedUser(t *testing.T) {
+	admin := newTestUser(user.RoleAdmin)
+	senior := newTestUser(user.RoleSeniorUser)
+	userRepo := newFakeUserRepository(admin, senior)
+	twoFactorRepo := &fakeTwoFactorRepository{}
+
+	resetPassword := userApp.NewResetPasswordService(userRepo, fakeAuditService{})
+	_, err := resetPassword.ResetPassword(context.Background(), userApp.ResetPasswordInput{
+		UserID:      senior.ID,
+		NewPassword: "N3w-Passw0rd!",
+		AdminUserID: admin.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, userRepo.updates)
+
+	twoFactor := userApp.NewTwoFactorService(userRepo, twoFactorRepo, nil, fakeAuditService{}, auth.LockoutPolicy{})
+	require.NoError(t, twoFactor.ResetTwoFactor(context.Background(), userApp.ResetTwoFactorInput{
+		UserID:  senior.ID,
+		ResetBy: admin.ID,
+	}))
+	assert.Equal(t, 1, twoFactorRepo.deletes)
+}
@@ -0,0 +1,186 @@
+package user
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/user"
+)
+
+// defaultJWTSecret signs tokens when no secret is configured
+const defaultJWTSecret = "mynumba-donwin-jwt-secret-key-2025"
+
+// loginChallengeExpiry is how long a user has to enter their second factor after their password
+const loginChallengeExpiry = 5 * time.Minute
+
+// loginChallengePurpose marks login challenges and derives the key they are signed with
+const loginChallengePurpose = "login-challenge"
+
+// TokenSettings configures the tokens issued at login and refresh
+type TokenSettings struct {
+	Secret        string
+	AccessExpiry  time.Duration
+	RefreshExpiry time.Duration
+}
+
+// TokenPair is an access token together with the refresh token that renews it
+type TokenPair struct {
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+	SessionID        uuid.UUID // Family of the refresh token, carried in the access token's sid claim
+}
+
+// tokenIssuer signs access tokens and records the refresh tokens issued with them
+type tokenIssuer struct {
+	tokenRepository auth.TokenRepository
+	settings        TokenSettings
+}
+
+// newTokenIssuer creates a tokenIssuer, filling in defaults for unset settings
+func newTokenIssuer(tokenRepository auth.TokenRepository, settings TokenSettings) *tokenIssuer {
+	if settings.Secret == "" {
+		settings.Secret = getEnvOrDefault("JWT_SECRET", defaultJWTSecret)
+	}
+	if settings.AccessExpiry <= 0 {
+		settings.AccessExpiry = 24 * time.Hour
+	}
+	if settings.RefreshExpiry <= 0 {
+		settings.RefreshExpiry = 7 * 24 * time.Hour
+	}
+
+	return &tokenIssuer{
+		tokenRepository: tokenRepository,
+		settings:        settings,
+	}
+}
+
+// issue signs an access token for the user and stores a new refresh token of the given
+// session. A new session is started when sessionID is uuid.Nil.
+func (i *tokenIssuer) issue(userEntity *user.User, sessionID uuid.UUID) (*TokenPair, error) {
+	now := time.Now()
+	if sessionID == uuid.Nil {
+		sessionID = uuid.New()
+	}
+
+	tokenID := uuid.New().String()
+	accessExpiresAt := now.Add(i.settings.AccessExpiry)
+
+	// Create the claims
+	claims := jwt.MapClaims{
+		"user_id":  userEntity.ID.String(),
+		"email":    userEntity.Email,
+		"username": userEntity.Username,
+		"role":     userEntity.Role,           // Single role for backward compatibility
+		"roles":    []string{userEntity.Role}, // Array of roles for future extensibility
+		"jti":      tokenID,
+		"sid":      sessionID.String(),
+		"ver":      userEntity.TokenVersion,
+		"exp":      jwt.NewNumericDate(accessExpiresAt).Unix(),
+		"iat":      jwt.NewNumericDate(now).Unix(),
+		"nbf":      jwt.NewNumericDate(now).Unix(),
+		"iss":      "mynumba-donwin-api",
+		"sub":      userEntity.ID.String(),
+	}
+
+	// Sign the token with the secret key
+	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(i.settings.Secret))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	refreshToken, err := auth.NewOpaqueToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	refreshExpiresAt := now.Add(i.settings.RefreshExpiry)
+	if err := i.tokenRepository.CreateRefreshToken(&auth.RefreshToken{
+		ID:              uuid.New(),
+		UserID:          userEntity.ID,
+		FamilyID:        sessionID,
+		TokenHash:       auth.HashToken(refreshToken),
+		TokenVersion:    userEntity.TokenVersion,
+		AccessTokenID:   tokenID,
+		AccessExpiresAt: accessExpiresAt,
+		ExpiresAt:       refreshExpiresAt,
+		CreatedAt:       now,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{
+		AccessToken:      accessToken,
+		AccessExpiresAt:  accessExpiresAt,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: refreshExpiresAt,
+		SessionID:        sessionID,
+	}, nil
+}
+
+// issueLoginChallenge signs the short-lived token that proves a user presented their password
+// and lets them present their second factor. It is signed with a key derived from the secret,
+// so it is never accepted as an access token.
+func (i *tokenIssuer) issueLoginChallenge(userEntity *user.User) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(loginChallengeExpiry)
+
+	claims := jwt.MapClaims{
+		"purpose": loginChallengePurpose,
+		"ver":     userEntity.TokenVersion,
+		"exp":     jwt.NewNumericDate(expiresAt).Unix(),
+		"iat":     jwt.NewNumericDate(now).Unix(),
+		"iss":     "mynumba-donwin-api",
+		"sub":     userEntity.ID.String(),
+	}
+
+	challenge, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(i.challengeKey())
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to generate login challenge: %w", err)
+	}
+
+	return challenge, expiresAt, nil
+}
+
+// parseLoginChallenge verifies a login challenge and returns the user and token version it was issued for
+func (i *tokenIssuer) parseLoginChallenge(challenge string) (uuid.UUID, int, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(challenge, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		return i.challengeKey(), nil
+	})
+	if err != nil {
+		return uuid.Nil, 0, auth.NewAuthError(auth.ErrInvalidLoginChallenge, "Login challenge is invalid or has expired", err)
+	}
+
+	if purpose, _ := claims["purpose"].(string); purpose != loginChallengePurpose {
+		return uuid.Nil, 0, auth.NewAuthError(auth.ErrInvalidLoginChallenge, "Login challenge is invalid or has expired", nil)
+	}
+
+	subject, _ := claims.GetSubject()
+	userID, err := uuid.Parse(subject)
+	if err != nil {
+		return uuid.Nil, 0, auth.NewAuthError(auth.ErrInvalidLoginChallenge, "Login challenge is invalid or has expired", err)
+	}
+
+	// JSON numbers decode as float64
+	version, _ := claims["ver"].(float64)
+
+	return userID, int(version), nil
+}
+
+// challengeKey derives the key login challenges are signed with from the secret
+func (i *tokenIssuer) challengeKey() []byte {
+	mac := hmac.New(sha256.New, []byte(i.settings.Secret))
+	mac.Write([]byte(loginChallengePurpose))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,349 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/user"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/totp"
+)
+
+// totpIssuer names the service in users' authenticator apps
+const totpIssuer = "MyNumba DonWin"
+
+// totpSkew is the number of 30 second steps of clock drift tolerated either way
+const totpSkew = 1
+
+// TwoFactorService provides TOTP enrollment and the step-up checks of sensitive operations
+type TwoFactorService struct {
+	userRepository      user.UserRepository
+	twoFactorRepository auth.TwoFactorRepository
+	auditService        audit.AuditService
+	loginGuard          *loginGuard
+}
+
+// NewTwoFactorService creates a new TwoFactorService. Wrong step-up codes count as failed
+// logins of the user's account, so they are locked out alike.
+func NewTwoFactorService(
+	userRepository user.UserRepository,
+	twoFactorRepository auth.TwoFactorRepository,
+	loginAttemptRepository auth.LoginAttemptRepository,
+	auditService audit.AuditService,
+	lockoutPolicy auth.LockoutPolicy,
+) *TwoFactorService {
+	return &TwoFactorService{
+		userRepository:      userRepository,
+		twoFactorRepository: twoFactorRepository,
+		auditService:        auditService,
+		loginGuard:          newLoginGuard(loginAttemptRepository, auditService, lockoutPolicy),
+	}
+}
+
+// EnrollTwoFactorOutput defines the output for the EnrollTwoFactor use case
+type EnrollTwoFactorOutput struct {
+	Secret          string
+	ProvisioningURI string // otpauth URI to show as a QR code
+}
+
+// EnrollTwoFactor starts TOTP enrollment with a new secret, replacing any unfinished enrollment.
+// Enrollment is finished by ConfirmTwoFactor.
+func (s *TwoFactorService) EnrollTwoFactor(ctx context.Context, userID uuid.UUID) (*EnrollTwoFactorOutput, error) {
+	userEntity, err := s.userRepository.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.twoFactorRepository.GetTwoFactor(userID)
+	if err != nil && !hasAuthErrorCode(err, auth.ErrTwoFactorNotEnrolled) {
+		return nil, err
+	}
+	if err == nil && existing.IsEnabled() {
+		return nil, auth.NewAuthError(auth.ErrTwoFactorAlreadyEnabled, "Two-factor authentication is already enabled", nil)
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate TOTP secret: %w", err)
+	}
+
+	now := time.Now()
+	if err := s.twoFactorRepository.SaveTwoFactor(&auth.TwoFactor{
+		UserID:    userID,
+		Secret:    secret,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}); err != nil {
+		return nil, err
+	}
+
+	// Log audit
+	if err := s.auditService.LogAudit(
+		"TWO_FACTOR_ENROLLMENT_STARTED",
+		"User",
+		userID,
+		userID,
+		fmt.Sprintf("Two-factor enrollment started for user: %s", userEntity.Username),
+		"",
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
+	return &EnrollTwoFactorOutput{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(totpIssuer, userEntity.Email, secret),
+	}, nil
+}
+
+// ConfirmTwoFactorInput defines the input for the ConfirmTwoFactor use case
+type ConfirmTwoFactorInput struct {
+	UserID uuid.UUID
+	Code   string // First code from the authenticator app
+}
+
+// ConfirmTwoFactorOutput defines the output for the ConfirmTwoFactor use case
+type ConfirmTwoFactorOutput struct {
+	RecoveryCodes []string // Shown once; only their hashes are stored
+}
+
+// ConfirmTwoFactor finishes enrollment once the user proves their app generates valid codes,
+// and issues their recovery codes
+func (s *TwoFactorService) ConfirmTwoFactor(ctx context.Context, input ConfirmTwoFactorInput) (*ConfirmTwoFactorOutput, error) {
+	twoFactor, err := s.twoFactorRepository.GetTwoFactor(input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if twoFactor.IsEnabled() {
+		return nil, auth.NewAuthError(auth.ErrTwoFactorAlreadyEnabled, "Two-factor authentication is already enabled", nil)
+	}
+
+	now := time.Now()
+	step, ok := totp.Validate(twoFactor.Secret, input.Code, now, totpSkew)
+	if !ok {
+		return nil, auth.NewAuthError(auth.ErrInvalidTwoFactorCode, "Invalid two-factor code", nil)
+	}
+
+	recoveryCodes, err := auth.NewRecoveryCodes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate recovery codes: %w", err)
+	}
+	hashes := make([]string, len(recoveryCodes))
+	for i, code := range recoveryCodes {
+		hashes[i] = auth.HashToken(auth.NormalizeRecoveryCode(code))
+	}
+
+	if err := s.twoFactorRepository.EnableTwoFactor(input.UserID, now, step, hashes); err != nil {
+		return nil, err
+	}
+
+	// Log audit
+	if err := s.auditService.LogAudit(
+		"TWO_FACTOR_ENABLED",
+		"User",
+		input.UserID,
+		input.UserID,
+		"Two-factor authentication enabled",
+		fmt.Sprintf("recovery_codes: %d", len(recoveryCodes)),
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
+	return &ConfirmTwoFactorOutput{
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// DisableTwoFactorInput defines the input for the DisableTwoFactor use case
+type DisableTwoFactorInput struct {
+	UserID    uuid.UUID
+	Code      string // TOTP or recovery code
+	IPAddress string
+	UserAgent string
+}
+
+// DisableTwoFactor removes the user's second factor after checking a code from it
+func (s *TwoFactorService) DisableTwoFactor(ctx context.Context, input DisableTwoFactorInput) error {
+	userEntity, err := s.userRepository.GetByID(input.UserID)
+	if err != nil {
+		return err
+	}
+
+	twoFactor, err := s.twoFactorRepository.GetTwoFactor(input.UserID)
+	if err != nil {
+		return err
+	}
+
+	// An unfinished enrollment is dropped without a code
+	if twoFactor.IsEnabled() {
+		if _, err := s.checkCode(userEntity, twoFactor, input.Code, true, input.IPAddress, input.UserAgent); err != nil {
+			return err
+		}
+	}
+
+	if err := s.twoFactorRepository.DeleteTwoFactor(input.UserID); err != nil {
+		return err
+	}
+
+	// Log audit
+	if err := s.auditService.LogAudit(
+		"TWO_FACTOR_DISABLED",
+		"User",
+		input.UserID,
+		input.UserID,
+		fmt.Sprintf("Two-factor authentication disabled for user: %s", userEntity.Username),
+		fmt.Sprintf("ip_address: %s, user_agent: %s", input.IPAddress, input.UserAgent),
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
+	return nil
+}
+
+// ResetTwoFactorInput defines the input for the ResetTwoFactor use case
+type ResetTwoFactorInput struct {
+	UserID  uuid.UUID
+	ResetBy uuid.UUID
+}
+
+// ResetTwoFactor removes a user's second factor on behalf of an administrator, for users who
+// lost both their authenticator app and their recovery codes. Only super admins may reset the
+// second factor of an admin or super admin.
+func (s *TwoFactorService) ResetTwoFactor(ctx context.Context, input ResetTwoFactorInput) error {
+	userEntity, err := s.userRepository.GetByID(input.UserID)
+	if err != nil {
+		return err
+	}
+
+	// With the password reset as well, a second factor reset hands over the account
+	if err := checkCanManageUser(s.userRepository, input.ResetBy, userEntity); err != nil {
+		return err
+	}
+
+	if err := s.twoFactorRepository.DeleteTwoFactor(input.UserID); err != nil {
+		return err
+	}
+
+	// Log audit
+	if err := s.auditService.LogAudit(
+		"TWO_FACTOR_RESET",
+		"User",
+		input.UserID,
+		input.ResetBy,
+		fmt.Sprintf("Two-factor authentication reset for user: %s", userEntity.Username),
+		"",
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
+	return nil
+}
+
+// VerifyStepUp checks the fresh TOTP code demanded by sensitive operations even within an
+// active session. Users without two-factor authentication cannot perform them.
+func (s *TwoFactorService) VerifyStepUp(ctx context.Context, userID uuid.UUID, code, ipAddress string) error {
+	twoFactor, err := s.twoFactorRepository.GetTwoFactor(userID)
+	if err != nil {
+		if hasAuthErrorCode(err, auth.ErrTwoFactorNotEnrolled) {
+			return auth.NewAuthError(auth.ErrTwoFactorRequired, "Two-factor authentication must be enabled for this operation", nil)
+		}
+		return err
+	}
+	if !twoFactor.IsEnabled() {
+		return auth.NewAuthError(auth.ErrTwoFactorRequired, "Two-factor authentication must be enabled for this operation", nil)
+	}
+
+	if code == "" {
+		return auth.NewAuthError(auth.ErrTwoFactorRequired, "A TOTP code is required for this operation", nil)
+	}
+
+	userEntity, err := s.userRepository.GetByID(userID)
+	if err != nil {
+		return err
+	}
+
+	// Recovery codes only stand in for a lost app at login
+	_, err = s.checkCode(userEntity, twoFactor, code, false, ipAddress, "")
+	return err
+}
+
+// checkCode verifies a second factor code under the account's lockout, counting a wrong code as
+// a failed login
+func (s *TwoFactorService) checkCode(userEntity *user.User, twoFactor *auth.TwoFactor, code string, allowRecoveryCode bool, ipAddress, userAgent string) (string, error) {
+	accountSubject := auth.NormalizeLoginSubject(userEntity.Email)
+	if err := s.loginGuard.check(accountSubject, ipAddress); err != nil {
+		return "", err
+	}
+
+	factor, err := verifyTwoFactorCode(s.twoFactorRepository, twoFactor, code, allowRecoveryCode, time.Now())
+	if err != nil {
+		if hasAuthErrorCode(err, auth.ErrInvalidTwoFactorCode) {
+			// Log audit
+			if err := s.auditService.LogAudit(
+				"TWO_FACTOR_FAILED",
+				"User",
+				userEntity.ID,
+				userEntity.ID,
+				fmt.Sprintf("Invalid two-factor code for user: %s", userEntity.Username),
+				fmt.Sprintf("ip_address: %s, user_agent: %s", ipAddress, userAgent),
+			); err != nil {
+				// Log error but continue
+				fmt.Printf("Failed to log audit: %v\n", err)
+			}
+
+			if lockErr := s.loginGuard.recordFailure(userEntity.ID, accountSubject, ipAddress, userAgent); lockErr != nil {
+				return "", lockErr
+			}
+		}
+		return "", err
+	}
+
+	return factor, nil
+}
+
+// verifyTwoFactorCode accepts a TOTP code not accepted before or, if allowed, an unused recovery
+// code, and uses it up. It returns the kind of code accepted.
+func verifyTwoFactorCode(repository auth.TwoFactorRepository, twoFactor *auth.TwoFactor, code string, allowRecoveryCode bool, now time.Time) (string, error) {
+	invalid := auth.NewAuthError(auth.ErrInvalidTwoFactorCode, "Invalid two-factor code", nil)
+
+	if allowRecoveryCode && auth.IsRecoveryCode(code) {
+		used, err := repository.UseRecoveryCode(twoFactor.UserID, auth.HashToken(auth.NormalizeRecoveryCode(code)), now)
+		if err != nil {
+			return "", err
+		}
+		if !used {
+			return "", invalid
+		}
+		return "recovery_code", nil
+	}
+
+	step, ok := totp.Validate(twoFactor.Secret, code, now, totpSkew)
+	if !ok || step <= twoFactor.LastUsedStep {
+		return "", invalid
+	}
+
+	// Another request may have accepted the same code since the second factor was read
+	used, err := repository.UseStep(twoFactor.UserID, step)
+	if err != nil {
+		return "", err
+	}
+	if !used {
+		return "", invalid
+	}
+
+	return "totp", nil
+}
+
+// hasAuthErrorCode reports whether err is an AuthError with the given code
+func hasAuthErrorCode(err error, code string) bool {
+	var authErr *auth.AuthError
+	return errors.As(err, &authErr) && authErr.Code == code
+}
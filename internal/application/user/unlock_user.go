@@ -0,0 +1,78 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/user"
+)
+
+// UnlockUserService lifts login lockouts
+type UnlockUserService struct {
+	userRepository         user.UserRepository
+	loginAttemptRepository auth.LoginAttemptRepository
+	auditService           audit.AuditService
+}
+
+// NewUnlockUserService creates a new UnlockUserService
+func NewUnlockUserService(
+	userRepository user.UserRepository,
+	loginAttemptRepository auth.LoginAttemptRepository,
+	auditService audit.AuditService,
+) *UnlockUserService {
+	return &UnlockUserService{
+		userRepository:         userRepository,
+		loginAttemptRepository: loginAttemptRepository,
+		auditService:           auditService,
+	}
+}
+
+// UnlockUserInput defines the input for the UnlockUser use case
+type UnlockUserInput struct {
+	UserID     uuid.UUID
+	IPAddress  string // Optional IP address whose lockout is lifted as well
+	UnlockedBy uuid.UUID
+}
+
+// UnlockUser forgets the failed logins of a user's account, and optionally of an IP address,
+// so that they can log in again straight away
+func (s *UnlockUserService) UnlockUser(ctx context.Context, input UnlockUserInput) error {
+	if input.UserID == uuid.Nil {
+		return errors.New("user ID is required")
+	}
+
+	userEntity, err := s.userRepository.GetByID(input.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.loginAttemptRepository.Reset(auth.ScopeAccount, auth.NormalizeLoginSubject(userEntity.Email)); err != nil {
+		return err
+	}
+
+	if input.IPAddress != "" {
+		if err := s.loginAttemptRepository.Reset(auth.ScopeIP, input.IPAddress); err != nil {
+			return err
+		}
+	}
+
+	// Log audit
+	if err := s.auditService.LogAudit(
+		"UNLOCK_USER",
+		"User",
+		userEntity.ID,
+		input.UnlockedBy,
+		fmt.Sprintf("Login lockout lifted for user: %s", userEntity.Username),
+		fmt.Sprintf("ip_address: %s", input.IPAddress),
+	); err != nil {
+		// Log error but continue
+		fmt.Printf("Failed to log audit: %v\n", err)
+	}
+
+	return nil
+}
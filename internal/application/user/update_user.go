@@ -67,12 +67,22 @@ func (s *UpdateUserService) UpdateUser(ctx context.Context, input UpdateUserInpu
 		return nil, errors.New("role is required")
 	}
 	
+	// Store the canonical spelling of the role
+	role, ok := user.NormalizeRole(input.Role)
+	if !ok {
+		return nil, user.NewUserError(user.ErrInvalidRole, "Invalid role: "+input.Role, nil)
+	}
+	input.Role = role
+	
 	// Get existing user
 	user, err := s.userRepository.GetByID(input.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 	
+	// Tokens issued before a deactivation or role change must stop working
+	revokeTokens := user.Role != input.Role || (user.IsActive && !input.IsActive) || input.Password != ""
+	
 	// Update user fields
 	user.Email = input.Email
 	if input.Username != "" {
@@ -91,6 +101,10 @@ func (s *UpdateUserService) UpdateUser(ctx context.Context, input UpdateUserInpu
 		user.PasswordHash = string(passwordHash)
 	}
 	
+	if revokeTokens {
+		user.RevokeTokens()
+	}
+	
 	// Save user
 	if err := s.userRepository.Update(user); err != nil {
 		return nil, fmt.Errorf("failed to update user: %w", err)
@@ -0,0 +1,91 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/user"
+)
+
+// VerifyTwoFactorLoginInput defines the input for the VerifyTwoFactorLogin use case
+type VerifyTwoFactorLoginInput struct {
+	ChallengeToken string // Returned by AuthenticateUser
+	Code           string // TOTP or recovery code
+	IPAddress      string
+	UserAgent      string
+}
+
+// VerifyTwoFactorLogin finishes the login of a user with two-factor authentication, exchanging
+// the challenge of their password step and a second factor code for tokens
+func (s *AuthenticateUserService) VerifyTwoFactorLogin(ctx context.Context, input VerifyTwoFactorLoginInput) (*AuthenticateUserOutput, error) {
+	// Validate input
+	if input.ChallengeToken == "" {
+		return nil, errors.New("challenge token is required")
+	}
+
+	if input.Code == "" {
+		return nil, errors.New("code is required")
+	}
+
+	userID, tokenVersion, err := s.tokenIssuer.parseLoginChallenge(input.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// A password reset or deactivation since the password step ends the challenge
+	userEntity, err := s.userRepository.GetByID(userID)
+	if err != nil {
+		var userErr *user.UserError
+		if errors.As(err, &userErr) && userErr.Code == user.ErrUserNotFound {
+			return nil, auth.NewAuthError(auth.ErrInvalidLoginChallenge, "Login challenge is invalid or has expired", err)
+		}
+		return nil, err
+	}
+	if !userEntity.IsActive || userEntity.TokenVersion != tokenVersion {
+		return nil, auth.NewAuthError(auth.ErrInvalidLoginChallenge, "Login challenge is invalid or has expired", nil)
+	}
+
+	accountSubject := auth.NormalizeLoginSubject(userEntity.Email)
+	if err := s.loginGuard.check(accountSubject, input.IPAddress); err != nil {
+		return nil, err
+	}
+
+	twoFactor, err := s.twoFactorRepository.GetTwoFactor(userID)
+	if err != nil {
+		return nil, err
+	}
+	if !twoFactor.IsEnabled() {
+		return nil, auth.NewAuthError(auth.ErrInvalidLoginChallenge, "Login challenge is invalid or has expired", nil)
+	}
+
+	factor, err := verifyTwoFactorCode(s.twoFactorRepository, twoFactor, input.Code, true, time.Now())
+	if err != nil {
+		if !hasAuthErrorCode(err, auth.ErrInvalidTwoFactorCode) {
+			return nil, err
+		}
+
+		// Log failed login attempt - Using string format for details parameter
+		if err := s.auditService.LogAudit(
+			"LOGIN_FAILED",
+			"User",
+			userEntity.ID,
+			userEntity.ID,
+			fmt.Sprintf("Failed login attempt for user: %s", userEntity.Email),
+			fmt.Sprintf("ip_address: %s, user_agent: %s, reason: invalid two-factor code", input.IPAddress, input.UserAgent),
+		); err != nil {
+			// Log error but continue
+			fmt.Printf("Failed to log audit: %v\n", err)
+		}
+
+		if err := s.loginGuard.recordFailure(userEntity.ID, accountSubject, input.IPAddress, input.UserAgent); err != nil {
+			return nil, err
+		}
+
+		return nil, err
+	}
+
+	return s.completeLogin(userEntity, input.IPAddress, input.UserAgent, "password, "+factor)
+}
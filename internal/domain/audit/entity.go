@@ -7,6 +7,10 @@ import (
 	"github.com/google/uuid"
 )
 
+// SystemActorID identifies the server itself as the actor of automated actions, such as
+// scheduled draws and claim forfeitures, in audit logs
+var SystemActorID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
 // AuditLog represents an audit log entity in the domain
 type AuditLog struct {
 	ID          uuid.UUID
@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RefreshToken is a single-use credential exchanged for a new access token. Each login starts
+// a family of refresh tokens; every refresh uses up the presented token and issues its successor
+// in the same family. Only the SHA-256 hash of the token is stored.
+type RefreshToken struct {
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	FamilyID        uuid.UUID // The login session the token belongs to
+	TokenHash       string
+	TokenVersion    int       // The user's token version when the token was issued
+	AccessTokenID   string    // jti of the access token issued with this refresh token
+	AccessExpiresAt time.Time // Expiry of that access token
+	ExpiresAt       time.Time
+	UsedAt          *time.Time // Set once the token has been exchanged
+	RevokedAt       *time.Time
+	CreatedAt       time.Time
+}
+
+// IsExpired reports whether the token has expired at now
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// RevokedToken is an access token that must no longer be accepted, identified by its jti.
+// It is kept until the access token would have expired anyway.
+type RevokedToken struct {
+	TokenID   string
+	UserID    uuid.UUID
+	ExpiresAt time.Time
+	RevokedAt time.Time
+}
+
+// TokenRepository stores refresh tokens and the access token revocation list
+type TokenRepository interface {
+	CreateRefreshToken(token *RefreshToken) error
+	GetRefreshTokenByHash(tokenHash string) (*RefreshToken, error)
+	// UseRefreshToken marks an unused, unrevoked token as used. It returns false if the token
+	// had already been used or revoked, so each token can be exchanged at most once.
+	UseRefreshToken(id uuid.UUID, usedAt time.Time) (bool, error)
+	// RevokeFamily revokes every refresh token of a family and the access tokens issued with them
+	RevokeFamily(familyID uuid.UUID, revokedAt time.Time) error
+	// RevokeUserTokens revokes every refresh token of a user and the access tokens issued with them
+	RevokeUserTokens(userID uuid.UUID, revokedAt time.Time) error
+	RevokeAccessToken(token RevokedToken) error
+	IsAccessTokenRevoked(tokenID string) (bool, error)
+	// DeleteExpired removes refresh tokens and revoked access tokens that expired before the given time
+	DeleteExpired(before time.Time) error
+}
+
+// AuthError represents domain-specific errors for the auth domain
+type AuthError struct {
+	Code    string
+	Message string
+	Err     error
+}
+
+// Error codes for the auth domain
+const (
+	ErrInvalidRefreshToken = "INVALID_REFRESH_TOKEN"
+	ErrRefreshTokenReused  = "REFRESH_TOKEN_REUSED"
+	ErrTokenRevoked        = "TOKEN_REVOKED"
+	ErrAccountLocked       = "ACCOUNT_LOCKED"  // Too many failed logins for the account
+	ErrLoginThrottled      = "LOGIN_THROTTLED" // Retried too soon after failed logins, or the IP address is locked
+
+	ErrInvalidLoginChallenge   = "INVALID_LOGIN_CHALLENGE"    // The first login step's challenge is invalid or expired
+	ErrInvalidTwoFactorCode    = "INVALID_TWO_FACTOR_CODE"    // Wrong, expired or already used TOTP or recovery code
+	ErrTwoFactorRequired       = "TWO_FACTOR_REQUIRED"        // The operation demands a fresh TOTP code
+	ErrTwoFactorNotEnrolled    = "TWO_FACTOR_NOT_ENROLLED"    // The user has not started or finished TOTP enrollment
+	ErrTwoFactorAlreadyEnabled = "TWO_FACTOR_ALREADY_ENABLED" // TOTP must be disabled before enrolling again
+
+	ErrInvalidResetToken      = "INVALID_RESET_TOKEN"      // Unknown, expired or already used password reset token
+	ErrPasswordResetThrottled = "PASSWORD_RESET_THROTTLED" // Too many reset links requested for the email or from the IP address
+)
+
+// Error implements the error interface
+func (e *AuthError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap returns the wrapped error
+func (e *AuthError) Unwrap() error {
+	return e.Err
+}
+
+// NewAuthError creates a new AuthError
+func NewAuthError(code, message string, err error) *AuthError {
+	return &AuthError{
+		Code:    code,
+		Message: message,
+		Err:     err,
+	}
+}
+
+// NewOpaqueToken returns a random URL-safe token carrying 256 bits of entropy
+func NewOpaqueToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashToken returns the hex encoded SHA-256 hash under which an opaque token is stored
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,38 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
+)
+
+func TestNewOpaqueToken(t *testing.T) {
+	first, err := auth.NewOpaqueToken()
+	require.NoError(t, err)
+	second, err := auth.NewOpaqueToken()
+	require.NoError(t, err)
+
+	assert.Len(t, first, 43)
+	assert.NotEqual(t, first, second)
+}
+
+func TestHashToken(t *testing.T) {
+	hash := auth.HashToken("token")
+
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, auth.HashToken("token"))
+	assert.NotEqual(t, hash, auth.HashToken("token2"))
+	assert.NotContains(t, hash, "token")
+}
+
+func TestRefreshTokenIsExpired(t *testing.T) {
+	now := time.Now()
+	token := &auth.RefreshToken{ExpiresAt: now.Add(time.Minute)}
+
+	assert.False(t, token.IsExpired(now))
+	assert.True(t, token.IsExpired(now.Add(time.Minute)))
+}
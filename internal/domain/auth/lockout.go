@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"strings"
+	"time"
+)
+
+// Scopes failed logins are counted in
+const (
+	ScopeAccount = "account" // Keyed by normalized email, whether or not the account exists
+	ScopeIP      = "ip"      // Keyed by client IP address
+)
+
+// Scopes password reset requests are counted in, each request counting as a failure
+const (
+	ScopePasswordResetEmail = "password_reset_email" // Keyed by normalized email, whether or not the account exists
+	ScopePasswordResetIP    = "password_reset_ip"    // Keyed by client IP address
+)
+
+// LoginAttempt counts the recent failed logins of an account or an IP address
+type LoginAttempt struct {
+	Scope         string
+	Subject       string
+	Failures      int // Consecutive failures, forgotten once the failure window has passed
+	LastFailureAt time.Time
+	LockedUntil   *time.Time
+}
+
+// IsLocked reports whether logins are locked out at now
+func (a *LoginAttempt) IsLocked(now time.Time) bool {
+	return a.LockedUntil != nil && now.Before(*a.LockedUntil)
+}
+
+// LockoutPolicy decides how failed logins slow down and lock out further attempts
+type LockoutPolicy struct {
+	MaxAccountFailures int           // Failures after which an account is locked
+	MaxIPFailures      int           // Failures after which an IP address is locked
+	LockoutDuration    time.Duration // How long a lockout lasts
+	FailureWindow      time.Duration // Failures older than this are forgotten
+	BaseDelay          time.Duration // Wait imposed after the third failure, doubling with each further failure
+	MaxDelay           time.Duration
+}
+
+// DefaultLockoutPolicy returns the policy used when none is configured
+func DefaultLockoutPolicy() LockoutPolicy {
+	return LockoutPolicy{
+		MaxAccountFailures: 5,
+		MaxIPFailures:      20,
+		LockoutDuration:    15 * time.Minute,
+		FailureWindow:      15 * time.Minute,
+		BaseDelay:          time.Second,
+		MaxDelay:           30 * time.Second,
+	}
+}
+
+// MaxFailures returns the number of failures after which a scope is locked
+func (p LockoutPolicy) MaxFailures(scope string) int {
+	if scope == ScopeIP || scope == ScopePasswordResetIP {
+		return p.MaxIPFailures
+	}
+	return p.MaxAccountFailures
+}
+
+// Delay returns how long to wait after the given number of consecutive failures before
+// trying again. The first two failures cost nothing.
+func (p LockoutPolicy) Delay(failures int) time.Duration {
+	if failures < 3 {
+		return 0
+	}
+
+	delay := p.BaseDelay
+	for i := 3; i < failures && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	return delay
+}
+
+// RetryAt returns when the next login attempt is allowed; a time not after now means straight away
+func (p LockoutPolicy) RetryAt(a *LoginAttempt, now time.Time) time.Time {
+	if a.IsLocked(now) {
+		return *a.LockedUntil
+	}
+
+	if a.Failures == 0 || now.Sub(a.LastFailureAt) >= p.FailureWindow {
+		return now
+	}
+
+	return a.LastFailureAt.Add(p.Delay(a.Failures))
+}
+
+// DefaultPasswordResetPolicy returns the limits on password reset requests used when none are
+// configured. An email is sent at most 3 reset links an hour, and an IP address may ask for 10.
+func DefaultPasswordResetPolicy() LockoutPolicy {
+	return LockoutPolicy{
+		MaxAccountFailures: 3,
+		MaxIPFailures:      10,
+		LockoutDuration:    time.Hour,
+		FailureWindow:      time.Hour,
+	}
+}
+
+// NormalizeLoginSubject returns the key failed logins of an email are counted under
+func NormalizeLoginSubject(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// LoginAttemptRepository stores failed login counts where every replica sees them
+type LoginAttemptRepository interface {
+	// Get returns the failed logins of a scope and subject, with no failures if there are none
+	Get(scope, subject string) (*LoginAttempt, error)
+	// RecordFailure atomically counts a failed login at now and locks the subject for the
+	// policy's lockout duration once it reaches the policy's maximum failures
+	RecordFailure(scope, subject string, now time.Time, policy LockoutPolicy) (*LoginAttempt, error)
+	// Reset forgets the failed logins of a scope and subject, lifting any lockout
+	Reset(scope, subject string) error
+}
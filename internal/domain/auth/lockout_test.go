@@ -0,0 +1,61 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
+)
+
+func TestLockoutPolicyDelay(t *testing.T) {
+	policy := auth.DefaultLockoutPolicy()
+
+	assert.Equal(t, time.Duration(0), policy.Delay(0))
+	assert.Equal(t, time.Duration(0), policy.Delay(2))
+	assert.Equal(t, time.Second, policy.Delay(3))
+	assert.Equal(t, 2*time.Second, policy.Delay(4))
+	assert.Equal(t, 16*time.Second, policy.Delay(7))
+	assert.Equal(t, 30*time.Second, policy.Delay(8))
+	assert.Equal(t, 30*time.Second, policy.Delay(100))
+}
+
+func TestLockoutPolicyMaxFailures(t *testing.T) {
+	policy := auth.DefaultLockoutPolicy()
+
+	assert.Equal(t, 5, policy.MaxFailures(auth.ScopeAccount))
+	assert.Equal(t, 20, policy.MaxFailures(auth.ScopeIP))
+}
+
+func TestLockoutPolicyRetryAt(t *testing.T) {
+	policy := auth.DefaultLockoutPolicy()
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("no failures", func(t *testing.T) {
+		attempt := &auth.LoginAttempt{}
+		assert.Equal(t, now, policy.RetryAt(attempt, now))
+	})
+
+	t.Run("progressive delay", func(t *testing.T) {
+		attempt := &auth.LoginAttempt{Failures: 4, LastFailureAt: now.Add(-time.Second)}
+		assert.Equal(t, now.Add(time.Second), policy.RetryAt(attempt, now))
+	})
+
+	t.Run("failures outside the window are forgotten", func(t *testing.T) {
+		attempt := &auth.LoginAttempt{Failures: 4, LastFailureAt: now.Add(-policy.FailureWindow)}
+		assert.Equal(t, now, policy.RetryAt(attempt, now))
+	})
+
+	t.Run("locked", func(t *testing.T) {
+		lockedUntil := now.Add(10 * time.Minute)
+		attempt := &auth.LoginAttempt{Failures: 5, LastFailureAt: now, LockedUntil: &lockedUntil}
+		assert.True(t, attempt.IsLocked(now))
+		assert.Equal(t, lockedUntil, policy.RetryAt(attempt, now))
+		assert.False(t, attempt.IsLocked(lockedUntil))
+	})
+}
+
+func TestNormalizeLoginSubject(t *testing.T) {
+	assert.Equal(t, "admin@example.com", auth.NormalizeLoginSubject("  Admin@Example.COM "))
+}
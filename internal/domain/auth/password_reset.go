@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PasswordResetToken is a single-use credential, mailed to a user who forgot their password,
+// that lets them choose a new one. Only the SHA-256 hash of the token is stored.
+type PasswordResetToken struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time // Set once the token has been used, or superseded by a newer one
+	CreatedAt time.Time
+}
+
+// IsExpired reports whether the token has expired at now
+func (t *PasswordResetToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// PasswordResetTokenRepository stores password reset tokens
+type PasswordResetTokenRepository interface {
+	Create(token *PasswordResetToken) error
+	// GetByTokenHash returns the token with the given hash, or an ErrInvalidResetToken AuthError
+	GetByTokenHash(tokenHash string) (*PasswordResetToken, error)
+	// Use marks an unused token as used. It returns false if the token had already been used,
+	// so each token resets a password at most once.
+	Use(id uuid.UUID, usedAt time.Time) (bool, error)
+	// InvalidateUserTokens marks every unused token of a user as used
+	InvalidateUserTokens(userID uuid.UUID, at time.Time) error
+	// DeleteExpired removes tokens that expired before the given time
+	DeleteExpired(before time.Time) error
+}
@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"crypto/rand"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecoveryCodeCount is the number of recovery codes issued when TOTP is enabled
+const RecoveryCodeCount = 10
+
+// recoveryCodeAlphabet is the lowercase base32 alphabet, which leaves out the easily confused 0, 1 and 8
+const recoveryCodeAlphabet = "abcdefghijklmnopqrstuvwxyz234567"
+
+// TwoFactor is a user's TOTP second factor. Enrollment stores the secret and is finished by
+// the first valid code, which enables it.
+type TwoFactor struct {
+	UserID       uuid.UUID
+	Secret       string     // Base32 TOTP secret shared with the user's authenticator app
+	EnabledAt    *time.Time // Nil while enrollment awaits its first code
+	LastUsedStep int64      // Time step of the last accepted code; a code is never accepted twice
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// IsEnabled reports whether enrollment has been finished
+func (t *TwoFactor) IsEnabled() bool {
+	return t.EnabledAt != nil
+}
+
+// TwoFactorRepository stores TOTP secrets and recovery codes
+type TwoFactorRepository interface {
+	// GetTwoFactor returns the user's second factor, or an ErrTwoFactorNotEnrolled AuthError
+	GetTwoFactor(userID uuid.UUID) (*TwoFactor, error)
+	// SaveTwoFactor creates or replaces the user's second factor
+	SaveTwoFactor(twoFactor *TwoFactor) error
+	// EnableTwoFactor finishes enrollment with the code of the given time step and replaces the
+	// user's recovery codes with the given hashes
+	EnableTwoFactor(userID uuid.UUID, enabledAt time.Time, step int64, recoveryCodeHashes []string) error
+	// DeleteTwoFactor removes the user's second factor and recovery codes
+	DeleteTwoFactor(userID uuid.UUID) error
+	// UseStep records that the code of a time step was accepted. It returns false if a code of
+	// that step or a later one was accepted before, so each code is accepted at most once.
+	UseStep(userID uuid.UUID, step int64) (bool, error)
+	// UseRecoveryCode marks an unused recovery code as used. It returns false if the user has
+	// no such unused code.
+	UseRecoveryCode(userID uuid.UUID, codeHash string, usedAt time.Time) (bool, error)
+}
+
+// NewRecoveryCodes returns RecoveryCodeCount random single-use recovery codes formatted as
+// "xxxxx-xxxxx", each carrying 50 bits of entropy
+func NewRecoveryCodes() ([]string, error) {
+	codes := make([]string, RecoveryCodeCount)
+	for i := range codes {
+		b := make([]byte, 10)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		for j := range b {
+			b[j] = recoveryCodeAlphabet[b[j]&31]
+		}
+		codes[i] = string(b[:5]) + "-" + string(b[5:])
+	}
+	return codes, nil
+}
+
+// NormalizeRecoveryCode returns the form a recovery code is hashed in, ignoring case, spaces and hyphens
+func NormalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+// IsRecoveryCode reports whether code is shaped like a recovery code rather than a TOTP code
+func IsRecoveryCode(code string) bool {
+	return len(NormalizeRecoveryCode(code)) == 10
+}
@@ -0,0 +1,37 @@
+package auth_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
+)
+
+func TestNewRecoveryCodes(t *testing.T) {
+	codes, err := auth.NewRecoveryCodes()
+	require.NoError(t, err)
+	require.Len(t, codes, auth.RecoveryCodeCount)
+
+	format := regexp.MustCompile(`^[a-z2-7]{5}-[a-z2-7]{5}$`)
+	seen := map[string]bool{}
+	for _, code := range codes {
+		assert.Regexp(t, format, code)
+		assert.True(t, auth.IsRecoveryCode(code))
+		assert.False(t, seen[code], "duplicate code %s", code)
+		seen[code] = true
+	}
+}
+
+func TestNormalizeRecoveryCode(t *testing.T) {
+	assert.Equal(t, "abcde23456", auth.NormalizeRecoveryCode(" ABCDE-23456 "))
+	assert.Equal(t, auth.HashToken(auth.NormalizeRecoveryCode("abcde-23456")),
+		auth.HashToken(auth.NormalizeRecoveryCode("ABCDE 23456")))
+}
+
+func TestIsRecoveryCode(t *testing.T) {
+	assert.False(t, auth.IsRecoveryCode("123456"))
+	assert.True(t, auth.IsRecoveryCode("abcde-23456"))
+}
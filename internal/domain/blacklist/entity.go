@@ -0,0 +1,112 @@
+package blacklist
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BlacklistEntry represents an MSISDN that is barred from draws and uploads
+type BlacklistEntry struct {
+	ID        uuid.UUID
+	MSISDN    string
+	Reason    string
+	ExpiresAt *time.Time // nil for a permanent entry
+	CreatedBy uuid.UUID
+	UpdatedBy uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsActive reports whether the entry applies at the given time
+func (e *BlacklistEntry) IsActive(at time.Time) bool {
+	return e.ExpiresAt == nil || e.ExpiresAt.After(at)
+}
+
+// MSISDNs returns the MSISDNs of blacklist entries
+func MSISDNs(entries []BlacklistEntry) []string {
+	msisdns := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		msisdns = append(msisdns, entry.MSISDN)
+	}
+	return msisdns
+}
+
+// BlacklistFilters defines the filters for listing blacklist entries
+type BlacklistFilters struct {
+	MSISDN         string
+	IncludeExpired bool
+}
+
+// BlacklistRepository defines the interface for blacklist data access
+type BlacklistRepository interface {
+	Create(entry *BlacklistEntry) error
+	GetByID(id uuid.UUID) (*BlacklistEntry, error)
+	GetByMSISDN(msisdn string) (*BlacklistEntry, error)
+	List(filters BlacklistFilters, page, pageSize int) ([]BlacklistEntry, int, error)
+	Update(entry *BlacklistEntry) error
+	Delete(id uuid.UUID) error
+	Upsert(entries []*BlacklistEntry) error
+	ListActive(at time.Time) ([]BlacklistEntry, error)
+}
+
+// BlacklistError represents domain-specific errors for the blacklist domain
+type BlacklistError struct {
+	Code    string
+	Message string
+	Err     error
+}
+
+// Error codes for the blacklist domain
+const (
+	ErrEntryNotFound      = "BLACKLIST_ENTRY_NOT_FOUND"
+	ErrEntryAlreadyExists = "BLACKLIST_ENTRY_ALREADY_EXISTS"
+	ErrInvalidEntry       = "INVALID_BLACKLIST_ENTRY"
+	ErrInvalidImportFile  = "INVALID_BLACKLIST_IMPORT_FILE"
+)
+
+// Error implements the error interface
+func (e *BlacklistError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap returns the wrapped error
+func (e *BlacklistError) Unwrap() error {
+	return e.Err
+}
+
+// NewBlacklistError creates a new BlacklistError
+func NewBlacklistError(code, message string, err error) *BlacklistError {
+	return &BlacklistError{
+		Code:    code,
+		Message: message,
+		Err:     err,
+	}
+}
+
+// ValidateReason validates that an entry has a reason
+func ValidateReason(reason string) error {
+	if strings.TrimSpace(reason) == "" {
+		return errors.New("reason is required")
+	}
+
+	if len(reason) > 500 {
+		return errors.New("reason cannot be longer than 500 characters")
+	}
+
+	return nil
+}
+
+// ValidateExpiry validates that an expiry, when set, is in the future
+func ValidateExpiry(expiresAt *time.Time, now time.Time) error {
+	if expiresAt != nil && !expiresAt.After(now) {
+		return errors.New("expiry must be in the future")
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package blacklist_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/blacklist"
+)
+
+func TestBlacklistEntry_IsActive(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	expiresAt := now.Add(time.Hour)
+
+	permanent := blacklist.BlacklistEntry{MSISDN: "2348030000001"}
+	expiring := blacklist.BlacklistEntry{MSISDN: "2348030000002", ExpiresAt: &expiresAt}
+
+	assert.True(t, permanent.IsActive(now))
+	assert.True(t, permanent.IsActive(now.AddDate(10, 0, 0)))
+	assert.True(t, expiring.IsActive(now))
+	assert.False(t, expiring.IsActive(expiresAt))
+	assert.False(t, expiring.IsActive(expiresAt.Add(time.Minute)))
+}
+
+func TestValidateExpiry(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	assert.NoError(t, blacklist.ValidateExpiry(nil, now))
+	assert.NoError(t, blacklist.ValidateExpiry(&future, now))
+	assert.Error(t, blacklist.ValidateExpiry(&now, now))
+	assert.Error(t, blacklist.ValidateExpiry(&past, now))
+}
@@ -0,0 +1,74 @@
+package draw
+
+import (
+	"time"
+)
+
+// Winner statuses of the claim process
+const (
+	WinnerStatusNotified  = "Notified"
+	WinnerStatusConfirmed = "Confirmed" // The winner claimed the prize
+	WinnerStatusForfeited = "Forfeited" // The claim window closed before the winner claimed
+)
+
+// AwaitingClaim reports whether the winner holds a prize that has not been claimed yet
+func (w *Winner) AwaitingClaim() bool {
+	// A prize approved for payment has in effect been claimed
+	switch w.PaymentStatus {
+	case PaymentStatusApproved, PaymentStatusProcessing, PaymentStatusPaid:
+		return false
+	}
+	if w.IsRunnerUp {
+		return false
+	}
+	return w.Status == WinnerStatusPendingNotification || w.Status == WinnerStatusNotified
+}
+
+// ClaimExpired reports whether the winner's claim window closed before the prize was claimed.
+// Winners without a claim deadline never expire.
+func (w *Winner) ClaimExpired(now time.Time) bool {
+	return w.AwaitingClaim() && w.ClaimDeadline != nil && now.After(*w.ClaimDeadline)
+}
+
+// MarkNotified records that the winning SMS reached the winner. It returns false, leaving the
+// winner unchanged, when the winner has moved past PendingNotification in the meantime.
+func (w *Winner) MarkNotified(at time.Time) bool {
+	if w.Status != WinnerStatusPendingNotification {
+		return false
+	}
+
+	w.Status = WinnerStatusNotified
+	w.NotifiedAt = &at
+	w.UpdatedAt = at
+
+	return true
+}
+
+// ConfirmClaim records that the winner claimed the prize within the claim window
+func (w *Winner) ConfirmClaim(at time.Time) error {
+	if !w.AwaitingClaim() {
+		return NewDrawError(ErrClaimClosed, "Winner has no prize awaiting a claim, status is "+w.Status, nil)
+	}
+
+	if w.ClaimExpired(at) {
+		return NewDrawError(ErrClaimClosed, "Claim window closed at "+w.ClaimDeadline.Format(time.RFC3339), nil)
+	}
+
+	w.Status = WinnerStatusConfirmed
+	w.ClaimedAt = &at
+	w.UpdatedAt = at
+
+	return nil
+}
+
+// Forfeit takes the prize from a winner whose claim window has closed
+func (w *Winner) Forfeit(at time.Time) error {
+	if !w.ClaimExpired(at) {
+		return NewDrawError(ErrClaimNotExpired, "Winner's claim window has not closed", nil)
+	}
+
+	w.Status = WinnerStatusForfeited
+	w.UpdatedAt = at
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package draw_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+)
+
+func TestWinner_ClaimWindow(t *testing.T) {
+	now := time.Now()
+	deadline := now.Add(time.Hour)
+	winner := draw.Winner{Status: draw.WinnerStatusPendingNotification, ClaimDeadline: &deadline}
+
+	assert.False(t, winner.ClaimExpired(now))
+	assert.True(t, winner.ClaimExpired(deadline.Add(time.Second)))
+
+	// The prize cannot be forfeited while the window is open
+	var drawErr *draw.DrawError
+	require.ErrorAs(t, winner.Forfeit(now), &drawErr)
+	assert.Equal(t, draw.ErrClaimNotExpired, drawErr.Code)
+
+	require.NoError(t, winner.ConfirmClaim(now))
+	assert.Equal(t, draw.WinnerStatusConfirmed, winner.Status)
+	require.NotNil(t, winner.ClaimedAt)
+
+	// A claimed prize never expires
+	assert.False(t, winner.ClaimExpired(deadline.Add(time.Hour)))
+	require.ErrorAs(t, winner.ConfirmClaim(now), &drawErr)
+	assert.Equal(t, draw.ErrClaimClosed, drawErr.Code)
+}
+
+func TestWinner_ForfeitAfterDeadline(t *testing.T) {
+	deadline := time.Now().Add(-time.Minute)
+	winner, runnerUps := newSlot()
+	winner.ClaimDeadline = &deadline
+	now := time.Now()
+
+	var drawErr *draw.DrawError
+	require.ErrorAs(t, winner.ConfirmClaim(now), &drawErr)
+	assert.Equal(t, draw.ErrClaimClosed, drawErr.Code)
+
+	require.NoError(t, winner.Forfeit(now))
+	assert.Equal(t, draw.WinnerStatusForfeited, winner.Status)
+
+	// The forfeited winner keeps its status once the runner-up is promoted
+	require.NoError(t, winner.ReplaceWith(&runnerUps[0], "Claim window expired", now))
+	assert.Equal(t, draw.WinnerStatusForfeited, winner.Status)
+	assert.Equal(t, winner.ID, runnerUps[0].ReplacesWinnerID)
+
+	// Winners without a deadline and runner-ups are never forfeited
+	assert.False(t, runnerUps[1].ClaimExpired(now))
+	open := draw.Winner{Status: draw.WinnerStatusPendingNotification}
+	assert.False(t, open.ClaimExpired(now))
+}
@@ -0,0 +1,224 @@
+package draw
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// Eligibility rule names, in the order they are applied. An MSISDN excluded by
+// more than one rule is counted against the first.
+const (
+	RuleRechargeWindow = "recharge_window"
+	RuleBlacklist      = "blacklist"
+	RuleWinCooldown    = "win_cooldown"
+	RuleMinimumPoints  = "minimum_points"
+)
+
+// EligibilityRules define which participants may enter a draw
+type EligibilityRules struct {
+	RechargeWindowDays int      `json:"rechargeWindowDays"` // 0 counts every recharge up to the draw date, 1 only the draw date, 7 the week ending on it
+	MinimumPoints      int      `json:"minimumPoints"`      // Points needed within the recharge window
+	BlacklistedMSISDNs []string `json:"blacklistedMsisdns"`
+	WinCooldownDays    int      `json:"winCooldownDays"` // Winners of draws in the N days before the draw date are excluded
+}
+
+// RuleExclusion reports how many MSISDNs a rule excluded from a draw
+type RuleExclusion struct {
+	Rule            string `json:"rule"`
+	ExcludedMSISDNs int    `json:"excludedMsisdns"`
+}
+
+// EligibilityResult is the outcome of applying eligibility rules to the recharges for a draw date
+type EligibilityResult struct {
+	CandidateMSISDNs int // MSISDNs with points on or before the draw date, before any rule is applied
+	CandidateEntries int
+	Entries          []Entry // Eligible MSISDNs sorted by MSISDN, with their points inside the recharge window
+	TotalEntries     int
+	Exclusions       []RuleExclusion
+}
+
+// ValidateEligibilityRules validates that a rule set is usable
+func ValidateEligibilityRules(rules EligibilityRules) error {
+	if rules.RechargeWindowDays < 0 {
+		return errors.New("recharge window days cannot be negative")
+	}
+
+	if rules.MinimumPoints < 0 {
+		return errors.New("minimum points cannot be negative")
+	}
+
+	if rules.WinCooldownDays < 0 {
+		return errors.New("win cool-down days cannot be negative")
+	}
+
+	return nil
+}
+
+// WithBlacklistedMSISDNs returns the rules with further MSISDNs to exclude, such as those on the
+// blacklist, leaving the rules' own list untouched
+func (r EligibilityRules) WithBlacklistedMSISDNs(msisdns []string) EligibilityRules {
+	if len(msisdns) == 0 {
+		return r
+	}
+	merged := make([]string, 0, len(r.BlacklistedMSISDNs)+len(msisdns))
+	merged = append(merged, r.BlacklistedMSISDNs...)
+	r.BlacklistedMSISDNs = append(merged, msisdns...)
+	return r
+}
+
+// RechargeWindowStart returns the first day of the recharge window for a draw date,
+// or the zero time when all recharges up to the draw date count
+func (r EligibilityRules) RechargeWindowStart(drawDate time.Time) time.Time {
+	if r.RechargeWindowDays <= 0 {
+		return time.Time{}
+	}
+	return calendarDate(drawDate).AddDate(0, 0, -(r.RechargeWindowDays - 1))
+}
+
+// WinCooldownStart returns the first day on which a win excludes an MSISDN from a draw
+// on the given date, or the zero time when there is no cool-down
+func (r EligibilityRules) WinCooldownStart(drawDate time.Time) time.Time {
+	if r.WinCooldownDays <= 0 {
+		return time.Time{}
+	}
+	return calendarDate(drawDate).AddDate(0, 0, -r.WinCooldownDays)
+}
+
+// EligibilityEvaluator applies a rule set to a stream of recharges. Only one
+// running total per MSISDN is kept, so recharges can be added in batches. MSISDNs
+// whose recharges were already summed are evaluated as they are added, keeping
+// nothing but the entries of the eligible ones.
+type EligibilityEvaluator struct {
+	rules         EligibilityRules
+	drawDate      time.Time
+	windowStart   time.Time
+	minimumPoints int
+	blacklist     map[string]bool
+	recentWinners map[string]bool
+	totalPoints   map[string]int
+	windowPoints  map[string]int
+	result        *EligibilityResult
+	excluded      map[string]int
+}
+
+// NewEligibilityEvaluator creates an evaluator for a draw date. recentWinners are
+// the MSISDNs that won within the rule set's cool-down period.
+func NewEligibilityEvaluator(rules EligibilityRules, drawDate time.Time, recentWinners []string) *EligibilityEvaluator {
+	blacklist := make(map[string]bool, len(rules.BlacklistedMSISDNs))
+	for _, msisdn := range rules.BlacklistedMSISDNs {
+		blacklist[msisdn] = true
+	}
+
+	winners := make(map[string]bool, len(recentWinners))
+	if rules.WinCooldownDays > 0 {
+		for _, msisdn := range recentWinners {
+			winners[msisdn] = true
+		}
+	}
+
+	minimumPoints := rules.MinimumPoints
+	if minimumPoints < 1 {
+		minimumPoints = 1
+	}
+
+	return &EligibilityEvaluator{
+		rules:         rules,
+		drawDate:      calendarDate(drawDate),
+		windowStart:   rules.RechargeWindowStart(drawDate),
+		minimumPoints: minimumPoints,
+		blacklist:     blacklist,
+		recentWinners: winners,
+		totalPoints:   make(map[string]int),
+		windowPoints:  make(map[string]int),
+		result:        &EligibilityResult{Entries: make([]Entry, 0)},
+		excluded: map[string]int{
+			RuleRechargeWindow: 0,
+			RuleBlacklist:      0,
+			RuleWinCooldown:    0,
+			RuleMinimumPoints:  0,
+		},
+	}
+}
+
+// WindowStart returns the first day of the recharge window the evaluator applies,
+// or the zero time when all recharges up to the draw date count
+func (e *EligibilityEvaluator) WindowStart() time.Time {
+	return e.windowStart
+}
+
+// Add records a recharge. Recharges without points or after the draw date are ignored.
+func (e *EligibilityEvaluator) Add(msisdn string, points int, rechargeDate time.Time) {
+	if points <= 0 {
+		return
+	}
+
+	day := calendarDate(rechargeDate)
+	if day.After(e.drawDate) {
+		return
+	}
+
+	e.totalPoints[msisdn] += points
+	if e.windowStart.IsZero() || !day.Before(e.windowStart) {
+		e.windowPoints[msisdn] += points
+	}
+}
+
+// AddTotals evaluates an MSISDN whose recharges were summed up front: totalPoints over every
+// recharge up to the draw date, windowPoints over those inside the evaluator's WindowStart.
+// Each MSISDN must be added once, and not also through Add.
+func (e *EligibilityEvaluator) AddTotals(msisdn string, totalPoints, windowPoints int) {
+	if totalPoints <= 0 {
+		return
+	}
+	e.evaluate(msisdn, totalPoints, windowPoints)
+}
+
+// Result applies the rules to every recharge added so far
+func (e *EligibilityEvaluator) Result() *EligibilityResult {
+	for msisdn, total := range e.totalPoints {
+		e.evaluate(msisdn, total, e.windowPoints[msisdn])
+	}
+	e.totalPoints = make(map[string]int)
+	e.windowPoints = make(map[string]int)
+
+	result := e.result
+	sort.Slice(result.Entries, func(i, j int) bool {
+		return result.Entries[i].MSISDN < result.Entries[j].MSISDN
+	})
+
+	result.Exclusions = make([]RuleExclusion, 0, len(e.excluded))
+	for _, rule := range []string{RuleRechargeWindow, RuleBlacklist, RuleWinCooldown, RuleMinimumPoints} {
+		result.Exclusions = append(result.Exclusions, RuleExclusion{
+			Rule:            rule,
+			ExcludedMSISDNs: e.excluded[rule],
+		})
+	}
+
+	return result
+}
+
+// evaluate applies the rules to the summed recharges of one MSISDN
+func (e *EligibilityEvaluator) evaluate(msisdn string, total, points int) {
+	e.result.CandidateMSISDNs++
+	e.result.CandidateEntries += total
+
+	switch {
+	case points == 0:
+		e.excluded[RuleRechargeWindow]++
+	case e.blacklist[msisdn]:
+		e.excluded[RuleBlacklist]++
+	case e.recentWinners[msisdn]:
+		e.excluded[RuleWinCooldown]++
+	case points < e.minimumPoints:
+		e.excluded[RuleMinimumPoints]++
+	default:
+		e.result.Entries = append(e.result.Entries, Entry{MSISDN: msisdn, Points: points})
+		e.result.TotalEntries += points
+	}
+}
+
+// calendarDate strips the time of day, keeping the date as written in t's location
+func calendarDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,68 @@
+package draw_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+)
+
+func TestEligibilityEvaluator_AddTotalsMatchesAdd(t *testing.T) {
+	drawDate := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	rules := draw.EligibilityRules{
+		RechargeWindowDays: 7,
+		MinimumPoints:      3,
+		BlacklistedMSISDNs: []string{"2348030000004"},
+		WinCooldownDays:    30,
+	}
+	recentWinners := []string{"2348030000005"}
+
+	type recharge struct {
+		msisdn string
+		points int
+		date   time.Time
+	}
+	recharges := []recharge{
+		{"2348030000001", 2, drawDate},
+		{"2348030000001", 2, drawDate.AddDate(0, 0, -6)},
+		{"2348030000002", 9, drawDate.AddDate(0, 0, -7)}, // Before the recharge window
+		{"2348030000003", 1, drawDate.AddDate(0, 0, -1)},
+		{"2348030000003", 5, drawDate.AddDate(0, 0, -20)},
+		{"2348030000004", 8, drawDate},
+		{"2348030000005", 8, drawDate},
+		{"2348030000006", 4, drawDate},
+	}
+
+	byRecharge := draw.NewEligibilityEvaluator(rules, drawDate, recentWinners)
+	for _, r := range recharges {
+		byRecharge.Add(r.msisdn, r.points, r.date)
+	}
+
+	// The same recharges summed per MSISDN, in MSISDN order, as the participant stream delivers them
+	byTotals := draw.NewEligibilityEvaluator(rules, drawDate, recentWinners)
+	assert.Equal(t, drawDate.AddDate(0, 0, -6), byTotals.WindowStart())
+	byTotals.AddTotals("2348030000001", 4, 4)
+	byTotals.AddTotals("2348030000002", 9, 0)
+	byTotals.AddTotals("2348030000003", 6, 1)
+	byTotals.AddTotals("2348030000004", 8, 8)
+	byTotals.AddTotals("2348030000005", 8, 8)
+	byTotals.AddTotals("2348030000006", 4, 4)
+
+	expected := byRecharge.Result()
+	assert.Equal(t, []draw.Entry{
+		{MSISDN: "2348030000001", Points: 4},
+		{MSISDN: "2348030000006", Points: 4},
+	}, expected.Entries)
+	assert.Equal(t, 6, expected.CandidateMSISDNs)
+	assert.Equal(t, 39, expected.CandidateEntries)
+	assert.Equal(t, []draw.RuleExclusion{
+		{Rule: draw.RuleRechargeWindow, ExcludedMSISDNs: 1},
+		{Rule: draw.RuleBlacklist, ExcludedMSISDNs: 1},
+		{Rule: draw.RuleWinCooldown, ExcludedMSISDNs: 1},
+		{Rule: draw.RuleMinimumPoints, ExcludedMSISDNs: 1},
+	}, expected.Exclusions)
+
+	assert.Equal(t, expected, byTotals.Result())
+}
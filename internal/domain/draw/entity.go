@@ -12,16 +12,39 @@ type Draw struct {
 	ID                  uuid.UUID
 	DrawDate            time.Time
 	PrizeStructureID    uuid.UUID
-	Status              string // "Pending", "Completed", "Failed"
+	Status              string // One of the Status constants, changed through TransitionTo
 	TotalEligibleMSISDNs int
 	TotalEntries        int
 	ExecutedByAdminID   uuid.UUID
 	ExecutedBy          uuid.UUID  // Added for application layer compatibility
+	AlgorithmVersion    string
+	SeedHash            string           // SHA-256 of the seed, committed before selection
+	Seed                string           // Hex encoded seed, revealed once the draw completes
+	EntriesHash         string           // SHA-256 of the entry snapshot
+	SelectionPlan       []TierSelection
+	FailureReason       string     // Why the last execution failed; cleared once the draw completes
+	VoidReason          string
+	VoidedByAdminID     uuid.UUID
+	VoidedAt            *time.Time
+	ReplacementDrawID   uuid.UUID  // Set once a voided draw has been redrawn
 	Winners             []Winner
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
 }
 
+// Entry is one MSISDN in the snapshot of entries a draw was executed against
+type Entry struct {
+	MSISDN string
+	Points int
+}
+
+// TierSelection records how many winners and runner-ups were drawn for a prize tier
+type TierSelection struct {
+	PrizeTierID uuid.UUID `json:"prizeTierId"`
+	Winners     int       `json:"winners"`
+	RunnerUps   int       `json:"runnerUps"`
+}
+
 // Winner represents a winner entity in the domain
 type Winner struct {
 	ID            uuid.UUID
@@ -30,12 +53,23 @@ type Winner struct {
 	PrizeTierID   uuid.UUID
 	PrizeTierName string        // Added for application layer compatibility
 	PrizeValue    float64       // Added for application layer compatibility
-	Status        string // "PendingNotification", "Notified", "Confirmed"
-	PaymentStatus string // "Pending", "Paid", "Failed"
+	Status        string // "PendingNotification", "Notified", "Confirmed", "Forfeited", "Replaced"
+	PaymentStatus string // "Pending", "Approved", "Processing", "Paid", "Failed"
 	PaymentNotes  string
+	PaymentReference  string
+	PaymentApprovedBy uuid.UUID
+	PaymentApprovedAt *time.Time
+	PaymentHistory    []PaymentEvent // Oldest first; loaded with a single winner
 	PaidAt        *time.Time
 	IsRunnerUp    bool
 	RunnerUpRank  int
+	ReplacesWinnerID   uuid.UUID  // Set on a promoted runner-up: the winner whose prize it took
+	ReplacedByWinnerID uuid.UUID  // Set on a replaced winner: the runner-up that took its prize
+	ReplacementReason  string
+	ReplacedAt         *time.Time
+	ClaimDeadline      *time.Time // The prize is forfeited if not claimed by then; nil never expires
+	ClaimedAt          *time.Time
+	NotifiedAt         *time.Time // When delivery of the winning SMS was confirmed
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
@@ -44,14 +78,30 @@ type Winner struct {
 type DrawRepository interface {
 	Create(draw *Draw) error
 	GetByID(id uuid.UUID) (*Draw, error)
+	GetByIDForUpdate(id uuid.UUID) (*Draw, error) // Locks the draw until the enclosing transaction ends
 	List(page, pageSize int) ([]Draw, int, error)
 	GetByDate(date time.Time) (*Draw, error)
+	LockDate(date time.Time) error // Holds off other writers of the date's draws until the enclosing transaction ends
 	Update(draw *Draw) error
 	GetEligibilityStats(date time.Time) (int, int, error)
 	CreateWinner(winner *Winner) error
 	GetWinnerByID(id uuid.UUID) (*Winner, error)
 	UpdateWinner(winner *Winner) error
 	GetRunnerUps(drawID uuid.UUID, prizeTierID uuid.UUID, limit int) ([]Winner, error)
+	ListWinnersByPrizeTier(drawID uuid.UUID, prizeTierID uuid.UUID) ([]Winner, error)
+	ListExpiredClaims(now time.Time, limit int) ([]Winner, error)
+	ListWinnersAwaitingNotification(limit int) ([]Winner, error)
+	ListPrizeWinnersByMSISDNs(msisdns []string) ([]Winner, error)
+	CreateEntries(drawID uuid.UUID, entries []Entry) error
+	ListEntries(drawID uuid.UUID) ([]Entry, error)
+	ListWinningMSISDNs(from, to time.Time) ([]string, error)
+}
+
+// UnitOfWork runs a group of repository operations inside a single database transaction.
+// The transaction is committed when fn returns nil and rolled back when it returns an
+// error or panics, so none of the writes made through the repository are kept.
+type UnitOfWork interface {
+	Do(fn func(drawRepository DrawRepository) error) error
 }
 
 // DrawError represents domain-specific errors for the draw domain
@@ -69,6 +119,24 @@ const (
 	ErrNoEligibleParticipants = "NO_ELIGIBLE_PARTICIPANTS"
 	ErrWinnerNotFound        = "WINNER_NOT_FOUND"
 	ErrNoRunnerUpsAvailable  = "NO_RUNNER_UPS_AVAILABLE"
+	ErrDrawNotVerifiable     = "DRAW_NOT_VERIFIABLE"
+	ErrEligibilityMismatch   = "ELIGIBILITY_MISMATCH"
+	ErrInvalidStatusTransition = "INVALID_STATUS_TRANSITION"
+	ErrVoidReasonRequired    = "VOID_REASON_REQUIRED"
+	ErrVoidNotAuthorized     = "VOID_NOT_AUTHORIZED"
+	ErrDrawNotCompleted      = "DRAW_NOT_COMPLETED"
+	ErrInsufficientParticipants = "INSUFFICIENT_PARTICIPANTS"
+	ErrWinnerAlreadyReplaced = "WINNER_ALREADY_REPLACED"
+	ErrCannotReplaceRunnerUp = "CANNOT_REPLACE_RUNNER_UP"
+	ErrInvalidRunnerUp       = "INVALID_RUNNER_UP"
+	ErrClaimClosed           = "CLAIM_CLOSED"
+	ErrClaimNotExpired       = "CLAIM_NOT_EXPIRED"
+	ErrInvalidPaymentTransition = "INVALID_PAYMENT_TRANSITION"
+	ErrPaymentReferenceRequired = "PAYMENT_REFERENCE_REQUIRED"
+	ErrPaymentApproverConflict  = "PAYMENT_APPROVER_CONFLICT"
+	ErrInvalidStatementFile     = "INVALID_STATEMENT_FILE"
+	ErrInvalidWinnerFilter      = "INVALID_WINNER_FILTER"
+	ErrPrizeStructureMismatch   = "PRIZE_STRUCTURE_MISMATCH"
 )
 
 // Error implements the error interface
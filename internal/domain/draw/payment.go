@@ -0,0 +1,152 @@
+package draw
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Payment statuses of a winner's prize. Finance approves a payment before it is made:
+// Pending → Approved → Paid or Failed, where a payout may take Approved through Processing.
+// A failed payment is approved again before it is retried.
+const (
+	PaymentStatusPending    = "Pending"
+	PaymentStatusApproved   = "Approved"
+	PaymentStatusProcessing = "Processing" // A payout is with the provider
+	PaymentStatusPaid       = "Paid"
+	PaymentStatusFailed     = "Failed"
+)
+
+// paymentTransitions lists the payment statuses reachable from each payment status
+var paymentTransitions = map[string][]string{
+	PaymentStatusPending:    {PaymentStatusApproved},
+	PaymentStatusApproved:   {PaymentStatusProcessing, PaymentStatusPaid, PaymentStatusFailed},
+	PaymentStatusProcessing: {PaymentStatusPaid, PaymentStatusFailed},
+	PaymentStatusFailed:     {PaymentStatusApproved},
+}
+
+// PaymentEvent records one step of paying a winner's prize
+type PaymentEvent struct {
+	ID         uuid.UUID
+	WinnerID   uuid.UUID
+	FromStatus string
+	ToStatus   string
+	ActorID    uuid.UUID
+	Reference  string // Payment or payout reference, when the step has one
+	Notes      string
+	At         time.Time
+}
+
+// HoldsPrize reports whether the winner currently holds a prize of a completed draw that can be paid:
+// not a waiting runner-up and not replaced or forfeited
+func (w *Winner) HoldsPrize() bool {
+	if w.IsRunnerUp {
+		return false
+	}
+
+	switch w.Status {
+	case WinnerStatusPendingNotification, WinnerStatusNotified, WinnerStatusConfirmed:
+		return true
+	}
+	return false
+}
+
+// ApprovePayment records finance's approval to pay the prize
+func (w *Winner) ApprovePayment(actorID uuid.UUID, notes string, at time.Time) error {
+	if !w.HoldsPrize() {
+		return NewDrawError(ErrInvalidPaymentTransition, "Winner does not hold a prize, status is "+w.Status, nil)
+	}
+
+	if err := w.transitionPayment(PaymentStatusApproved, actorID, "", notes, at); err != nil {
+		return err
+	}
+
+	w.PaymentApprovedBy = actorID
+	w.PaymentApprovedAt = &at
+	return nil
+}
+
+// MarkPaymentProcessing records that a payout of the approved prize has been started.
+// The payout must be requested by someone other than the approver.
+func (w *Winner) MarkPaymentProcessing(actorID uuid.UUID, reference string, at time.Time) error {
+	if err := w.CheckNotApprover(actorID); err != nil {
+		return err
+	}
+
+	return w.transitionPayment(PaymentStatusProcessing, actorID, reference, "", at)
+}
+
+// MarkPaid records that the prize was paid under reference. A prize marked paid straight
+// from approval must be marked by someone other than the approver.
+func (w *Winner) MarkPaid(actorID uuid.UUID, reference, notes string, at time.Time) error {
+	if strings.TrimSpace(reference) == "" {
+		return NewDrawError(ErrPaymentReferenceRequired, "A payment reference is required to mark a prize as paid", nil)
+	}
+
+	if w.PaymentStatus == PaymentStatusApproved {
+		if err := w.CheckNotApprover(actorID); err != nil {
+			return err
+		}
+	}
+
+	if err := w.transitionPayment(PaymentStatusPaid, actorID, reference, notes, at); err != nil {
+		return err
+	}
+
+	w.PaidAt = &at
+	return nil
+}
+
+// MarkPaymentFailed records that paying out the prize failed
+func (w *Winner) MarkPaymentFailed(actorID uuid.UUID, reference, notes string, at time.Time) error {
+	return w.transitionPayment(PaymentStatusFailed, actorID, reference, notes, at)
+}
+
+// CheckNotApprover enforces that the payment is made by someone other than its approver
+func (w *Winner) CheckNotApprover(actorID uuid.UUID) error {
+	if w.PaymentApprovedBy != uuid.Nil && actorID == w.PaymentApprovedBy {
+		return NewDrawError(ErrPaymentApproverConflict, "The payment must be made by an admin other than the one who approved it", nil)
+	}
+	return nil
+}
+
+// transitionPayment moves the payment to status and appends the step to the payment history
+func (w *Winner) transitionPayment(status string, actorID uuid.UUID, reference, notes string, at time.Time) error {
+	from := w.PaymentStatus
+	if from == "" {
+		from = PaymentStatusPending
+	}
+
+	allowed := false
+	for _, next := range paymentTransitions[from] {
+		if next == status {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return NewDrawError(ErrInvalidPaymentTransition, "Cannot change payment status from "+from+" to "+status, nil)
+	}
+
+	w.PaymentStatus = status
+	if notes != "" {
+		w.PaymentNotes = notes
+	}
+	if reference != "" {
+		w.PaymentReference = reference
+	}
+	w.UpdatedAt = at
+	w.PaymentHistory = append(w.PaymentHistory, PaymentEvent{
+		ID:         uuid.New(),
+		WinnerID:   w.ID,
+		FromStatus: from,
+		ToStatus:   status,
+		ActorID:    actorID,
+		Reference:  reference,
+		Notes:      notes,
+		At:         at,
+	})
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package draw_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+)
+
+func TestWinner_PaymentMakerChecker(t *testing.T) {
+	now := time.Now()
+	approver, payer := uuid.New(), uuid.New()
+	winner := draw.Winner{ID: uuid.New(), Status: draw.WinnerStatusConfirmed, PaymentStatus: draw.PaymentStatusPending}
+
+	// A prize cannot be paid before it is approved
+	var drawErr *draw.DrawError
+	require.ErrorAs(t, winner.MarkPaid(payer, "TRX-1", "", now), &drawErr)
+	assert.Equal(t, draw.ErrInvalidPaymentTransition, drawErr.Code)
+
+	require.NoError(t, winner.ApprovePayment(approver, "Claim verified", now))
+	assert.Equal(t, draw.PaymentStatusApproved, winner.PaymentStatus)
+
+	// The approver cannot also mark the prize paid
+	require.ErrorAs(t, winner.MarkPaid(approver, "TRX-1", "", now), &drawErr)
+	assert.Equal(t, draw.ErrPaymentApproverConflict, drawErr.Code)
+
+	require.ErrorAs(t, winner.MarkPaid(payer, " ", "", now), &drawErr)
+	assert.Equal(t, draw.ErrPaymentReferenceRequired, drawErr.Code)
+
+	require.NoError(t, winner.MarkPaid(payer, "TRX-1", "Bank transfer", now))
+	assert.Equal(t, draw.PaymentStatusPaid, winner.PaymentStatus)
+	assert.Equal(t, "TRX-1", winner.PaymentReference)
+	require.NotNil(t, winner.PaidAt)
+
+	require.Len(t, winner.PaymentHistory, 2)
+	assert.Equal(t, draw.PaymentStatusPending, winner.PaymentHistory[0].FromStatus)
+	assert.Equal(t, approver, winner.PaymentHistory[0].ActorID)
+	assert.Equal(t, payer, winner.PaymentHistory[1].ActorID)
+	assert.Equal(t, "TRX-1", winner.PaymentHistory[1].Reference)
+
+	// A paid prize is final
+	require.ErrorAs(t, winner.MarkPaymentFailed(payer, "", "", now), &drawErr)
+	assert.Equal(t, draw.ErrInvalidPaymentTransition, drawErr.Code)
+}
+
+func TestWinner_FailedPaymentIsApprovedAgain(t *testing.T) {
+	now := time.Now()
+	approver, payer := uuid.New(), uuid.New()
+	winner := draw.Winner{ID: uuid.New(), Status: draw.WinnerStatusNotified, PaymentStatus: draw.PaymentStatusPending}
+
+	require.NoError(t, winner.ApprovePayment(approver, "", now))
+	require.NoError(t, winner.MarkPaymentProcessing(payer, "payout-1", now))
+	require.NoError(t, winner.MarkPaymentFailed(uuid.Nil, "payout-1", "Wallet closed", now))
+
+	var drawErr *draw.DrawError
+	require.ErrorAs(t, winner.MarkPaymentProcessing(payer, "payout-2", now), &drawErr)
+	assert.Equal(t, draw.ErrInvalidPaymentTransition, drawErr.Code)
+
+	require.NoError(t, winner.ApprovePayment(payer, "Wallet reopened", now))
+	require.ErrorAs(t, winner.MarkPaymentProcessing(payer, "payout-2", now), &drawErr)
+	assert.Equal(t, draw.ErrPaymentApproverConflict, drawErr.Code)
+	require.NoError(t, winner.MarkPaymentProcessing(approver, "payout-2", now))
+}
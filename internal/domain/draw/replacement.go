@@ -0,0 +1,92 @@
+package draw
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Winner statuses used by runner-up replacement
+const (
+	WinnerStatusPendingNotification = "PendingNotification"
+	WinnerStatusReplaced            = "Replaced" // The prize passed to the next runner-up in the chain
+)
+
+// ReplaceWith hands the winner's prize to a runner-up. The winner may be an original winner or a
+// runner-up promoted earlier, so a prize slot forms a chain of replacements. The runner-up must be
+// an unpromoted runner-up of the same draw and prize tier.
+func (w *Winner) ReplaceWith(runnerUp *Winner, reason string, at time.Time) error {
+	if w.IsRunnerUp {
+		return NewDrawError(ErrCannotReplaceRunnerUp, "Only a winner holding the prize can be replaced, not a waiting runner-up", nil)
+	}
+
+	if w.Status == WinnerStatusReplaced || w.ReplacedByWinnerID != uuid.Nil {
+		return NewDrawError(ErrWinnerAlreadyReplaced, "Winner has already been replaced", nil)
+	}
+
+	if !runnerUp.IsRunnerUp || runnerUp.ReplacesWinnerID != uuid.Nil {
+		return NewDrawError(ErrInvalidRunnerUp, fmt.Sprintf("Winner %s is not an available runner-up", runnerUp.ID), nil)
+	}
+
+	if runnerUp.DrawID != w.DrawID || runnerUp.PrizeTierID != w.PrizeTierID {
+		return NewDrawError(ErrInvalidRunnerUp, "Runner-up belongs to a different draw or prize tier", nil)
+	}
+
+	// A forfeited winner keeps that status so the reason the prize moved on stays visible
+	if w.Status != WinnerStatusForfeited {
+		w.Status = WinnerStatusReplaced
+	}
+	w.ReplacedByWinnerID = runnerUp.ID
+	w.ReplacementReason = strings.TrimSpace(reason)
+	w.ReplacedAt = &at
+	w.UpdatedAt = at
+
+	runnerUp.IsRunnerUp = false
+	runnerUp.ReplacesWinnerID = w.ID
+	runnerUp.Status = WinnerStatusPendingNotification
+	runnerUp.UpdatedAt = at
+
+	return nil
+}
+
+// ReplacementChain returns the prize slot containing winnerID, from the original winner through
+// each runner-up that replaced the previous holder. winners are the winners of the slot's draw
+// and prize tier.
+func ReplacementChain(winners []Winner, winnerID uuid.UUID) ([]Winner, error) {
+	byID := make(map[uuid.UUID]Winner, len(winners))
+	for _, winner := range winners {
+		byID[winner.ID] = winner
+	}
+
+	current, ok := byID[winnerID]
+	if !ok {
+		return nil, NewDrawError(ErrWinnerNotFound, "Winner not found", nil)
+	}
+
+	if current.IsRunnerUp {
+		return nil, NewDrawError(ErrCannotReplaceRunnerUp, "Runner-up has not been promoted to a prize slot", nil)
+	}
+
+	// Walk back to the original winner, guarding against corrupt links
+	for steps := 0; current.ReplacesWinnerID != uuid.Nil; steps++ {
+		previous, ok := byID[current.ReplacesWinnerID]
+		if !ok || steps > len(winners) {
+			return nil, fmt.Errorf("replacement chain of winner %s is broken", winnerID)
+		}
+		current = previous
+	}
+
+	chain := []Winner{current}
+	for current.ReplacedByWinnerID != uuid.Nil {
+		next, ok := byID[current.ReplacedByWinnerID]
+		if !ok || len(chain) > len(winners) {
+			return nil, fmt.Errorf("replacement chain of winner %s is broken", winnerID)
+		}
+		chain = append(chain, next)
+		current = next
+	}
+
+	return chain, nil
+}
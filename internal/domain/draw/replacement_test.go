@@ -0,0 +1,73 @@
+package draw_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+)
+
+func newSlot() (draw.Winner, []draw.Winner) {
+	drawID, tierID := uuid.New(), uuid.New()
+	winner := draw.Winner{ID: uuid.New(), DrawID: drawID, PrizeTierID: tierID, Status: draw.WinnerStatusPendingNotification}
+
+	runnerUps := make([]draw.Winner, 0, 2)
+	for rank := 1; rank <= 2; rank++ {
+		runnerUps = append(runnerUps, draw.Winner{
+			ID:           uuid.New(),
+			DrawID:       drawID,
+			PrizeTierID:  tierID,
+			IsRunnerUp:   true,
+			RunnerUpRank: rank,
+		})
+	}
+	return winner, runnerUps
+}
+
+func TestWinner_ReplaceWithCascades(t *testing.T) {
+	winner, runnerUps := newSlot()
+	first, second := runnerUps[0], runnerUps[1]
+	now := time.Now()
+
+	require.NoError(t, winner.ReplaceWith(&first, " did not claim ", now))
+	assert.Equal(t, draw.WinnerStatusReplaced, winner.Status)
+	assert.Equal(t, first.ID, winner.ReplacedByWinnerID)
+	assert.Equal(t, "did not claim", winner.ReplacementReason)
+	assert.False(t, first.IsRunnerUp)
+	assert.Equal(t, winner.ID, first.ReplacesWinnerID)
+
+	// A promoted runner-up can itself be replaced by the next rank
+	require.NoError(t, first.ReplaceWith(&second, "unreachable", now))
+	assert.Equal(t, second.ID, first.ReplacedByWinnerID)
+	assert.Equal(t, first.ID, second.ReplacesWinnerID)
+
+	chain, err := draw.ReplacementChain([]draw.Winner{second, winner, first}, first.ID)
+	require.NoError(t, err)
+	require.Len(t, chain, 3)
+	assert.Equal(t, []uuid.UUID{winner.ID, first.ID, second.ID}, []uuid.UUID{chain[0].ID, chain[1].ID, chain[2].ID})
+}
+
+func TestWinner_ReplaceWithRejectsInvalidReplacements(t *testing.T) {
+	winner, runnerUps := newSlot()
+	first, second := runnerUps[0], runnerUps[1]
+	now := time.Now()
+
+	// A waiting runner-up holds no prize to hand on
+	err := second.ReplaceWith(&first, "", now)
+	var drawErr *draw.DrawError
+	require.ErrorAs(t, err, &drawErr)
+	assert.Equal(t, draw.ErrCannotReplaceRunnerUp, drawErr.Code)
+
+	other := draw.Winner{ID: uuid.New(), DrawID: winner.DrawID, PrizeTierID: uuid.New(), IsRunnerUp: true}
+	require.ErrorAs(t, winner.ReplaceWith(&other, "", now), &drawErr)
+	assert.Equal(t, draw.ErrInvalidRunnerUp, drawErr.Code)
+
+	require.NoError(t, winner.ReplaceWith(&first, "", now))
+
+	require.ErrorAs(t, winner.ReplaceWith(&second, "", now), &drawErr)
+	assert.Equal(t, draw.ErrWinnerAlreadyReplaced, drawErr.Code)
+}
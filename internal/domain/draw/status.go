@@ -0,0 +1,120 @@
+package draw
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Draw statuses
+const (
+	StatusScheduled  = "Scheduled"  // Created ahead of the draw date, not yet executed
+	StatusInProgress = "InProgress" // Entries are being snapshotted and winners selected
+	StatusCompleted  = "Completed"
+	StatusFailed     = "Failed"     // Execution failed; the draw can be executed again or voided
+	StatusVoided     = "Voided"  // Cancelled by a super admin; its winners no longer stand
+	StatusRedrawn    = "Redrawn" // Voided and replaced by another draw for the same date
+)
+
+// statusTransitions lists the statuses each status may move to
+var statusTransitions = map[string][]string{
+	StatusScheduled:  {StatusInProgress, StatusFailed, StatusVoided},
+	StatusInProgress: {StatusCompleted, StatusFailed},
+	StatusFailed:     {StatusInProgress, StatusVoided},
+	StatusCompleted:  {StatusVoided},
+	StatusVoided:     {StatusRedrawn},
+}
+
+// StatusTransition records a change of draw status
+type StatusTransition struct {
+	DrawID uuid.UUID
+	From   string
+	To     string
+	Reason string
+	At     time.Time
+}
+
+// CanTransition reports whether a draw may move from one status to another
+func CanTransition(from, to string) bool {
+	for _, allowed := range statusTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitionTo moves the draw to a new status, rejecting transitions the lifecycle does not allow
+func (d *Draw) TransitionTo(status string, at time.Time) (StatusTransition, error) {
+	if !CanTransition(d.Status, status) {
+		return StatusTransition{}, NewDrawError(
+			ErrInvalidStatusTransition,
+			fmt.Sprintf("Draw cannot move from %s to %s", d.Status, status),
+			nil,
+		)
+	}
+
+	transition := StatusTransition{
+		DrawID: d.ID,
+		From:   d.Status,
+		To:     status,
+		At:     at,
+	}
+	d.Status = status
+	d.UpdatedAt = at
+
+	return transition, nil
+}
+
+// Void cancels the draw. A reason is mandatory.
+func (d *Draw) Void(reason string, voidedBy uuid.UUID, at time.Time) (StatusTransition, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return StatusTransition{}, NewDrawError(ErrVoidReasonRequired, "A reason is required to void a draw", nil)
+	}
+
+	transition, err := d.TransitionTo(StatusVoided, at)
+	if err != nil {
+		return StatusTransition{}, err
+	}
+
+	d.VoidReason = reason
+	d.VoidedByAdminID = voidedBy
+	d.VoidedAt = &at
+	transition.Reason = reason
+
+	return transition, nil
+}
+
+// MarkFailed records that executing the draw failed, and why. A draw that had already failed
+// keeps its status and takes the new reason.
+func (d *Draw) MarkFailed(reason string, at time.Time) (StatusTransition, error) {
+	transition := StatusTransition{DrawID: d.ID, From: d.Status, To: StatusFailed, At: at}
+	if d.Status != StatusFailed {
+		var err error
+		if transition, err = d.TransitionTo(StatusFailed, at); err != nil {
+			return StatusTransition{}, err
+		}
+	}
+
+	d.FailureReason = reason
+	d.UpdatedAt = at
+	transition.Reason = reason
+
+	return transition, nil
+}
+
+// MarkRedrawn links a voided draw to the draw that replaced it
+func (d *Draw) MarkRedrawn(replacementDrawID uuid.UUID, at time.Time) (StatusTransition, error) {
+	transition, err := d.TransitionTo(StatusRedrawn, at)
+	if err != nil {
+		return StatusTransition{}, err
+	}
+
+	d.ReplacementDrawID = replacementDrawID
+	transition.Reason = "Replaced by draw " + replacementDrawID.String()
+
+	return transition, nil
+}
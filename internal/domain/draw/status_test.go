@@ -0,0 +1,100 @@
+package draw_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+)
+
+func TestDraw_Lifecycle(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	d := &draw.Draw{ID: uuid.New(), Status: draw.StatusScheduled}
+
+	for _, status := range []string{draw.StatusInProgress, draw.StatusCompleted} {
+		transition, err := d.TransitionTo(status, now)
+		require.NoError(t, err)
+		assert.Equal(t, status, transition.To)
+		assert.Equal(t, d.ID, transition.DrawID)
+	}
+
+	admin := uuid.New()
+	transition, err := d.Void("Duplicate entries found", admin, now)
+	require.NoError(t, err)
+	assert.Equal(t, draw.StatusCompleted, transition.From)
+	assert.Equal(t, draw.StatusVoided, d.Status)
+	assert.Equal(t, "Duplicate entries found", d.VoidReason)
+	assert.Equal(t, admin, d.VoidedByAdminID)
+	require.NotNil(t, d.VoidedAt)
+
+	replacement := uuid.New()
+	_, err = d.MarkRedrawn(replacement, now)
+	require.NoError(t, err)
+	assert.Equal(t, draw.StatusRedrawn, d.Status)
+	assert.Equal(t, replacement, d.ReplacementDrawID)
+}
+
+func TestDraw_TransitionToRejectsIllegalTransitions(t *testing.T) {
+	tests := []struct {
+		from string
+		to   string
+	}{
+		{draw.StatusScheduled, draw.StatusCompleted},
+		{draw.StatusCompleted, draw.StatusInProgress},
+		{draw.StatusCompleted, draw.StatusRedrawn},
+		{draw.StatusVoided, draw.StatusCompleted},
+		{draw.StatusRedrawn, draw.StatusVoided},
+	}
+
+	for _, tt := range tests {
+		d := &draw.Draw{ID: uuid.New(), Status: tt.from}
+		_, err := d.TransitionTo(tt.to, time.Now())
+
+		var drawErr *draw.DrawError
+		require.True(t, errors.As(err, &drawErr), "%s -> %s", tt.from, tt.to)
+		assert.Equal(t, draw.ErrInvalidStatusTransition, drawErr.Code)
+		assert.Equal(t, tt.from, d.Status)
+	}
+}
+
+func TestDraw_VoidRequiresReason(t *testing.T) {
+	d := &draw.Draw{ID: uuid.New(), Status: draw.StatusCompleted}
+
+	_, err := d.Void("  ", uuid.New(), time.Now())
+
+	var drawErr *draw.DrawError
+	require.True(t, errors.As(err, &drawErr))
+	assert.Equal(t, draw.ErrVoidReasonRequired, drawErr.Code)
+	assert.Equal(t, draw.StatusCompleted, d.Status)
+}
+
+func TestDraw_MarkFailed(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	d := &draw.Draw{ID: uuid.New(), Status: draw.StatusInProgress}
+
+	transition, err := d.MarkFailed("failed to create winner", now)
+	require.NoError(t, err)
+	assert.Equal(t, draw.StatusInProgress, transition.From)
+	assert.Equal(t, draw.StatusFailed, d.Status)
+	assert.Equal(t, "failed to create winner", d.FailureReason)
+
+	// Failing again keeps the status and records the latest error
+	transition, err = d.MarkFailed("failed to store draw entries", now)
+	require.NoError(t, err)
+	assert.Equal(t, draw.StatusFailed, transition.From)
+	assert.Equal(t, "failed to store draw entries", d.FailureReason)
+
+	completed := &draw.Draw{ID: uuid.New(), Status: draw.StatusCompleted}
+	_, err = completed.MarkFailed("failed to create winner", now)
+
+	var drawErr *draw.DrawError
+	require.True(t, errors.As(err, &drawErr))
+	assert.Equal(t, draw.ErrInvalidStatusTransition, drawErr.Code)
+	assert.Equal(t, draw.StatusCompleted, completed.Status)
+	assert.Empty(t, completed.FailureReason)
+}
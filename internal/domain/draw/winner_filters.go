@@ -0,0 +1,88 @@
+package draw
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Fields winners can be sorted by when listed
+const (
+	WinnerSortCreatedAt     = "createdAt"
+	WinnerSortMSISDN        = "msisdn"
+	WinnerSortStatus        = "status"
+	WinnerSortPaymentStatus = "paymentStatus"
+	WinnerSortRunnerUpRank  = "runnerUpRank"
+)
+
+// Sort orders of a winner listing
+const (
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+// WinnerFilters defines the filters and ordering for listing winners. Zero values do not filter.
+type WinnerFilters struct {
+	StartDate     time.Time // Winners recorded on or after this day
+	EndDate       time.Time // Winners recorded on or before this day
+	DrawID        uuid.UUID
+	PrizeTierID   uuid.UUID
+	Status        string
+	PaymentStatus string
+	IsRunnerUp    *bool
+	MSISDN        string // A full MSISDN, or a masked one such as 234****567 where * hides digits
+	SortBy        string // One of the WinnerSort constants, createdAt by default
+	SortOrder     string // asc or desc, desc by default
+}
+
+// IsMaskedMSISDN reports whether the MSISDN filter hides digits and is matched as a pattern
+func (f WinnerFilters) IsMaskedMSISDN() bool {
+	return strings.Contains(f.MSISDN, "*")
+}
+
+// Validate checks the filters and fills in the default ordering
+func (f *WinnerFilters) Validate() error {
+	if !f.StartDate.IsZero() && !f.EndDate.IsZero() && f.EndDate.Before(f.StartDate) {
+		return NewDrawError(ErrInvalidWinnerFilter, "End date must not be before start date", nil)
+	}
+
+	if f.Status != "" && !isOneOf(f.Status, WinnerStatusPendingNotification, WinnerStatusNotified, WinnerStatusConfirmed, WinnerStatusForfeited, WinnerStatusReplaced) {
+		return NewDrawError(ErrInvalidWinnerFilter, "Unknown winner status "+f.Status, nil)
+	}
+
+	if f.PaymentStatus != "" && !isOneOf(f.PaymentStatus, PaymentStatusPending, PaymentStatusApproved, PaymentStatusProcessing, PaymentStatusPaid, PaymentStatusFailed) {
+		return NewDrawError(ErrInvalidWinnerFilter, "Unknown payment status "+f.PaymentStatus, nil)
+	}
+
+	if f.IsMaskedMSISDN() && len(strings.ReplaceAll(f.MSISDN, "*", "")) < 3 {
+		return NewDrawError(ErrInvalidWinnerFilter, "A masked MSISDN must show at least 3 digits", nil)
+	}
+
+	if f.SortBy == "" {
+		f.SortBy = WinnerSortCreatedAt
+	}
+	if !isOneOf(f.SortBy, WinnerSortCreatedAt, WinnerSortMSISDN, WinnerSortStatus, WinnerSortPaymentStatus, WinnerSortRunnerUpRank) {
+		return NewDrawError(ErrInvalidWinnerFilter, "Winners cannot be sorted by "+f.SortBy, nil)
+	}
+
+	f.SortOrder = strings.ToLower(f.SortOrder)
+	if f.SortOrder == "" {
+		f.SortOrder = SortOrderDesc
+	}
+	if f.SortOrder != SortOrderAsc && f.SortOrder != SortOrderDesc {
+		return NewDrawError(ErrInvalidWinnerFilter, "Sort order must be asc or desc", nil)
+	}
+
+	return nil
+}
+
+// isOneOf reports whether value is one of values
+func isOneOf(value string, values ...string) bool {
+	for _, v := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
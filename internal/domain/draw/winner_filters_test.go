@@ -0,0 +1,40 @@
+package draw_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+)
+
+func TestWinnerFilters_Validate(t *testing.T) {
+	filters := draw.WinnerFilters{PaymentStatus: draw.PaymentStatusApproved, MSISDN: "234****567"}
+	require.NoError(t, filters.Validate())
+	assert.True(t, filters.IsMaskedMSISDN())
+
+	// Newest winners come first unless asked otherwise
+	assert.Equal(t, draw.WinnerSortCreatedAt, filters.SortBy)
+	assert.Equal(t, draw.SortOrderDesc, filters.SortOrder)
+
+	day := time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC)
+	invalid := []draw.WinnerFilters{
+		{StartDate: day, EndDate: day.AddDate(0, 0, -1)},
+		{Status: "Won"},
+		{PaymentStatus: "Settled"},
+		{MSISDN: "23****"},
+		{SortBy: "prizeValue"},
+		{SortOrder: "sideways"},
+	}
+	for _, f := range invalid {
+		var drawErr *draw.DrawError
+		require.ErrorAs(t, f.Validate(), &drawErr, "%+v", f)
+		assert.Equal(t, draw.ErrInvalidWinnerFilter, drawErr.Code)
+	}
+
+	sameDay := draw.WinnerFilters{StartDate: day, EndDate: day, SortOrder: "ASC"}
+	require.NoError(t, sameDay.Validate())
+	assert.Equal(t, draw.SortOrderAsc, sameDay.SortOrder)
+}
@@ -115,6 +115,8 @@ type Prize struct {
 	Quantity          int
 	Position          int
 	NumberOfRunnerUps int
+	ClaimWindowHours  int
+	NotificationTemplate string
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
 	CreatedBy         uuid.UUID
@@ -132,6 +134,8 @@ type PrizeInput struct {
 	Quantity          int
 	IsActive          bool
 	NumberOfRunnerUps int
+	ClaimWindowHours  int
+	NotificationTemplate string
 }
 
 // PrizeStructure represents a prize structure in the system
@@ -144,6 +148,8 @@ type PrizeStructure struct {
 	Value             float64
 	IsActive          bool
 	NumberOfRunnerUps int
+	ClaimWindowHours  int
+	NotificationTemplate string
 	Prizes            []Prize
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
@@ -194,17 +200,31 @@ type Draw struct {
 	DrawDate            time.Time
 	PrizeStructureID    uuid.UUID
 	Status              string
+	SeedHash            string
 	TotalEligibleMSISDNs int
 	TotalEntries        int
 	ExecutedByAdminID   uuid.UUID
 	ExecutedBy          uuid.UUID
 	RunnerUpsCount      int
 	CreatedBy           uuid.UUID
+	FailureReason       string
+	VoidReason          string
+	VoidedAt            *time.Time
+	ReplacementDrawID   uuid.UUID
 	Winners             []Winner
+	RunnerUps           []TierRunnerUps
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
 }
 
+// TierRunnerUps reports the runner-ups requested and drawn for a prize tier
+type TierRunnerUps struct {
+	PrizeTierID uuid.UUID
+	PrizeName   string
+	Requested   int
+	Available   int
+}
+
 // DrawWithWinners represents a draw with its winners
 type DrawWithWinners struct {
 	Draw    Draw
@@ -234,13 +254,55 @@ type Winner struct {
 	Status        string
 	PaymentStatus string
 	PaymentNotes  string
+	PaymentReference  string
+	PaymentApprovedBy uuid.UUID
+	PaymentApprovedAt *time.Time
+	PaymentHistory    []PaymentEvent
 	PaidAt        *time.Time
 	IsRunnerUp    bool
 	RunnerUpRank  int
+	ReplacesWinnerID   uuid.UUID
+	ReplacedByWinnerID uuid.UUID
+	ReplacementReason  string
+	ReplacedAt         *time.Time
+	ClaimDeadline      *time.Time
+	ClaimedAt          *time.Time
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
 
+// PaymentEvent represents one step of paying a winner's prize
+type PaymentEvent struct {
+	ID         uuid.UUID
+	FromStatus string
+	ToStatus   string
+	ActorID    uuid.UUID
+	Reference  string
+	Notes      string
+	At         time.Time
+}
+
+// PaymentHistory represents the payment of a winner's prize and every step taken to pay it
+type PaymentHistory struct {
+	WinnerID          uuid.UUID
+	PaymentStatus     string
+	PaymentReference  string
+	PaymentApprovedBy uuid.UUID
+	PaymentApprovedAt *time.Time
+	PaidAt            *time.Time
+	Events            []PaymentEvent
+}
+
+// ReplacementHistory represents the holders of a prize slot, from the original winner
+// through each runner-up that replaced the previous holder
+type ReplacementHistory struct {
+	DrawID             uuid.UUID
+	PrizeTierID        uuid.UUID
+	CurrentWinnerID    uuid.UUID
+	RemainingRunnerUps int
+	Chain              []Winner
+}
+
 // PaginatedWinners represents a paginated list of winners
 type PaginatedWinners struct {
 	Winners    []Winner
@@ -256,10 +318,20 @@ type EligibilityStats struct {
 	TotalPoints   int
 	TotalEligibleMSISDNs int
 	TotalEntries  int
+	CandidateMSISDNs int
+	CandidateEntries int
+	Exclusions    []EligibilityExclusion
+	PrizeStructureID uuid.UUID
 	DrawDate      time.Time
 	LastUpdated   time.Time
 }
 
+// EligibilityExclusion represents how many MSISDNs an eligibility rule excluded
+type EligibilityExclusion struct {
+	Rule            string
+	ExcludedMSISDNs int
+}
+
 // RunnerUpInvocationResult represents the result of invoking a runner-up
 type RunnerUpInvocationResult struct {
 	Success        bool
@@ -0,0 +1,16 @@
+// Package mail defines the outgoing email messages of the application and the mailers that deliver them.
+package mail
+
+import "context"
+
+// Message is a plain text email
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers email messages
+type Mailer interface {
+	Send(ctx context.Context, message Message) error
+}
@@ -0,0 +1,114 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DispatchLockKey is the Postgres advisory lock key held while the outbox is dispatched
+const DispatchLockKey int64 = 724_301_002
+
+// ChannelSMS is the channel winners are notified on
+const ChannelSMS = "SMS"
+
+// Statuses of an outbox notification
+const (
+	StatusPending   = "Pending"   // Waiting for its first or next send attempt
+	StatusSent      = "Sent"      // Accepted by the gateway, waiting for a delivery receipt
+	StatusDelivered = "Delivered" // The gateway reported delivery to the handset
+	StatusFailed    = "Failed"    // Gave up after MaxAttempts
+)
+
+// Notification is an outbox entry holding a message to a winner until it has been sent
+type Notification struct {
+	ID                uuid.UUID
+	WinnerID          uuid.UUID
+	DrawID            uuid.UUID
+	MSISDN            string
+	Channel           string
+	Message           string
+	Status            string
+	Attempts          int
+	MaxAttempts       int
+	NextAttemptAt     time.Time
+	LastError         string
+	ProviderMessageID string
+	SentAt            *time.Time
+	DeliveredAt       *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// Message is a message handed to a Notifier
+type Message struct {
+	ID     uuid.UUID // Outbox notification ID, usable as an idempotency key by the gateway
+	MSISDN string
+	Text   string
+}
+
+// SendResult is the gateway's acknowledgement of a message
+type SendResult struct {
+	ProviderMessageID string // Quoted back by delivery receipts
+}
+
+// Notifier sends messages over a channel
+type Notifier interface {
+	Send(ctx context.Context, message Message) (*SendResult, error)
+}
+
+// NotificationRepository defines the interface for notification outbox data access
+type NotificationRepository interface {
+	// Create stores a notification unless the winner already has one, reporting whether it was stored
+	Create(notification *Notification) (bool, error)
+	GetByID(id uuid.UUID) (*Notification, error)
+	GetByProviderMessageID(providerMessageID string) (*Notification, error)
+	ListByWinnerID(winnerID uuid.UUID) ([]Notification, error)
+	ListDue(now time.Time, limit int) ([]Notification, error)
+	Update(notification *Notification) error
+}
+
+// DispatchLock makes sure only one server dispatches the outbox at a time, so a message is
+// never sent twice concurrently
+type DispatchLock interface {
+	// TryRun runs fn while holding the lock. It returns false without running fn
+	// when another process holds the lock.
+	TryRun(fn func() error) (bool, error)
+}
+
+// NotificationError represents domain-specific errors for the notification domain
+type NotificationError struct {
+	Code    string
+	Message string
+	Err     error
+}
+
+// Error codes for the notification domain
+const (
+	ErrNotificationNotFound = "NOTIFICATION_NOT_FOUND"
+	ErrInvalidTemplate      = "INVALID_NOTIFICATION_TEMPLATE"
+	ErrInvalidReceipt       = "INVALID_DELIVERY_RECEIPT"
+)
+
+// Error implements the error interface
+func (e *NotificationError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap returns the wrapped error
+func (e *NotificationError) Unwrap() error {
+	return e.Err
+}
+
+// NewNotificationError creates a new NotificationError
+func NewNotificationError(code, message string, err error) *NotificationError {
+	return &NotificationError{
+		Code:    code,
+		Message: message,
+		Err:     err,
+	}
+}
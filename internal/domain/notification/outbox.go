@@ -0,0 +1,92 @@
+package notification
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultMaxAttempts is how often a notification is sent before it is marked Failed
+const DefaultMaxAttempts = 5
+
+// retryBaseDelay is the wait after the first failed attempt; it doubles with every further failure
+const retryBaseDelay = time.Minute
+
+// NewWinnerNotification creates an outbox entry for a winner, due straight away
+func NewWinnerNotification(winnerID, drawID uuid.UUID, msisdn, text string, maxAttempts int, now time.Time) *Notification {
+	if maxAttempts < 1 {
+		maxAttempts = DefaultMaxAttempts
+	}
+
+	return &Notification{
+		ID:            uuid.New(),
+		WinnerID:      winnerID,
+		DrawID:        drawID,
+		MSISDN:        msisdn,
+		Channel:       ChannelSMS,
+		Message:       text,
+		Status:        StatusPending,
+		MaxAttempts:   maxAttempts,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+// MarkSent records that the gateway accepted the message
+func (n *Notification) MarkSent(providerMessageID string, at time.Time) {
+	n.Attempts++
+	n.Status = StatusSent
+	n.ProviderMessageID = providerMessageID
+	n.LastError = ""
+	n.SentAt = &at
+	n.UpdatedAt = at
+}
+
+// MarkFailed records a failed attempt, scheduling a retry with exponential backoff until
+// MaxAttempts is reached. countAttempt is false for failures reported by a delivery receipt,
+// whose attempt was already counted when the message was sent.
+func (n *Notification) MarkFailed(reason string, countAttempt bool, at time.Time) {
+	if countAttempt {
+		n.Attempts++
+	}
+	n.LastError = reason
+	n.UpdatedAt = at
+
+	if n.Attempts >= n.MaxAttempts {
+		n.Status = StatusFailed
+		return
+	}
+
+	n.Status = StatusPending
+	n.NextAttemptAt = at.Add(retryBaseDelay << (n.Attempts - 1))
+}
+
+// MarkDelivered records the gateway's delivery receipt
+func (n *Notification) MarkDelivered(at time.Time) {
+	n.Status = StatusDelivered
+	n.DeliveredAt = &at
+	n.UpdatedAt = at
+}
+
+// Outcomes of a delivery receipt
+const (
+	ReceiptDelivered    = "Delivered"
+	ReceiptFailed       = "Failed"
+	ReceiptIntermediate = "Intermediate" // The message is still on its way; nothing changes
+)
+
+// ParseReceiptStatus maps a gateway's delivery status, including the SMPP stat values, to an outcome
+func ParseReceiptStatus(status string) (string, error) {
+	switch strings.ToUpper(strings.TrimSpace(status)) {
+	case "DELIVRD", "DELIVERED":
+		return ReceiptDelivered, nil
+	case "UNDELIV", "UNDELIVERED", "EXPIRED", "REJECTD", "REJECTED", "DELETED", "FAILED":
+		return ReceiptFailed, nil
+	case "ACCEPTD", "ACCEPTED", "ENROUTE", "BUFFERED", "SENT", "UNKNOWN":
+		return ReceiptIntermediate, nil
+	}
+
+	return "", NewNotificationError(ErrInvalidReceipt, "Unknown delivery status "+status, nil)
+}
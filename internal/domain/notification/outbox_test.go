@@ -0,0 +1,72 @@
+package notification_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/notification"
+)
+
+func TestNotification_RetriesWithBackoffUntilFailed(t *testing.T) {
+	now := time.Now()
+	n := notification.NewWinnerNotification(uuid.New(), uuid.New(), "2348031234567", "You won", 3, now)
+	assert.Equal(t, notification.StatusPending, n.Status)
+
+	n.MarkFailed("timeout", true, now)
+	assert.Equal(t, notification.StatusPending, n.Status)
+	assert.Equal(t, now.Add(time.Minute), n.NextAttemptAt)
+
+	n.MarkFailed("timeout", true, now)
+	assert.Equal(t, now.Add(2*time.Minute), n.NextAttemptAt)
+
+	n.MarkFailed("timeout", true, now)
+	assert.Equal(t, notification.StatusFailed, n.Status)
+	assert.Equal(t, 3, n.Attempts)
+}
+
+func TestNotification_FailedReceiptDoesNotCountAnotherAttempt(t *testing.T) {
+	now := time.Now()
+	n := notification.NewWinnerNotification(uuid.New(), uuid.New(), "2348031234567", "You won", 2, now)
+
+	n.MarkSent("gw-1", now)
+	n.MarkFailed("UNDELIV", false, now)
+	assert.Equal(t, notification.StatusPending, n.Status)
+	assert.Equal(t, 1, n.Attempts)
+}
+
+func TestParseReceiptStatus(t *testing.T) {
+	for status, want := range map[string]string{
+		"DELIVRD":   notification.ReceiptDelivered,
+		"delivered": notification.ReceiptDelivered,
+		"UNDELIV":   notification.ReceiptFailed,
+		"EXPIRED":   notification.ReceiptFailed,
+		"ACCEPTD":   notification.ReceiptIntermediate,
+	} {
+		outcome, err := notification.ParseReceiptStatus(status)
+		require.NoError(t, err, status)
+		assert.Equal(t, want, outcome, status)
+	}
+
+	_, err := notification.ParseReceiptStatus("BOGUS")
+	assert.Error(t, err)
+}
+
+func TestRenderWinnerMessage(t *testing.T) {
+	data := notification.WinnerMessageData{PrizeName: "Jackpot", PrizeValue: "1,000,000.00", DrawDate: "1 May 2025", ClaimDeadline: "4 May 2025 12:00 WAT"}
+
+	message, err := notification.RenderWinnerMessage("", data)
+	require.NoError(t, err)
+	assert.Contains(t, message, "Jackpot worth NGN 1,000,000.00")
+
+	message, err = notification.RenderWinnerMessage("{{.MaskedMSISDN}} won {{.PrizeName}}", notification.WinnerMessageData{MaskedMSISDN: "234***567", PrizeName: "Airtime"})
+	require.NoError(t, err)
+	assert.Equal(t, "234***567 won Airtime", message)
+
+	assert.NoError(t, notification.ValidateTemplate(""))
+	assert.Error(t, notification.ValidateTemplate("{{.Unknown}}"))
+	assert.Error(t, notification.ValidateTemplate("{{.PrizeName"))
+}
@@ -0,0 +1,49 @@
+package notification
+
+import (
+	"strings"
+	"text/template"
+)
+
+// DefaultWinnerTemplate is the message sent to winners of tiers without their own template
+const DefaultWinnerTemplate = "Congratulations! You have won {{.PrizeName}} worth NGN {{.PrizeValue}} in the MyNumba Don Win draw of {{.DrawDate}}. Claim your prize before {{.ClaimDeadline}}."
+
+// WinnerMessageData holds the values a winner notification template can use
+type WinnerMessageData struct {
+	MSISDN        string
+	MaskedMSISDN  string
+	PrizeName     string
+	PrizeValue    string
+	DrawDate      string
+	ClaimDeadline string
+}
+
+// ValidateTemplate checks that a template parses and only uses WinnerMessageData fields.
+// An empty template is valid and stands for DefaultWinnerTemplate.
+func ValidateTemplate(text string) error {
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+
+	_, err := RenderWinnerMessage(text, WinnerMessageData{})
+	return err
+}
+
+// RenderWinnerMessage renders a winner notification template, using DefaultWinnerTemplate when text is empty
+func RenderWinnerMessage(text string, data WinnerMessageData) (string, error) {
+	if strings.TrimSpace(text) == "" {
+		text = DefaultWinnerTemplate
+	}
+
+	tmpl, err := template.New("winner").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", NewNotificationError(ErrInvalidTemplate, "Invalid notification template", err)
+	}
+
+	var message strings.Builder
+	if err := tmpl.Execute(&message, data); err != nil {
+		return "", NewNotificationError(ErrInvalidTemplate, "Invalid notification template", err)
+	}
+
+	return message.String(), nil
+}
@@ -2,6 +2,7 @@ package participant
 
 import (
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,6 +20,13 @@ type Participant struct {
 	UpdatedAt      time.Time
 }
 
+// RechargeTotals are the points an MSISDN earned with its recharges up to a draw date
+type RechargeTotals struct {
+	MSISDN       string
+	TotalPoints  int // Every recharge on or before the draw date
+	WindowPoints int // Recharges inside the draw's recharge window
+}
+
 // ParticipantInput represents input for creating a participant
 type ParticipantInput struct {
 	MSISDN         string
@@ -34,11 +42,17 @@ type ParticipantRepository interface {
 	GetByMSISDNAndDate(msisdn string, date time.Time) (*Participant, error)
 	List(page, pageSize int) ([]Participant, int, error)
 	ListByDate(date time.Time, page, pageSize int) ([]Participant, int, error)
+	// ForEachEligible streams the recharge totals of every MSISDN with points on or before the
+	// given date to fn in batches of batchSize, in MSISDN order, stopping at the first error fn
+	// returns. WindowPoints counts the recharges from windowStart on, all of them when it is zero.
+	ForEachEligible(date, windowStart time.Time, batchSize int, fn func(batch []RechargeTotals) error) error
 	GetStatsByDate(date time.Time) (int, int, error)
 	GetStats(date time.Time) (int, int, float64, error)
 	BulkCreate(participants []*Participant) (int, []string, error)
 	CreateBatch(participants []*Participant) (int, []string, error)
 	DeleteByUploadID(uploadID uuid.UUID) error
+	CreateUploadAudit(audit *UploadAudit) error
+	GetUploadAuditByID(id uuid.UUID) (*UploadAudit, error)
 }
 
 // UploadAudit represents an audit record for participant data uploads
@@ -51,6 +65,7 @@ type UploadAudit struct {
 	TotalRows       int
 	SuccessfulRows  int
 	ErrorCount      int
+	DuplicateCount  int
 	ErrorDetails    []string
 	ErrorMessage    string       // Added for adapter layer compatibility
 	ProcessingTime  string       // Added for adapter layer compatibility
@@ -114,12 +129,37 @@ func ValidateMSISDN(msisdn string) error {
 		return errors.New("MSISDN cannot be empty")
 	}
 	
-	// Additional validation logic can be added here
-	// For example, ensuring the MSISDN follows the correct format for Nigerian phone numbers
+	for _, ch := range msisdn {
+		if ch < '0' || ch > '9' {
+			return errors.New("MSISDN must contain digits only")
+		}
+	}
+	
+	// Nigerian numbers are 234 followed by a 10 digit subscriber number
+	if len(msisdn) != 13 || !strings.HasPrefix(msisdn, "234") {
+		return errors.New("MSISDN must be a Nigerian number in the format 234XXXXXXXXXX")
+	}
 	
 	return nil
 }
 
+// NormalizeMSISDN converts the common local formats of a Nigerian number
+// (08031234567, +2348031234567, 8031234567) to the international 234 format
+func NormalizeMSISDN(msisdn string) string {
+	msisdn = strings.TrimSpace(msisdn)
+	msisdn = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "").Replace(msisdn)
+	msisdn = strings.TrimPrefix(msisdn, "+")
+	
+	switch {
+	case len(msisdn) == 11 && strings.HasPrefix(msisdn, "0"):
+		return "234" + msisdn[1:]
+	case len(msisdn) == 10 && !strings.HasPrefix(msisdn, "0"):
+		return "234" + msisdn
+	}
+	
+	return msisdn
+}
+
 // CalculatePoints calculates the number of points based on recharge amount
 func CalculatePoints(rechargeAmount float64) int {
 	// Every full N100 recharge is 1 point
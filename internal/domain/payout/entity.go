@@ -0,0 +1,177 @@
+package payout
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SettleLockKey is the Postgres advisory lock key held while unsettled payouts are processed
+const SettleLockKey int64 = 724_301_003
+
+// Payout methods
+const (
+	MethodAirtime     = "Airtime"     // Top-up of the winner's line, for small prizes
+	MethodMobileMoney = "MobileMoney" // Transfer to the mobile money wallet of the winner's MSISDN
+)
+
+// Payout statuses
+const (
+	StatusPending    = "Pending"    // Created, not yet accepted by the provider
+	StatusProcessing = "Processing" // Accepted by the provider, waiting for the outcome
+	StatusSucceeded  = "Succeeded"
+	StatusFailed     = "Failed" // Can be retried, which sends it to the provider again
+)
+
+// Payout pays a winner's prize through a payout provider. A winner has at most one payout;
+// retrying a failed payout reuses it with a new provider reference.
+type Payout struct {
+	ID                uuid.UUID
+	WinnerID          uuid.UUID
+	DrawID            uuid.UUID
+	MSISDN            string
+	Method            string
+	Amount            float64
+	Currency          string
+	Status            string
+	Provider          string
+	Attempts          int
+	ProviderReference string // Our reference for the current attempt, the provider's idempotency key
+	ProviderPayoutID  string // The provider's ID for the current attempt
+	FailureReason     string
+	NextCheckAt       time.Time
+	RequestedBy       uuid.UUID
+	CompletedAt       *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// PayoutRequest is a payout handed to a PayoutProvider
+type PayoutRequest struct {
+	Reference string // Idempotency key: the provider pays a reference at most once
+	Method    string
+	MSISDN    string
+	Amount    float64
+	Currency  string
+}
+
+// Provider statuses of a payout
+const (
+	ProviderStatusPending   = "Pending"
+	ProviderStatusSucceeded = "Succeeded"
+	ProviderStatusFailed    = "Failed"
+)
+
+// ProviderResult is a provider's report of a payout
+type ProviderResult struct {
+	ProviderPayoutID string
+	Status           string // One of the ProviderStatus constants
+	FailureReason    string
+}
+
+// PayoutProvider pays prizes through an airtime or money transfer service
+type PayoutProvider interface {
+	// Name identifies the provider in payouts and callbacks
+	Name() string
+	// Initiate starts a payout. Initiating a reference again returns the original payout.
+	Initiate(ctx context.Context, request PayoutRequest) (*ProviderResult, error)
+	// Status returns the current state of a payout by its reference
+	Status(ctx context.Context, reference string) (*ProviderResult, error)
+}
+
+// PayoutRepository defines the interface for payout data access
+type PayoutRepository interface {
+	// Create stores a payout unless the winner already has one, reporting whether it was stored
+	Create(payout *Payout) (bool, error)
+	GetByID(id uuid.UUID) (*Payout, error)
+	GetByWinnerID(winnerID uuid.UUID) (*Payout, error)
+	GetByProviderReference(reference string) (*Payout, error)
+	ListUnsettled(now time.Time, limit int) ([]Payout, error)
+	Update(payout *Payout) error
+	// SaveAttempt stores a payout whose attempt was started unless another attempt was started
+	// since it was read with previousAttempts, reporting whether it was stored
+	SaveAttempt(payout *Payout, previousAttempts int) (bool, error)
+}
+
+// ProviderSet holds the provider of each payout method
+type ProviderSet struct {
+	byMethod map[string]PayoutProvider
+}
+
+// NewProviderSet creates a ProviderSet; a nil provider leaves its method unavailable
+func NewProviderSet(airtime, mobileMoney PayoutProvider) *ProviderSet {
+	set := &ProviderSet{byMethod: make(map[string]PayoutProvider)}
+	if airtime != nil {
+		set.byMethod[MethodAirtime] = airtime
+	}
+	if mobileMoney != nil {
+		set.byMethod[MethodMobileMoney] = mobileMoney
+	}
+	return set
+}
+
+// ForMethod returns the provider paying out by method
+func (s *ProviderSet) ForMethod(method string) (PayoutProvider, error) {
+	provider, ok := s.byMethod[method]
+	if !ok {
+		return nil, NewPayoutError(ErrNoProviderForMethod, "No payout provider is configured for "+method, nil)
+	}
+	return provider, nil
+}
+
+// ByName returns the provider a payout was sent to
+func (s *ProviderSet) ByName(name string) (PayoutProvider, error) {
+	for _, provider := range s.byMethod {
+		if provider.Name() == name {
+			return provider, nil
+		}
+	}
+	return nil, NewPayoutError(ErrNoProviderForMethod, "Payout provider "+name+" is not configured", nil)
+}
+
+// SettleLock makes sure only one server processes unsettled payouts at a time
+type SettleLock interface {
+	// TryRun runs fn while holding the lock. It returns false without running fn
+	// when another process holds the lock.
+	TryRun(fn func() error) (bool, error)
+}
+
+// PayoutError represents domain-specific errors for the payout domain
+type PayoutError struct {
+	Code    string
+	Message string
+	Err     error
+}
+
+// Error codes for the payout domain
+const (
+	ErrPayoutNotFound      = "PAYOUT_NOT_FOUND"
+	ErrWinnerNotPayable    = "WINNER_NOT_PAYABLE"
+	ErrPayoutInProgress    = "PAYOUT_IN_PROGRESS"
+	ErrPayoutAlreadyPaid   = "PAYOUT_ALREADY_PAID"
+	ErrNoProviderForMethod = "NO_PAYOUT_PROVIDER"
+	ErrInvalidCallback     = "INVALID_PAYOUT_CALLBACK"
+)
+
+// Error implements the error interface
+func (e *PayoutError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap returns the wrapped error
+func (e *PayoutError) Unwrap() error {
+	return e.Err
+}
+
+// NewPayoutError creates a new PayoutError
+func NewPayoutError(code, message string, err error) *PayoutError {
+	return &PayoutError{
+		Code:    code,
+		Message: message,
+		Err:     err,
+	}
+}
@@ -0,0 +1,130 @@
+package payout
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// checkInterval is how long after an update an unsettled payout is checked with its provider
+const checkInterval = 2 * time.Minute
+
+// MethodFor returns the payout method of a prize: airtime up to airtimeMaxValue, mobile money above
+func MethodFor(amount, airtimeMaxValue float64) string {
+	if amount <= airtimeMaxValue {
+		return MethodAirtime
+	}
+	return MethodMobileMoney
+}
+
+// NewPayout creates a pending payout of a winner's prize
+func NewPayout(winnerID, drawID uuid.UUID, msisdn, method string, amount float64, provider string, requestedBy uuid.UUID, now time.Time) *Payout {
+	return &Payout{
+		ID:          uuid.New(),
+		WinnerID:    winnerID,
+		DrawID:      drawID,
+		MSISDN:      msisdn,
+		Method:      method,
+		Amount:      amount,
+		Currency:    "NGN",
+		Status:      StatusPending,
+		Provider:    provider,
+		NextCheckAt: now,
+		RequestedBy: requestedBy,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// StartAttempt begins a new attempt at the payout, giving it a fresh provider reference
+func (p *Payout) StartAttempt(requestedBy uuid.UUID, now time.Time) error {
+	switch p.Status {
+	case StatusSucceeded:
+		return NewPayoutError(ErrPayoutAlreadyPaid, "Prize has already been paid out", nil)
+	case StatusProcessing:
+		return NewPayoutError(ErrPayoutInProgress, "Payout is already with the provider", nil)
+	case StatusPending:
+		if p.ProviderReference != "" {
+			return NewPayoutError(ErrPayoutInProgress, "Payout is already being sent to the provider", nil)
+		}
+	}
+
+	p.Attempts++
+	p.Status = StatusPending
+	p.ProviderReference = fmt.Sprintf("%s-%d", p.ID, p.Attempts)
+	p.ProviderPayoutID = ""
+	p.FailureReason = ""
+	p.RequestedBy = requestedBy
+	p.NextCheckAt = now
+	p.UpdatedAt = now
+
+	return nil
+}
+
+// Request returns the provider request of the current attempt
+func (p *Payout) Request() PayoutRequest {
+	return PayoutRequest{
+		Reference: p.ProviderReference,
+		Method:    p.Method,
+		MSISDN:    p.MSISDN,
+		Amount:    p.Amount,
+		Currency:  p.Currency,
+	}
+}
+
+// Apply records a provider's report of the current attempt. It returns true when the report
+// settled the payout, succeeded or failed. Reports for settled payouts are ignored.
+func (p *Payout) Apply(result *ProviderResult, now time.Time) bool {
+	if p.Settled() {
+		return false
+	}
+
+	if result.ProviderPayoutID != "" {
+		p.ProviderPayoutID = result.ProviderPayoutID
+	}
+	p.UpdatedAt = now
+
+	switch result.Status {
+	case ProviderStatusSucceeded:
+		p.Status = StatusSucceeded
+		p.CompletedAt = &now
+		return true
+	case ProviderStatusFailed:
+		p.Status = StatusFailed
+		p.FailureReason = result.FailureReason
+		p.CompletedAt = &now
+		return true
+	}
+
+	p.Status = StatusProcessing
+	p.NextCheckAt = now.Add(checkInterval)
+	return false
+}
+
+// DeferCheck postpones the next provider check after the provider could not be reached
+func (p *Payout) DeferCheck(reason string, now time.Time) {
+	p.FailureReason = reason
+	p.NextCheckAt = now.Add(checkInterval)
+	p.UpdatedAt = now
+}
+
+// Settled reports whether the payout has an outcome
+func (p *Payout) Settled() bool {
+	return p.Status == StatusSucceeded || p.Status == StatusFailed
+}
+
+// ParseProviderStatus maps a provider's payout status to one of the ProviderStatus constants
+func ParseProviderStatus(status string) (string, error) {
+	switch strings.ToUpper(strings.TrimSpace(status)) {
+	case "SUCCESS", "SUCCESSFUL", "SUCCEEDED", "COMPLETED", "PAID":
+		return ProviderStatusSucceeded, nil
+	case "FAILED", "FAILURE", "REJECTED", "REVERSED", "CANCELLED":
+		return ProviderStatusFailed, nil
+	case "PENDING", "PROCESSING", "QUEUED", "ACCEPTED":
+		return ProviderStatusPending, nil
+	}
+
+	return "", NewPayoutError(ErrInvalidCallback, "Unknown payout status "+status, nil)
+}
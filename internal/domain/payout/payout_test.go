@@ -0,0 +1,52 @@
+package payout_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/payout"
+)
+
+func TestMethodFor(t *testing.T) {
+	assert.Equal(t, payout.MethodAirtime, payout.MethodFor(1000, 5000))
+	assert.Equal(t, payout.MethodAirtime, payout.MethodFor(5000, 5000))
+	assert.Equal(t, payout.MethodMobileMoney, payout.MethodFor(5000.01, 5000))
+}
+
+func TestPayout_AttemptsAndRetries(t *testing.T) {
+	now := time.Now()
+	admin := uuid.New()
+	p := payout.NewPayout(uuid.New(), uuid.New(), "2348031234567", payout.MethodMobileMoney, 100000, "mock", admin, now)
+
+	require.NoError(t, p.StartAttempt(admin, now))
+	firstReference := p.ProviderReference
+	assert.Equal(t, 1, p.Attempts)
+
+	// An attempt being sent cannot be started again
+	var payoutErr *payout.PayoutError
+	require.ErrorAs(t, p.StartAttempt(admin, now), &payoutErr)
+	assert.Equal(t, payout.ErrPayoutInProgress, payoutErr.Code)
+
+	assert.False(t, p.Apply(&payout.ProviderResult{ProviderPayoutID: "tx-1", Status: payout.ProviderStatusPending}, now))
+	assert.Equal(t, payout.StatusProcessing, p.Status)
+
+	assert.True(t, p.Apply(&payout.ProviderResult{Status: payout.ProviderStatusFailed, FailureReason: "wallet closed"}, now))
+	assert.Equal(t, payout.StatusFailed, p.Status)
+
+	// A failed payout is retried under a new reference
+	require.NoError(t, p.StartAttempt(admin, now))
+	assert.NotEqual(t, firstReference, p.ProviderReference)
+	assert.Empty(t, p.FailureReason)
+
+	assert.True(t, p.Apply(&payout.ProviderResult{Status: payout.ProviderStatusSucceeded}, now))
+	require.ErrorAs(t, p.StartAttempt(admin, now), &payoutErr)
+	assert.Equal(t, payout.ErrPayoutAlreadyPaid, payoutErr.Code)
+
+	// Late reports do not change a settled payout
+	assert.False(t, p.Apply(&payout.ProviderResult{Status: payout.ProviderStatusFailed}, now))
+	assert.Equal(t, payout.StatusSucceeded, p.Status)
+}
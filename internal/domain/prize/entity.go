@@ -6,6 +6,9 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/notification"
 )
 
 // PrizeStructure represents a prize structure entity in the domain
@@ -21,6 +24,7 @@ type PrizeStructure struct {
 	CreatedBy   uuid.UUID
 	UpdatedBy   uuid.UUID
 	Prizes      []PrizeTier
+	EligibilityRules draw.EligibilityRules
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
@@ -49,10 +53,25 @@ type PrizeTier struct {
 	ValueNGN          float64
 	Quantity          int
 	NumberOfRunnerUps int
+	ClaimWindowHours  int // Hours a winner has to claim the prize; 0 uses DefaultClaimWindowHours
+	NotificationTemplate string // SMS sent to the tier's winners; empty uses notification.DefaultWinnerTemplate
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
 }
 
+// DefaultClaimWindowHours is the claim window of tiers without one: an unreachable winner
+// forfeits the prize after 72 hours
+const DefaultClaimWindowHours = 72
+
+// ClaimWindow returns how long a winner of the tier has to claim the prize
+func (pt *PrizeTier) ClaimWindow() time.Duration {
+	hours := pt.ClaimWindowHours
+	if hours <= 0 {
+		hours = DefaultClaimWindowHours
+	}
+	return time.Duration(hours) * time.Hour
+}
+
 // PrizeRepository defines the interface for prize structure data access
 type PrizeRepository interface {
 	CreatePrizeStructure(prizeStructure *PrizeStructure) error
@@ -82,6 +101,8 @@ type CreatePrizeInput struct {
 	Value             float64
 	Quantity          int
 	NumberOfRunnerUps int
+	ClaimWindowHours  int
+	NotificationTemplate string
 }
 
 // DeletePrizeStructureInput represents the input for deleting a prize structure
@@ -181,9 +202,31 @@ func ValidatePrizeTier(pt *PrizeTier) error {
 		return errors.New("prize tier quantity must be positive")
 	}
 	
+	if pt.NumberOfRunnerUps < 0 {
+		return errors.New("prize tier number of runner-ups cannot be negative")
+	}
+	
+	if pt.ClaimWindowHours < 0 {
+		return errors.New("prize tier claim window cannot be negative")
+	}
+	
+	if err := notification.ValidateTemplate(pt.NotificationTemplate); err != nil {
+		return err
+	}
+	
 	return nil
 }
 
+// DefaultNumberOfRunnerUps is the runner-up count of tiers saved before the count was
+// configurable: half the quantity, with a minimum of 1
+func DefaultNumberOfRunnerUps(quantity int) int {
+	runnerUps := quantity / 2
+	if runnerUps < 1 {
+		runnerUps = 1
+	}
+	return runnerUps
+}
+
 // DeletePrizeStructureService defines the service for deleting prize structures
 type DeletePrizeStructureService interface {
 	DeletePrizeStructure(ctx context.Context, input DeletePrizeStructureInput) error
@@ -179,6 +179,8 @@ type UpdatePrizeInput struct {
 	Value             float64
 	Quantity          int
 	NumberOfRunnerUps int
+	ClaimWindowHours  int
+	NotificationTemplate string
 }
 
 // UpdatePrizeStructureInput represents the input for updating a prize structure
@@ -0,0 +1,87 @@
+package report
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CertificateWinner is a prize winner listed on a winners certificate
+type CertificateWinner struct {
+	PrizeTierName string
+	PrizeValue    float64
+	MSISDN        string // Masked
+	Status        string
+}
+
+// Certificate attests the outcome of a completed draw: how it was drawn and who holds its prizes
+type Certificate struct {
+	DrawID               uuid.UUID
+	DrawDate             time.Time
+	AlgorithmVersion     string
+	SeedHash             string
+	EntriesHash          string
+	TotalEligibleMSISDNs int
+	TotalEntries         int
+	Winners              []CertificateWinner // In prize tier order
+	IssuedAt             time.Time
+}
+
+// Lines returns the text of the certificate. The signature covers exactly these lines joined
+// by newlines, so a certificate can be verified from its printed text and the public key.
+func (c Certificate) Lines() []string {
+	lines := []string{
+		"Draw ID: " + c.DrawID.String(),
+		"Draw date: " + c.DrawDate.Format("2006-01-02"),
+		"Algorithm: " + c.AlgorithmVersion,
+		"Seed hash: " + c.SeedHash,
+		"Entries hash: " + c.EntriesHash,
+		"Eligible MSISDNs: " + strconv.Itoa(c.TotalEligibleMSISDNs),
+		"Total entries: " + strconv.Itoa(c.TotalEntries),
+		"Issued at: " + c.IssuedAt.UTC().Format(time.RFC3339),
+		"Winners: " + strconv.Itoa(len(c.Winners)),
+	}
+	for i, w := range c.Winners {
+		lines = append(lines, fmt.Sprintf("%d. %s (%s) - %s - %s", i+1, w.PrizeTierName, strconv.FormatFloat(w.PrizeValue, 'f', 2, 64), w.MSISDN, w.Status))
+	}
+	return lines
+}
+
+// Payload returns the bytes the certificate signature covers
+func (c Certificate) Payload() []byte {
+	return []byte(strings.Join(c.Lines(), "\n"))
+}
+
+// Sign returns the Ed25519 signature of the certificate
+func (c Certificate) Sign(key ed25519.PrivateKey) []byte {
+	return ed25519.Sign(key, c.Payload())
+}
+
+// VerifyCertificate reports whether signature is a valid signature of the certificate text
+func VerifyCertificate(publicKey ed25519.PublicKey, lines []string, signature []byte) bool {
+	return ed25519.Verify(publicKey, []byte(strings.Join(lines, "\n")), signature)
+}
+
+// ParseSigningKey parses a hex encoded 32 byte Ed25519 seed into a certificate signing key
+func ParseSigningKey(seed string) (ed25519.PrivateKey, error) {
+	b, err := hex.DecodeString(strings.TrimSpace(seed))
+	if err != nil {
+		return nil, fmt.Errorf("signing key must be hex encoded: %w", err)
+	}
+	if len(b) != ed25519.SeedSize {
+		return nil, fmt.Errorf("signing key must be %d bytes, got %d", ed25519.SeedSize, len(b))
+	}
+	return ed25519.NewKeyFromSeed(b), nil
+}
+
+// KeyID returns a short fingerprint of a public key, printed on certificates to identify the signing key
+func KeyID(publicKey ed25519.PublicKey) string {
+	sum := sha256.Sum256(publicKey)
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,48 @@
+package report_test
+
+import (
+	"crypto/ed25519"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/report"
+)
+
+func TestCertificate_SignAndVerify(t *testing.T) {
+	key, err := report.ParseSigningKey(strings.Repeat("ab", ed25519.SeedSize))
+	require.NoError(t, err)
+
+	certificate := report.Certificate{
+		DrawID:      uuid.New(),
+		DrawDate:    time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC),
+		SeedHash:    "seed-hash",
+		EntriesHash: "entries-hash",
+		Winners: []report.CertificateWinner{
+			{PrizeTierName: "Jackpot", PrizeValue: 1000000, MSISDN: "234****567", Status: "Confirmed"},
+		},
+		IssuedAt: time.Now(),
+	}
+	signature := certificate.Sign(key)
+	publicKey := key.Public().(ed25519.PublicKey)
+
+	lines := certificate.Lines()
+	assert.Contains(t, lines, "1. Jackpot (1000000.00) - 234****567 - Confirmed")
+	assert.True(t, report.VerifyCertificate(publicKey, lines, signature))
+
+	// Changing any printed line breaks the signature
+	lines[len(lines)-1] = "1. Jackpot (1000000.00) - 234****999 - Confirmed"
+	assert.False(t, report.VerifyCertificate(publicKey, lines, signature))
+}
+
+func TestParseSigningKey_Invalid(t *testing.T) {
+	_, err := report.ParseSigningKey("not-hex")
+	assert.Error(t, err)
+
+	_, err = report.ParseSigningKey("abcd")
+	assert.Error(t, err)
+}
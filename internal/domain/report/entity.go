@@ -0,0 +1,126 @@
+package report
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Filters selects what a report covers. Winner and prize liability reports are
+// dated by draw date, the payment ledger by the date of each payment step.
+type Filters struct {
+	DrawID    uuid.UUID
+	StartDate time.Time // Inclusive day; zero is unbounded
+	EndDate   time.Time // Inclusive day; zero is unbounded
+}
+
+// WinnerRow is one winner or runner-up of a completed draw
+type WinnerRow struct {
+	WinnerID         uuid.UUID
+	DrawID           uuid.UUID
+	DrawDate         time.Time
+	MSISDN           string
+	PrizeTierName    string
+	PrizeTierRank    int
+	PrizeValue       float64
+	Status           string
+	PaymentStatus    string
+	PaymentReference string
+	IsRunnerUp       bool
+	RunnerUpRank     int
+	ClaimDeadline    *time.Time
+	ClaimedAt        *time.Time
+	PaidAt           *time.Time
+}
+
+// PaymentLedgerRow is one step taken to pay a winner's prize
+type PaymentLedgerRow struct {
+	EventID       uuid.UUID
+	WinnerID      uuid.UUID
+	DrawID        uuid.UUID
+	DrawDate      time.Time
+	MSISDN        string
+	PrizeTierName string
+	Amount        float64
+	FromStatus    string
+	ToStatus      string
+	ActorID       uuid.UUID // uuid.Nil for steps taken by the system
+	Reference     string
+	Notes         string
+	At            time.Time
+}
+
+// PrizeLiabilityRow sums the prizes of one tier of a completed draw by how far their payment has got.
+// Only prizes still held count: forfeited and replaced winners and waiting runner-ups owe nothing.
+type PrizeLiabilityRow struct {
+	DrawID        uuid.UUID
+	DrawDate      time.Time
+	PrizeTierName string
+	PrizeTierRank int
+	PrizeValue    float64
+	Winners       int
+	Paid          int // Paid prizes
+	InPayment     int // Approved prizes and prizes with a payout in progress
+}
+
+// Outstanding returns the number of prizes not yet approved for payment, including failed payments
+func (r PrizeLiabilityRow) Outstanding() int {
+	return r.Winners - r.Paid - r.InPayment
+}
+
+// Liability returns the value of the prizes not yet paid
+func (r PrizeLiabilityRow) Liability() float64 {
+	return float64(r.Winners-r.Paid) * r.PrizeValue
+}
+
+// ReportRepository defines the read-only queries behind the reports. Rows are streamed
+// to fn in report order; an error returned by fn stops the query and is returned.
+type ReportRepository interface {
+	StreamWinners(filters Filters, fn func(WinnerRow) error) error
+	StreamPaymentLedger(filters Filters, fn func(PaymentLedgerRow) error) error
+	ListPrizeLiability(filters Filters) ([]PrizeLiabilityRow, error)
+}
+
+// ReportError represents domain-specific errors for the report domain
+type ReportError struct {
+	Code    string
+	Message string
+	Err     error
+}
+
+// Error codes for the report domain
+const (
+	ErrInvalidFilters     = "INVALID_REPORT_FILTERS"
+	ErrSigningKeyMissing  = "CERTIFICATE_SIGNING_KEY_MISSING"
+	ErrDrawNotCertifiable = "DRAW_NOT_CERTIFIABLE"
+)
+
+// Error implements the error interface
+func (e *ReportError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap returns the wrapped error
+func (e *ReportError) Unwrap() error {
+	return e.Err
+}
+
+// NewReportError creates a new ReportError
+func NewReportError(code, message string, err error) *ReportError {
+	return &ReportError{
+		Code:    code,
+		Message: message,
+		Err:     err,
+	}
+}
+
+// Validate checks that the date range of the filters is not reversed
+func (f Filters) Validate() error {
+	if !f.StartDate.IsZero() && !f.EndDate.IsZero() && f.EndDate.Before(f.StartDate) {
+		return NewReportError(ErrInvalidFilters, "End date must not be before start date", nil)
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package schedule
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/cron"
+)
+
+// SystemActorID identifies the scheduler as the executor of scheduled draws and in audit logs
+var SystemActorID = audit.SystemActorID
+
+// RunLockKey is the Postgres advisory lock key held while due schedules are run
+const RunLockKey int64 = 724_301_001
+
+// Outcomes of a scheduled run
+const (
+	RunStatusSucceeded = "Succeeded"
+	RunStatusSkipped   = "Skipped" // A draw already existed for the date, such as one scheduled by hand
+	RunStatusFailed    = "Failed"
+)
+
+// DrawSchedule runs a draw automatically whenever its cron expression fires
+type DrawSchedule struct {
+	ID               uuid.UUID
+	Name             string
+	CronExpression   string
+	TimeZone         string    // IANA name; the draw date is the fire date in this zone
+	PrizeStructureID uuid.UUID // uuid.Nil uses the prize structure active on the draw date
+	Enabled          bool
+	LastRunAt        *time.Time // Fire time of the last run attempted, successful or not
+	LastRunStatus    string
+	LastRunError     string
+	LastDrawID       uuid.UUID
+	CreatedBy        uuid.UUID
+	UpdatedBy        uuid.UUID
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// Location returns the schedule's time zone
+func (s *DrawSchedule) Location() (*time.Location, error) {
+	return time.LoadLocation(s.TimeZone)
+}
+
+// NextRun returns the first fire time after t
+func (s *DrawSchedule) NextRun(t time.Time) (time.Time, error) {
+	expr, err := cron.Parse(s.CronExpression)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	loc, err := s.Location()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return expr.Next(t.In(loc)), nil
+}
+
+// DueRuns returns the fire times after the last run (or creation) up to now, oldest first.
+// When more than maxRuns were missed only the latest maxRuns are returned.
+func (s *DrawSchedule) DueRuns(now time.Time, maxRuns int) ([]time.Time, error) {
+	expr, err := cron.Parse(s.CronExpression)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := s.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	from := s.CreatedAt
+	if s.LastRunAt != nil {
+		from = *s.LastRunAt
+	}
+
+	runs := make([]time.Time, 0)
+	for next := expr.Next(from.In(loc)); !next.IsZero() && !next.After(now); next = expr.Next(next) {
+		runs = append(runs, next)
+		if maxRuns > 0 && len(runs) > maxRuns {
+			runs = runs[1:]
+		}
+	}
+
+	return runs, nil
+}
+
+// SharesDrawDates reports whether the schedule and other can fire on the same draw date. As a
+// date has only one draw, the later of the two runs would be skipped. Draw dates are calendar
+// dates in each schedule's own time zone, so the zones do not change the answer.
+func (s *DrawSchedule) SharesDrawDates(other *DrawSchedule) (bool, error) {
+	expr, err := cron.Parse(s.CronExpression)
+	if err != nil {
+		return false, err
+	}
+
+	otherExpr, err := cron.Parse(other.CronExpression)
+	if err != nil {
+		return false, err
+	}
+
+	return expr.SharesDay(otherExpr), nil
+}
+
+// DrawDate returns the draw date for a fire time: its calendar date in the schedule's time zone
+func DrawDate(runAt time.Time, loc *time.Location) time.Time {
+	local := runAt.In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// DrawScheduleRepository defines the interface for draw schedule data access
+type DrawScheduleRepository interface {
+	Create(schedule *DrawSchedule) error
+	GetByID(id uuid.UUID) (*DrawSchedule, error)
+	List() ([]DrawSchedule, error)
+	ListEnabled() ([]DrawSchedule, error)
+	Update(schedule *DrawSchedule) error
+	Delete(id uuid.UUID) error
+}
+
+// RunLock makes sure only one server runs due schedules at a time
+type RunLock interface {
+	// TryRun runs fn while holding the lock. It returns false without running fn
+	// when another process holds the lock.
+	TryRun(fn func() error) (bool, error)
+}
+
+// ScheduleError represents domain-specific errors for the schedule domain
+type ScheduleError struct {
+	Code    string
+	Message string
+	Err     error
+}
+
+// Error codes for the schedule domain
+const (
+	ErrScheduleNotFound = "DRAW_SCHEDULE_NOT_FOUND"
+	ErrInvalidSchedule  = "INVALID_DRAW_SCHEDULE"
+	ErrScheduleOverlap  = "DRAW_SCHEDULE_OVERLAP"
+)
+
+// Error implements the error interface
+func (e *ScheduleError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap returns the wrapped error
+func (e *ScheduleError) Unwrap() error {
+	return e.Err
+}
+
+// NewScheduleError creates a new ScheduleError
+func NewScheduleError(code, message string, err error) *ScheduleError {
+	return &ScheduleError{
+		Code:    code,
+		Message: message,
+		Err:     err,
+	}
+}
+
+// ValidateSchedule validates a schedule's name, cron expression and time zone
+func ValidateSchedule(name, cronExpression, timeZone string) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("name is required")
+	}
+
+	if _, err := cron.Parse(cronExpression); err != nil {
+		return errors.New("invalid cron expression: " + err.Error())
+	}
+
+	if _, err := time.LoadLocation(timeZone); err != nil {
+		return errors.New("invalid time zone: " + timeZone)
+	}
+
+	return nil
+}
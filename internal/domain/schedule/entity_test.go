@@ -0,0 +1,58 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/schedule"
+)
+
+func TestDrawSchedule_DueRunsCatchesUpMissedRuns(t *testing.T) {
+	lagos, err := time.LoadLocation("Africa/Lagos")
+	require.NoError(t, err)
+
+	lastRun := time.Date(2026, 3, 1, 20, 0, 0, 0, lagos)
+	s := &schedule.DrawSchedule{
+		CronExpression: "0 20 * * *",
+		TimeZone:       "Africa/Lagos",
+		LastRunAt:      &lastRun,
+	}
+	now := time.Date(2026, 3, 4, 21, 0, 0, 0, lagos)
+
+	runs, err := s.DueRuns(now, 0)
+	require.NoError(t, err)
+	require.Len(t, runs, 3)
+	assert.Equal(t, time.Date(2026, 3, 2, 20, 0, 0, 0, lagos), runs[0])
+	assert.Equal(t, time.Date(2026, 3, 4, 20, 0, 0, 0, lagos), runs[2])
+
+	// Only the latest runs are kept when more were missed than allowed
+	runs, err = s.DueRuns(now, 2)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, time.Date(2026, 3, 3, 20, 0, 0, 0, lagos), runs[0])
+}
+
+func TestDrawSchedule_DueRunsStartsAfterCreation(t *testing.T) {
+	s := &schedule.DrawSchedule{
+		CronExpression: "@daily",
+		TimeZone:       "UTC",
+		CreatedAt:      time.Date(2026, 3, 4, 9, 0, 0, 0, time.UTC),
+	}
+
+	runs, err := s.DueRuns(time.Date(2026, 3, 4, 23, 59, 0, 0, time.UTC), 7)
+	require.NoError(t, err)
+	assert.Empty(t, runs)
+}
+
+func TestDrawDate_UsesScheduleTimeZone(t *testing.T) {
+	lagos, err := time.LoadLocation("Africa/Lagos")
+	require.NoError(t, err)
+
+	// 23:30 UTC on 3 March is 00:30 on 4 March in Lagos
+	runAt := time.Date(2026, 3, 3, 23, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC), schedule.DrawDate(runAt, lagos))
+}
@@ -13,10 +13,11 @@ type User struct {
 	Email        string
 	Username     string
 	FullName     string
-	Role         string // "SuperAdmin", "Admin", "SeniorUser", "WinnersReportUser", "AllReportUser"
+	Role         string // One of the Role constants
 	PasswordHash string // Hashed password
 	LastLogin    *time.Time
 	IsActive     bool
+	TokenVersion int // Tokens carrying an older version are rejected
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
@@ -48,6 +49,7 @@ const (
 	ErrInvalidEmail      = "INVALID_EMAIL"
 	ErrInvalidPassword   = "INVALID_PASSWORD"
 	ErrInvalidRole       = "INVALID_ROLE"
+	ErrInsufficientRole  = "INSUFFICIENT_ROLE"
 )
 
 // Error implements the error interface
@@ -86,16 +88,8 @@ func ValidateUser(user *User) error {
 		return errors.New("role cannot be empty")
 	}
 	
-	// Validate role is one of the allowed values
-	validRoles := map[string]bool{
-		"SuperAdmin":       true,
-		"Admin":            true,
-		"SeniorUser":       true,
-		"WinnersReportUser": true,
-		"AllReportUser":    true,
-	}
-	
-	if !validRoles[user.Role] {
+	// Validate role is one of the canonical roles
+	if !IsValidRole(user.Role) {
 		return errors.New("invalid role")
 	}
 	
@@ -112,3 +106,9 @@ func ValidatePassword(password string) error {
 	
 	return nil
 }
+
+// RevokeTokens invalidates every token issued to the user so far. It is called when the user
+// is deactivated, changes role or has their password reset.
+func (u *User) RevokeTokens() {
+	u.TokenVersion++
+}
@@ -0,0 +1,171 @@
+package user
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Roles a user can hold. These are the only role strings stored on users and issued in tokens.
+const (
+	RoleSuperAdmin        = "SuperAdmin"
+	RoleAdmin             = "Admin"
+	RoleSeniorUser        = "SeniorUser"
+	RoleWinnersReportUser = "WinnersReportUser" // Winners reports only
+	RoleAllReportUser     = "AllReportUser"
+)
+
+// Roles lists every role, most privileged first
+var Roles = []string{
+	RoleSuperAdmin,
+	RoleAdmin,
+	RoleSeniorUser,
+	RoleWinnersReportUser,
+	RoleAllReportUser,
+}
+
+// Permissions checked on routes and in use cases
+const (
+	PermDrawRead     = "draw:read"
+	PermDrawExecute  = "draw:execute"
+	PermDrawSimulate = "draw:simulate"
+	PermDrawSchedule = "draw:schedule" // Scheduling single draws and managing recurring draw schedules
+	PermDrawVoid     = "draw:void"
+
+	PermWinnerRead   = "winner:read"
+	PermWinnerManage = "winner:manage" // Confirming claims and invoking runner-ups
+	PermWinnerPay    = "winner:pay"    // Moving payments along, payouts and reconciliation
+
+	PermPrizeRead   = "prize:read"
+	PermPrizeManage = "prize:manage"
+	PermPrizeDelete = "prize:delete"
+
+	PermParticipantRead   = "participant:read"
+	PermParticipantUpload = "participant:upload"
+	PermParticipantDelete = "participant:delete"
+
+	PermBlacklistRead   = "blacklist:read"
+	PermBlacklistManage = "blacklist:manage"
+
+	PermReportWinners = "report:winners" // Winners report and winners certificates
+	PermReportFinance = "report:finance" // Payment ledger and prize liability
+	PermReportUploads = "report:uploads" // Data upload audits
+
+	PermUserManage        = "user:manage"
+	PermUserResetPassword = "user:reset-password"
+	PermUserUnlock        = "user:unlock" // Lifting login lockouts
+)
+
+// readPermissions are the permissions to view the promotion without changing it
+var readPermissions = []string{
+	PermDrawRead,
+	PermWinnerRead,
+	PermPrizeRead,
+	PermParticipantRead,
+	PermBlacklistRead,
+}
+
+// rolePermissions is the permission matrix: what each role may do
+var rolePermissions = map[string][]string{
+	RoleSuperAdmin: append(append([]string{}, readPermissions...),
+		PermDrawExecute, PermDrawSimulate, PermDrawSchedule, PermDrawVoid,
+		PermWinnerManage, PermWinnerPay,
+		PermPrizeManage, PermPrizeDelete,
+		PermParticipantUpload, PermParticipantDelete,
+		PermBlacklistManage,
+		PermReportWinners, PermReportFinance, PermReportUploads,
+		PermUserManage, PermUserResetPassword, PermUserUnlock,
+	),
+	RoleAdmin: append(append([]string{}, readPermissions...),
+		PermDrawSimulate,
+		PermWinnerManage, PermWinnerPay,
+		PermPrizeManage,
+		PermParticipantUpload, PermParticipantDelete,
+		PermBlacklistManage,
+		PermReportWinners, PermReportFinance, PermReportUploads,
+		PermUserResetPassword, PermUserUnlock,
+	),
+	RoleSeniorUser: append(append([]string{}, readPermissions...),
+		PermParticipantUpload,
+		PermReportWinners, PermReportUploads,
+	),
+	RoleWinnersReportUser: {PermReportWinners},
+	RoleAllReportUser:     {PermReportWinners, PermReportFinance, PermReportUploads},
+}
+
+// roleRanks orders the roles by privilege; report-only roles rank alike
+var roleRanks = map[string]int{
+	RoleSuperAdmin:        4,
+	RoleAdmin:             3,
+	RoleSeniorUser:        2,
+	RoleWinnersReportUser: 1,
+	RoleAllReportUser:     1,
+}
+
+// NormalizeRole maps a role string to its canonical form, accepting the legacy spellings
+// found in older tokens and user rows such as "super_admin" or "SUPER_ADMIN".
+// It returns false if role is not one of the defined roles.
+func NormalizeRole(role string) (string, bool) {
+	key := roleKey(role)
+	for _, r := range Roles {
+		if roleKey(r) == key {
+			return r, true
+		}
+	}
+	return "", false
+}
+
+// roleKey reduces a role to its lowercase letters, so spellings differing in case and separators match
+func roleKey(role string) string {
+	return strings.Map(func(r rune) rune {
+		if !unicode.IsLetter(r) {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, role)
+}
+
+// IsValidRole reports whether role is one of the canonical roles
+func IsValidRole(role string) bool {
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+// Permissions returns the permissions of a role, or nil for an unknown role
+func Permissions(role string) []string {
+	canonical, ok := NormalizeRole(role)
+	if !ok {
+		return nil
+	}
+	return append([]string{}, rolePermissions[canonical]...)
+}
+
+// HasPermission reports whether role grants permission. Legacy role spellings are accepted.
+func HasPermission(role, permission string) bool {
+	for _, p := range Permissions(role) {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// IsReportOnlyRole reports whether role is a report-only role, whose reports always mask MSISDNs
+func IsReportOnlyRole(role string) bool {
+	canonical, _ := NormalizeRole(role)
+	return canonical == RoleWinnersReportUser || canonical == RoleAllReportUser
+}
+
+// CanManageRole reports whether a user with actorRole may take over the account of a user with
+// targetRole, as resetting their password or second factor does. Super admins may manage anyone;
+// other roles only less privileged ones, so an admin cannot take over a super admin or another admin.
+func CanManageRole(actorRole, targetRole string) bool {
+	actor, ok := NormalizeRole(actorRole)
+	if !ok {
+		return false
+	}
+	if actor == RoleSuperAdmin {
+		return true
+	}
+	target, _ := NormalizeRole(targetRole)
+	return roleRanks[actor] > roleRanks[target]
+}
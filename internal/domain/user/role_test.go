@@ -0,0 +1,91 @@
+package user_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/user"
+)
+
+func TestNormalizeRole(t *testing.T) {
+	tests := []struct {
+		role     string
+		expected string
+		valid    bool
+	}{
+		{"SuperAdmin", user.RoleSuperAdmin, true},
+		{"super_admin", user.RoleSuperAdmin, true},
+		{"SUPER_ADMIN", user.RoleSuperAdmin, true},
+		{"admin", user.RoleAdmin, true},
+		{"ADMIN", user.RoleAdmin, true},
+		{"senior_user", user.RoleSeniorUser, true},
+		{"winners_report_user", user.RoleWinnersReportUser, true},
+		{"AllReportUser", user.RoleAllReportUser, true},
+		{"", "", false},
+		{"root", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role, func(t *testing.T) {
+			role, ok := user.NormalizeRole(tt.role)
+			assert.Equal(t, tt.valid, ok)
+			assert.Equal(t, tt.expected, role)
+		})
+	}
+}
+
+func TestIsValidRoleRejectsLegacySpellings(t *testing.T) {
+	for _, role := range user.Roles {
+		assert.True(t, user.IsValidRole(role), role)
+	}
+	assert.False(t, user.IsValidRole("super_admin"))
+	assert.False(t, user.IsValidRole("admin"))
+}
+
+func TestHasPermission(t *testing.T) {
+	// Only a super admin runs, schedules and voids draws
+	assert.True(t, user.HasPermission(user.RoleSuperAdmin, user.PermDrawExecute))
+	assert.True(t, user.HasPermission("super_admin", user.PermDrawVoid))
+	assert.False(t, user.HasPermission(user.RoleAdmin, user.PermDrawExecute))
+	assert.False(t, user.HasPermission(user.RoleAdmin, user.PermDrawVoid))
+
+	// Admins pay winners, senior users do not
+	assert.True(t, user.HasPermission(user.RoleAdmin, user.PermWinnerPay))
+	assert.False(t, user.HasPermission(user.RoleSeniorUser, user.PermWinnerPay))
+	assert.True(t, user.HasPermission(user.RoleSeniorUser, user.PermParticipantUpload))
+
+	// Report-only roles see their reports and nothing else
+	assert.True(t, user.HasPermission(user.RoleWinnersReportUser, user.PermReportWinners))
+	assert.False(t, user.HasPermission(user.RoleWinnersReportUser, user.PermReportFinance))
+	assert.False(t, user.HasPermission(user.RoleWinnersReportUser, user.PermWinnerRead))
+	assert.True(t, user.HasPermission(user.RoleAllReportUser, user.PermReportFinance))
+	assert.False(t, user.HasPermission(user.RoleAllReportUser, user.PermDrawRead))
+
+	assert.False(t, user.HasPermission("", user.PermDrawRead))
+	assert.False(t, user.HasPermission("root", user.PermDrawRead))
+}
+
+func TestIsReportOnlyRole(t *testing.T) {
+	assert.True(t, user.IsReportOnlyRole(user.RoleWinnersReportUser))
+	assert.True(t, user.IsReportOnlyRole("all_report_user"))
+	assert.False(t, user.IsReportOnlyRole(user.RoleAdmin))
+}
+
+func TestCanManageRole(t *testing.T) {
+	// Admins cannot take over a super admin, nor each other
+	assert.False(t, user.CanManageRole(user.RoleAdmin, user.RoleSuperAdmin))
+	assert.False(t, user.CanManageRole("admin", "super_admin"))
+	assert.False(t, user.CanManageRole(user.RoleAdmin, user.RoleAdmin))
+	assert.True(t, user.CanManageRole(user.RoleAdmin, user.RoleSeniorUser))
+	assert.True(t, user.CanManageRole(user.RoleAdmin, user.RoleAllReportUser))
+
+	// Super admins manage everyone, including other super admins
+	for _, role := range user.Roles {
+		assert.True(t, user.CanManageRole(user.RoleSuperAdmin, role), role)
+	}
+
+	assert.False(t, user.CanManageRole(user.RoleSeniorUser, user.RoleSeniorUser))
+	assert.False(t, user.CanManageRole(user.RoleWinnersReportUser, user.RoleAllReportUser))
+	assert.False(t, user.CanManageRole("root", user.RoleSeniorUser))
+}
@@ -39,7 +39,7 @@ func (s *AuthenticateUserService) AuthenticateUser(ctx context.Context, input Au
 		ID:        uuid.New(),
 		Username:  "username",
 		Email:     input.Email,
-		Role:      "admin",
+		Role:      RoleAdmin,
 		IsActive:  true,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
@@ -149,7 +149,7 @@ func (s *GetUserService) GetUser(ctx context.Context, input GetUserInput) (*GetU
 		ID:        input.ID,
 		Username:  "username",
 		Email:     "email@example.com",
-		Role:      "admin",
+		Role:      RoleAdmin,
 		IsActive:  true,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
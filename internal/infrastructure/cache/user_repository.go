@@ -0,0 +1,107 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/user"
+)
+
+// UserRepository wraps a user.UserRepository, caching users looked up by ID for a short time.
+// Writes through this repository, or through ForWrites, evict the user at once; writes made by
+// other replicas are seen once the cached entry expires. It is meant for read-only lookups such
+// as token checks: code that reads a user to write it back must use ForWrites, or it may write
+// back a stale copy over newer changes.
+type UserRepository struct {
+	user.UserRepository
+	ttl   time.Duration
+	mu    sync.Mutex
+	users map[uuid.UUID]cachedUser
+}
+
+// cachedUser is a cached copy of a user
+type cachedUser struct {
+	user      user.User
+	expiresAt time.Time
+}
+
+// NewUserRepository creates a UserRepository caching users of repository for ttl
+func NewUserRepository(repository user.UserRepository, ttl time.Duration) *UserRepository {
+	return &UserRepository{
+		UserRepository: repository,
+		ttl:            ttl,
+		users:          make(map[uuid.UUID]cachedUser),
+	}
+}
+
+// GetByID implements the user.UserRepository interface, serving the user from the cache
+// while the cached copy is fresh
+func (r *UserRepository) GetByID(id uuid.UUID) (*user.User, error) {
+	now := time.Now()
+
+	r.mu.Lock()
+	cached, ok := r.users[id]
+	r.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		u := cached.user
+		return &u, nil
+	}
+
+	u, err := r.UserRepository.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.users[id] = cachedUser{user: *u, expiresAt: now.Add(r.ttl)}
+	r.mu.Unlock()
+
+	return u, nil
+}
+
+// Update implements the user.UserRepository interface
+func (r *UserRepository) Update(u *user.User) error {
+	r.evict(u.ID)
+	err := r.UserRepository.Update(u)
+	r.evict(u.ID)
+	return err
+}
+
+// Delete implements the user.UserRepository interface
+func (r *UserRepository) Delete(id uuid.UUID) error {
+	r.evict(id)
+	err := r.UserRepository.Delete(id)
+	r.evict(id)
+	return err
+}
+
+// evict drops a user from the cache
+func (r *UserRepository) evict(id uuid.UUID) {
+	r.mu.Lock()
+	delete(r.users, id)
+	r.mu.Unlock()
+}
+
+// ForWrites returns a user.UserRepository for services that read users to write them back. It
+// reads from the wrapped repository, bypassing the cache, and evicts the users it writes.
+func (r *UserRepository) ForWrites() user.UserRepository {
+	return writeThroughUserRepository{UserRepository: r.UserRepository, cache: r}
+}
+
+// writeThroughUserRepository reads users uncached and evicts them from the cache on writes
+type writeThroughUserRepository struct {
+	user.UserRepository
+	cache *UserRepository
+}
+
+// Update implements the user.UserRepository interface
+func (r writeThroughUserRepository) Update(u *user.User) error {
+	return r.cache.Update(u)
+}
+
+// Delete implements the user.UserRepository interface
+func (r writeThroughUserRepository) Delete(id uuid.UUID) error {
+	return r.cache.Delete(id)
+}
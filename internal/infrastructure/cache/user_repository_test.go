@@ -0,0 +1,127 @@
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/user"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/infrastructure/cache"
+)
+
+// fakeUserRepository counts lookups of an in-memory set of users
+type fakeUserRepository struct {
+	user.UserRepository
+	users   map[uuid.UUID]user.User
+	lookups int
+}
+
+func (r *fakeUserRepository) GetByID(id uuid.UUID) (*user.User, error) {
+	r.lookups++
+	u, ok := r.users[id]
+	if !ok {
+		return nil, user.NewUserError(user.ErrUserNotFound, "User not found", nil)
+	}
+	return &u, nil
+}
+
+func (r *fakeUserRepository) Update(u *user.User) error {
+	r.users[u.ID] = *u
+	return nil
+}
+
+func newFakeUserRepository(u user.User) *fakeUserRepository {
+	return &fakeUserRepository{users: map[uuid.UUID]user.User{u.ID: u}}
+}
+
+func TestUserRepositoryCachesLookups(t *testing.T) {
+	u := user.User{ID: uuid.New(), Role: user.RoleAdmin, IsActive: true}
+	repository := newFakeUserRepository(u)
+	cached := cache.NewUserRepository(repository, time.Minute)
+
+	for i := 0; i < 3; i++ {
+		got, err := cached.GetByID(u.ID)
+		require.NoError(t, err)
+		assert.Equal(t, user.RoleAdmin, got.Role)
+	}
+	assert.Equal(t, 1, repository.lookups)
+
+	// Callers changing the returned user do not change the cached copy
+	got, err := cached.GetByID(u.ID)
+	require.NoError(t, err)
+	got.Role = user.RoleSuperAdmin
+	got, err = cached.GetByID(u.ID)
+	require.NoError(t, err)
+	assert.Equal(t, user.RoleAdmin, got.Role)
+}
+
+func TestUserRepositoryEvictsOnUpdate(t *testing.T) {
+	u := user.User{ID: uuid.New(), Role: user.RoleAdmin, IsActive: true}
+	repository := newFakeUserRepository(u)
+	cached := cache.NewUserRepository(repository, time.Minute)
+
+	_, err := cached.GetByID(u.ID)
+	require.NoError(t, err)
+
+	u.IsActive = false
+	u.RevokeTokens()
+	require.NoError(t, cached.Update(&u))
+
+	got, err := cached.GetByID(u.ID)
+	require.NoError(t, err)
+	assert.False(t, got.IsActive)
+	assert.Equal(t, 1, got.TokenVersion)
+	assert.Equal(t, 2, repository.lookups)
+}
+
+func TestUserRepositoryExpiresEntries(t *testing.T) {
+	u := user.User{ID: uuid.New(), IsActive: true}
+	repository := newFakeUserRepository(u)
+	cached := cache.NewUserRepository(repository, 0)
+
+	_, err := cached.GetByID(u.ID)
+	require.NoError(t, err)
+	_, err = cached.GetByID(u.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, repository.lookups)
+}
+
+func TestUserRepositoryDoesNotCacheErrors(t *testing.T) {
+	repository := newFakeUserRepository(user.User{ID: uuid.New()})
+	cached := cache.NewUserRepository(repository, time.Minute)
+
+	missing := uuid.New()
+	_, err := cached.GetByID(missing)
+	assert.Error(t, err)
+	_, err = cached.GetByID(missing)
+	assert.Error(t, err)
+	assert.Equal(t, 2, repository.lookups)
+}
+
+func TestUserRepositoryForWritesReadsUncachedAndEvicts(t *testing.T) {
+	u := user.User{ID: uuid.New(), Role: user.RoleAdmin, IsActive: true}
+	repository := newFakeUserRepository(u)
+	cached := cache.NewUserRepository(repository, time.Minute)
+	writes := cached.ForWrites()
+
+	_, err := cached.GetByID(u.ID)
+	require.NoError(t, err)
+
+	// Services read the stored user, not the cached copy, before writing it back
+	stored, err := writes.GetByID(u.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, repository.lookups)
+
+	stored.IsActive = false
+	stored.RevokeTokens()
+	require.NoError(t, writes.Update(stored))
+
+	got, err := cached.GetByID(u.ID)
+	require.NoError(t, err)
+	assert.False(t, got.IsActive)
+	assert.Equal(t, 1, got.TokenVersion)
+	assert.Equal(t, 3, repository.lookups)
+}
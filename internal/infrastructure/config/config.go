@@ -10,10 +10,18 @@ import (
 
 // Config holds all configuration for the application
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
-	Cors     CorsConfig
+	Server    ServerConfig
+	Database  DatabaseConfig
+	JWT       JWTConfig
+	Login     LoginConfig
+	Mail      MailConfig
+	PasswordReset PasswordResetConfig
+	Cors      CorsConfig
+	Scheduler SchedulerConfig
+	Claims    ClaimsConfig
+	Notifications NotificationsConfig
+	Payouts   PayoutsConfig
+	Reports   ReportsConfig
 }
 
 // ServerConfig holds server-specific configuration
@@ -39,6 +47,35 @@ type JWTConfig struct {
 	Secret        string
 	TokenExpiry   time.Duration
 	RefreshExpiry time.Duration
+	UserCacheTTL  time.Duration // How long a token's user is cached between status checks
+}
+
+// LoginConfig holds configuration of the lockout that slows down and stops repeated failed logins
+type LoginConfig struct {
+	MaxAccountFailures int           // Failures after which an account is locked
+	MaxIPFailures      int           // Failures after which a client IP address is locked
+	LockoutDuration    time.Duration
+	FailureWindow      time.Duration // Failures older than this are forgotten
+}
+
+// MailConfig holds outgoing email configuration
+type MailConfig struct {
+	Provider     string // "file" writes each email to a file in FileDir and is meant for development, "smtp" sends it through the SMTP server
+	FileDir      string
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	From         string
+}
+
+// PasswordResetConfig holds configuration of the links mailed to users who forgot their password
+type PasswordResetConfig struct {
+	TokenExpiry         time.Duration
+	URL                 string // Admin portal page the reset token is appended to
+	MaxRequestsPerEmail int    // Reset links mailed to one email per RequestWindow
+	MaxRequestsPerIP    int    // Reset requests from one client IP address per RequestWindow
+	RequestWindow       time.Duration
 }
 
 // CorsConfig holds CORS-specific configuration
@@ -51,6 +88,49 @@ type CorsConfig struct {
 	MaxAge           time.Duration
 }
 
+// SchedulerConfig holds draw scheduler configuration
+type SchedulerConfig struct {
+	Enabled        bool
+	Interval       time.Duration
+	MaxCatchUpRuns int // Missed runs of one schedule executed after downtime, the latest are kept
+}
+
+// ClaimsConfig holds configuration of the job that forfeits unclaimed prizes
+type ClaimsConfig struct {
+	ForfeitureEnabled  bool
+	ForfeitureInterval time.Duration
+}
+
+// NotificationsConfig holds winner notification configuration
+type NotificationsConfig struct {
+	Enabled          bool
+	Interval         time.Duration
+	Channel          string // "log" writes messages to LogFile, "sms_gateway" sends them through the SMS gateway
+	LogFile          string // Empty writes to standard output
+	SMSGatewayURL    string
+	SMSGatewayAPIKey string
+	SMSSenderID      string
+	CallbackURL      string // Where the SMS gateway posts delivery receipts
+	CallbackToken    string // Shared secret delivery receipts must carry; receipts are refused while empty
+	MaxAttempts      int
+}
+
+// PayoutsConfig holds winner payout configuration
+type PayoutsConfig struct {
+	Provider        string // "mock" pays nothing, "http" uses the payout API; empty disables payouts
+	Interval        time.Duration
+	APIURL          string
+	APIKey          string
+	CallbackURL     string // Where the payout provider posts payout outcomes
+	CallbackToken   string // Shared secret callbacks must carry; callbacks are refused while empty
+	AirtimeMaxValue float64 // Prizes worth up to this amount are paid as airtime, larger ones by mobile money
+}
+
+// ReportsConfig holds report configuration
+type ReportsConfig struct {
+	CertificateSigningKey string // Hex encoded Ed25519 seed; winners certificates are unavailable while empty
+}
+
 // LoadConfig loads configuration from environment variables
 func LoadConfig() (*Config, error) {
 	// Load .env file if it exists
@@ -75,15 +155,71 @@ func LoadConfig() (*Config, error) {
 			Secret:        getEnv("JWT_SECRET", "your-secret-key"),
 			TokenExpiry:   getDurationEnv("JWT_TOKEN_EXPIRY", 24*time.Hour),
 			RefreshExpiry: getDurationEnv("JWT_REFRESH_EXPIRY", 7*24*time.Hour),
+			UserCacheTTL:  getDurationEnv("JWT_USER_CACHE_TTL", 10*time.Second),
+		},
+		Login: LoginConfig{
+			MaxAccountFailures: getIntEnv("LOGIN_MAX_ACCOUNT_FAILURES", 5),
+			MaxIPFailures:      getIntEnv("LOGIN_MAX_IP_FAILURES", 20),
+			LockoutDuration:    getDurationEnv("LOGIN_LOCKOUT_DURATION", 15*time.Minute),
+			FailureWindow:      getDurationEnv("LOGIN_FAILURE_WINDOW", 15*time.Minute),
+		},
+		Mail: MailConfig{
+			Provider:     getEnv("MAIL_PROVIDER", "file"),
+			FileDir:      getEnv("MAIL_FILE_DIR", "mail"),
+			SMTPHost:     getEnv("SMTP_HOST", ""),
+			SMTPPort:     getEnv("SMTP_PORT", "587"),
+			SMTPUsername: getEnv("SMTP_USERNAME", ""),
+			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+			From:         getEnv("MAIL_FROM", "no-reply@mynumba-donwin.com"),
+		},
+		PasswordReset: PasswordResetConfig{
+			TokenExpiry:         getDurationEnv("PASSWORD_RESET_TOKEN_EXPIRY", 30*time.Minute),
+			URL:                 getEnv("PASSWORD_RESET_URL", ""),
+			MaxRequestsPerEmail: getIntEnv("PASSWORD_RESET_MAX_PER_EMAIL", 3),
+			MaxRequestsPerIP:    getIntEnv("PASSWORD_RESET_MAX_PER_IP", 10),
+			RequestWindow:       getDurationEnv("PASSWORD_RESET_REQUEST_WINDOW", time.Hour),
 		},
 		Cors: CorsConfig{
 			AllowOrigins:     getSliceEnv("CORS_ALLOW_ORIGINS", []string{"*"}),
 			AllowMethods:     getSliceEnv("CORS_ALLOW_METHODS", []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}),
-			AllowHeaders:     getSliceEnv("CORS_ALLOW_HEADERS", []string{"Origin", "Content-Type", "Accept", "Authorization"}),
+			AllowHeaders:     getSliceEnv("CORS_ALLOW_HEADERS", []string{"Origin", "Content-Type", "Accept", "Authorization", "X-TOTP-Code"}),
 			ExposeHeaders:    getSliceEnv("CORS_EXPOSE_HEADERS", []string{}),
 			AllowCredentials: getBoolEnv("CORS_ALLOW_CREDENTIALS", false),
 			MaxAge:           getDurationEnv("CORS_MAX_AGE", 12*time.Hour),
 		},
+		Scheduler: SchedulerConfig{
+			Enabled:        getBoolEnv("SCHEDULER_ENABLED", true),
+			Interval:       getDurationEnv("SCHEDULER_INTERVAL", time.Minute),
+			MaxCatchUpRuns: getIntEnv("SCHEDULER_MAX_CATCH_UP_RUNS", 7),
+		},
+		Claims: ClaimsConfig{
+			ForfeitureEnabled:  getBoolEnv("CLAIM_FORFEITURE_ENABLED", true),
+			ForfeitureInterval: getDurationEnv("CLAIM_FORFEITURE_INTERVAL", 5*time.Minute),
+		},
+		Notifications: NotificationsConfig{
+			Enabled:          getBoolEnv("NOTIFICATIONS_ENABLED", true),
+			Interval:         getDurationEnv("NOTIFICATIONS_INTERVAL", 30*time.Second),
+			Channel:          getEnv("NOTIFICATIONS_CHANNEL", "log"),
+			LogFile:          getEnv("NOTIFICATIONS_LOG_FILE", ""),
+			SMSGatewayURL:    getEnv("SMS_GATEWAY_URL", ""),
+			SMSGatewayAPIKey: getEnv("SMS_GATEWAY_API_KEY", ""),
+			SMSSenderID:      getEnv("SMS_SENDER_ID", "MyNumba"),
+			CallbackURL:      getEnv("SMS_CALLBACK_URL", ""),
+			CallbackToken:    getEnv("SMS_CALLBACK_TOKEN", ""),
+			MaxAttempts:      getIntEnv("NOTIFICATIONS_MAX_ATTEMPTS", 5),
+		},
+		Payouts: PayoutsConfig{
+			Provider:        getEnv("PAYOUT_PROVIDER", ""),
+			Interval:        getDurationEnv("PAYOUTS_INTERVAL", time.Minute),
+			APIURL:          getEnv("PAYOUT_API_URL", ""),
+			APIKey:          getEnv("PAYOUT_API_KEY", ""),
+			CallbackURL:     getEnv("PAYOUT_CALLBACK_URL", ""),
+			CallbackToken:   getEnv("PAYOUT_CALLBACK_TOKEN", ""),
+			AirtimeMaxValue: getFloatEnv("PAYOUT_AIRTIME_MAX_VALUE", 10000),
+		},
+		Reports: ReportsConfig{
+			CertificateSigningKey: getEnv("REPORT_CERTIFICATE_SIGNING_KEY", ""),
+		},
 	}
 
 	return config, nil
@@ -127,6 +263,16 @@ func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
 	return defaultValue
 }
 
+func getFloatEnv(key string, defaultValue float64) float64 {
+	if value, exists := os.LookupEnv(key); exists {
+		floatValue, err := strconv.ParseFloat(value, 64)
+		if err == nil {
+			return floatValue
+		}
+	}
+	return defaultValue
+}
+
 func getSliceEnv(key string, defaultValue []string) []string {
 	if value, exists := os.LookupEnv(key); exists && value != "" {
 		return stringSplit(value, ",")
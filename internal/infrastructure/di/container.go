@@ -1,6 +1,11 @@
 package di
 
 import (
+	"log"
+	"os"
+	"strconv"
+	"time"
+
 	"github.com/gin-gonic/gin"
 	"gorm.io/gorm"
 	
@@ -10,6 +15,19 @@ import (
 	"github.com/ArowuTest/GP-Backend-Promo/internal/application/participant"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/application/prize"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/application/audit"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/application/blacklist"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/application/schedule"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/application/notification"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/application/payout"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/application/report"
+	domainAuth "github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/mail"
+	domainPayout "github.com/ArowuTest/GP-Backend-Promo/internal/domain/payout"
+	domainReport "github.com/ArowuTest/GP-Backend-Promo/internal/domain/report"
+	domainUser "github.com/ArowuTest/GP-Backend-Promo/internal/domain/user"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/infrastructure/cache"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/infrastructure/mailer"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/infrastructure/payoutprovider"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/api/handler"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/api/middleware"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/api"
@@ -27,6 +45,18 @@ type Container struct {
 	ParticipantRepository *pgorm.GormParticipantRepository
 	PrizeRepository       *pgorm.GormPrizeRepository
 	AuditRepository       *pgorm.GormAuditRepository
+	BlacklistRepository   *pgorm.GormBlacklistRepository
+	UnitOfWork            *pgorm.GormUnitOfWork
+	DrawScheduleRepository *pgorm.GormDrawScheduleRepository
+	NotificationRepository *pgorm.GormNotificationRepository
+	PayoutRepository       *pgorm.GormPayoutRepository
+	ReportRepository       *pgorm.GormReportRepository
+	TokenRepository        *pgorm.GormTokenRepository
+	LoginAttemptRepository *pgorm.GormLoginAttemptRepository
+	TwoFactorRepository    *pgorm.GormTwoFactorRepository
+	PasswordResetTokenRepository *pgorm.GormPasswordResetTokenRepository
+	CachedUserRepository   *cache.UserRepository
+	UserWriteRepository    domainUser.UserRepository
 	
 	// Services
 	AuthService           *user.AuthenticateUserService
@@ -35,6 +65,7 @@ type Container struct {
 	PrizeService          *prize.CreatePrizeStructureService
 	AuditService          *audit.AuditService
 	ResetPasswordService  *user.ResetPasswordService
+	TwoFactorService      *user.TwoFactorService
 	
 	// Middleware
 	AuthMiddleware        *middleware.AuthMiddleware
@@ -48,6 +79,12 @@ type Container struct {
 	AuditHandler          *handler.AuditHandler
 	UserHandler           *handler.UserHandler
 	ResetPasswordHandler  *handler.ResetPasswordHandler
+	BlacklistHandler      *handler.BlacklistHandler
+	DrawScheduleHandler   *handler.DrawScheduleHandler
+	NotificationHandler   *handler.NotificationHandler
+	PayoutHandler         *handler.PayoutHandler
+	ReportHandler         *handler.ReportHandler
+	AuthHandler           *handler.AuthHandler
 	
 	// Router
 	Router                *api.Router
@@ -86,6 +123,27 @@ func (c *Container) initRepositories() {
 	c.ParticipantRepository = pgorm.NewGormParticipantRepository(c.DB)
 	c.PrizeRepository = pgorm.NewGormPrizeRepository(c.DB)
 	c.AuditRepository = pgorm.NewGormAuditRepository(c.DB)
+	c.BlacklistRepository = pgorm.NewGormBlacklistRepository(c.DB)
+	c.UnitOfWork = pgorm.NewGormUnitOfWork(c.DB)
+	c.DrawScheduleRepository = pgorm.NewGormDrawScheduleRepository(c.DB)
+	c.NotificationRepository = pgorm.NewGormNotificationRepository(c.DB)
+	c.PayoutRepository = pgorm.NewGormPayoutRepository(c.DB)
+	c.ReportRepository = pgorm.NewGormReportRepository(c.DB)
+	c.TokenRepository = pgorm.NewGormTokenRepository(c.DB)
+	c.LoginAttemptRepository = pgorm.NewGormLoginAttemptRepository(c.DB)
+	c.TwoFactorRepository = pgorm.NewGormTwoFactorRepository(c.DB)
+	c.PasswordResetTokenRepository = pgorm.NewGormPasswordResetTokenRepository(c.DB)
+	
+	// Every authenticated request looks up its user, so the auth middleware's lookups by ID are
+	// cached briefly. User services read uncached, since a stale copy would overwrite a
+	// deactivation or token version bump made by another replica, and their writes evict the
+	// user so that the next request sees the change.
+	userCacheTTL, err := time.ParseDuration(os.Getenv("JWT_USER_CACHE_TTL"))
+	if err != nil {
+		userCacheTTL = 10 * time.Second
+	}
+	c.CachedUserRepository = cache.NewUserRepository(c.UserRepository, userCacheTTL)
+	c.UserWriteRepository = c.CachedUserRepository.ForWrites()
 }
 
 // Initialize services
@@ -95,14 +153,15 @@ func (c *Container) initServices() {
 	c.AuditService = audit.NewAuditService(logAuditService)
 	
 	// Create user services
-	c.AuthService = user.NewAuthenticateUserService(c.UserRepository, c.AuditService)
-	c.ResetPasswordService = user.NewResetPasswordService(c.UserRepository, c.AuditService)
+	c.AuthService = user.NewAuthenticateUserService(c.UserWriteRepository, c.TokenRepository, c.LoginAttemptRepository, c.TwoFactorRepository, c.AuditService, tokenSettings(), lockoutPolicy())
+	c.TwoFactorService = user.NewTwoFactorService(c.UserWriteRepository, c.TwoFactorRepository, c.LoginAttemptRepository, c.AuditService, lockoutPolicy())
+	c.ResetPasswordService = user.NewResetPasswordService(c.UserWriteRepository, c.AuditService)
 	
 	// Create draw services
-	c.DrawService = draw.NewDrawService(c.DrawRepository, c.ParticipantRepository, c.PrizeRepository, c.AuditService)
+	c.DrawService = draw.NewDrawService(c.DrawRepository, c.ParticipantRepository, c.PrizeRepository, c.BlacklistRepository, c.UnitOfWork, c.AuditService)
 	
 	// Create participant services
-	c.ParticipantService = participant.NewUploadParticipantsService(c.ParticipantRepository, c.AuditService)
+	c.ParticipantService = participant.NewUploadParticipantsService(c.ParticipantRepository, c.BlacklistRepository, c.AuditService)
 	
 	// Create prize services
 	c.PrizeService = prize.NewCreatePrizeStructureService(c.PrizeRepository, c.AuditService)
@@ -110,7 +169,7 @@ func (c *Container) initServices() {
 
 // Initialize middleware
 func (c *Container) initMiddleware() {
-	c.AuthMiddleware = middleware.NewAuthMiddleware("mynumba-donwin-jwt-secret-key-2025") // Production JWT secret
+	c.AuthMiddleware = middleware.NewAuthMiddleware(tokenSettings().Secret, c.TokenRepository, c.CachedUserRepository, c.TwoFactorService)
 	c.CORSMiddleware = middleware.Default() // Use default CORS middleware
 	c.ErrorMiddleware = middleware.NewErrorMiddleware(false) // Set to true for debug mode
 }
@@ -122,10 +181,17 @@ func (c *Container) initHandlers() {
 		c.DrawService,
 		draw.NewGetDrawByIDService(c.DrawRepository),
 		draw.NewListDrawsService(c.DrawRepository),
-		draw.NewGetEligibilityStatsService(c.DrawRepository, c.ParticipantRepository),
-		draw.NewInvokeRunnerUpService(c.DrawRepository, c.AuditService),
-		draw.NewUpdateWinnerPaymentStatusService(c.DrawRepository),
-		draw.NewListWinnersService(c.DrawRepository))
+		draw.NewGetEligibilityStatsService(c.DrawRepository, c.ParticipantRepository, c.PrizeRepository, c.BlacklistRepository),
+		draw.NewInvokeRunnerUpService(c.DrawRepository, c.PrizeRepository, c.UnitOfWork, c.AuditService),
+		draw.NewUpdateWinnerPaymentStatusService(c.UnitOfWork, c.AuditService),
+		draw.NewListWinnersService(c.DrawRepository),
+		draw.NewVerifyDrawService(c.DrawRepository),
+		draw.NewScheduleDrawService(c.DrawRepository, c.PrizeRepository, c.UnitOfWork, c.AuditService),
+		draw.NewVoidDrawService(c.DrawRepository, c.AuditService),
+		draw.NewGetReplacementHistoryService(c.DrawRepository),
+		draw.NewConfirmWinnerClaimService(c.DrawRepository, c.AuditService),
+		draw.NewGetWinnerPaymentHistoryService(c.DrawRepository),
+		draw.NewReconcileWinnerPaymentsService(c.DrawRepository, c.PrizeRepository, c.UnitOfWork, c.AuditService))
 	c.DrawHandler = handler.NewDrawHandler(drawServiceAdapter)
 	
 	// Create prize handler
@@ -145,7 +211,8 @@ func (c *Container) initHandlers() {
 		participant.NewDeleteUploadService(c.ParticipantRepository))
 	c.ParticipantHandler = handler.NewParticipantHandler(
 		participantServiceAdapter,
-		participant.NewGetParticipantStatsService(c.ParticipantRepository))
+		participant.NewGetParticipantStatsService(c.ParticipantRepository),
+		participant.NewGetUploadErrorReportService(c.ParticipantRepository))
 	
 	// Create audit handler
 	c.AuditHandler = handler.NewAuditHandler(
@@ -154,14 +221,72 @@ func (c *Container) initHandlers() {
 	
 	// Create user handler with correct parameter order
 	c.UserHandler = handler.NewUserHandler(
-		user.NewCreateUserService(c.UserRepository, c.AuditService),
-		user.NewUpdateUserService(c.UserRepository, c.AuditService),
-		user.NewGetUserService(c.UserRepository),
-		user.NewListUsersService(c.UserRepository),
+		user.NewCreateUserService(c.UserWriteRepository, c.AuditService),
+		user.NewUpdateUserService(c.UserWriteRepository, c.AuditService),
+		user.NewGetUserService(c.UserWriteRepository),
+		user.NewListUsersService(c.UserWriteRepository),
 		c.AuthService)
 	
 	// Create reset password handler
 	c.ResetPasswordHandler = handler.NewResetPasswordHandler(c.ResetPasswordService)
+	
+	// Create blacklist handler
+	c.BlacklistHandler = handler.NewBlacklistHandler(
+		blacklist.NewCreateBlacklistEntryService(c.BlacklistRepository, c.AuditService),
+		blacklist.NewGetBlacklistEntryService(c.BlacklistRepository),
+		blacklist.NewListBlacklistEntriesService(c.BlacklistRepository),
+		blacklist.NewUpdateBlacklistEntryService(c.BlacklistRepository, c.AuditService),
+		blacklist.NewDeleteBlacklistEntryService(c.BlacklistRepository, c.AuditService),
+		blacklist.NewImportBlacklistService(c.BlacklistRepository, c.AuditService))
+	
+	// Create draw schedule handler
+	c.DrawScheduleHandler = handler.NewDrawScheduleHandler(
+		schedule.NewCreateDrawScheduleService(c.DrawScheduleRepository, c.PrizeRepository, c.AuditService),
+		schedule.NewGetDrawScheduleService(c.DrawScheduleRepository),
+		schedule.NewListDrawSchedulesService(c.DrawScheduleRepository),
+		schedule.NewUpdateDrawScheduleService(c.DrawScheduleRepository, c.PrizeRepository, c.AuditService),
+		schedule.NewDeleteDrawScheduleService(c.DrawScheduleRepository, c.AuditService))
+	
+	// Create notification handler
+	c.NotificationHandler = handler.NewNotificationHandler(
+		notification.NewHandleDeliveryReceiptService(c.NotificationRepository, c.UnitOfWork, c.AuditService),
+		notification.NewListWinnerNotificationsService(c.NotificationRepository),
+		os.Getenv("SMS_CALLBACK_TOKEN"))
+	
+	// Create payout handler
+	var payoutProvider domainPayout.PayoutProvider
+	switch os.Getenv("PAYOUT_PROVIDER") {
+	case "mock":
+		payoutProvider = payoutprovider.NewMockProvider("mock")
+	case "http":
+		payoutProvider = payoutprovider.NewHTTPProvider("http", os.Getenv("PAYOUT_API_URL"), os.Getenv("PAYOUT_API_KEY"), os.Getenv("PAYOUT_CALLBACK_URL"))
+	}
+	payoutProviders := domainPayout.NewProviderSet(payoutProvider, payoutProvider)
+	airtimeMaxValue, err := strconv.ParseFloat(os.Getenv("PAYOUT_AIRTIME_MAX_VALUE"), 64)
+	if err != nil {
+		airtimeMaxValue = 10000
+	}
+	c.PayoutHandler = handler.NewPayoutHandler(
+		payout.NewRequestPayoutService(c.PayoutRepository, c.DrawRepository, c.PrizeRepository, payoutProviders, c.UnitOfWork, c.AuditService, airtimeMaxValue),
+		payout.NewGetWinnerPayoutService(c.PayoutRepository),
+		payout.NewHandlePayoutCallbackService(c.PayoutRepository, payoutProviders, c.UnitOfWork, c.AuditService),
+		os.Getenv("PAYOUT_CALLBACK_TOKEN"))
+	
+	// Create report handler; winners certificates are unavailable without a valid signing key
+	certificateSigningKey, _ := domainReport.ParseSigningKey(os.Getenv("REPORT_CERTIFICATE_SIGNING_KEY"))
+	c.ReportHandler = handler.NewReportHandler(
+		report.NewExportWinnersReportService(c.ReportRepository, c.AuditService),
+		report.NewExportPaymentLedgerService(c.ReportRepository, c.AuditService),
+		report.NewExportPrizeLiabilityService(c.ReportRepository, c.AuditService),
+		report.NewGenerateWinnersCertificateService(c.DrawRepository, c.ReportRepository, certificateSigningKey, c.AuditService))
+	
+	// Create auth handler
+	c.AuthHandler = handler.NewAuthHandler(
+		user.NewRefreshTokenService(c.UserWriteRepository, c.TokenRepository, c.AuditService, tokenSettings()),
+		user.NewLogoutService(c.TokenRepository, c.AuditService),
+		user.NewUnlockUserService(c.UserWriteRepository, c.LoginAttemptRepository, c.AuditService),
+		c.TwoFactorService,
+		user.NewPasswordRecoveryService(c.UserWriteRepository, c.PasswordResetTokenRepository, c.TokenRepository, c.LoginAttemptRepository, newMailer(), c.AuditService, passwordResetSettings()))
 }
 	
 // Initialize router
@@ -176,7 +301,84 @@ func (c *Container) initRouter() {
 		c.ParticipantHandler,
 		c.AuditHandler,
 		c.UserHandler,
-		c.ResetPasswordHandler)
+		c.ResetPasswordHandler,
+		c.BlacklistHandler,
+		c.DrawScheduleHandler,
+		c.NotificationHandler,
+		c.PayoutHandler,
+		c.ReportHandler,
+		c.AuthHandler)
+}
+
+// tokenSettings reads the token settings from the environment
+func tokenSettings() user.TokenSettings {
+	secret := os.Getenv("JWT_SECRET")
+	if secret == "" {
+		secret = "mynumba-donwin-jwt-secret-key-2025" // Production JWT secret
+	}
+	accessExpiry, _ := time.ParseDuration(os.Getenv("JWT_TOKEN_EXPIRY"))
+	refreshExpiry, _ := time.ParseDuration(os.Getenv("JWT_REFRESH_EXPIRY"))
+	return user.TokenSettings{
+		Secret:        secret,
+		AccessExpiry:  accessExpiry,
+		RefreshExpiry: refreshExpiry,
+	}
+}
+
+// newMailer creates the mailer configured in the environment: SMTP when MAIL_PROVIDER is
+// "smtp", otherwise files in MAIL_FILE_DIR
+func newMailer() mail.Mailer {
+	if os.Getenv("MAIL_PROVIDER") == "smtp" {
+		port := os.Getenv("SMTP_PORT")
+		if port == "" {
+			port = "587"
+		}
+		from := os.Getenv("MAIL_FROM")
+		if from == "" {
+			from = "no-reply@mynumba-donwin.com"
+		}
+		return mailer.NewSMTPMailer(os.Getenv("SMTP_HOST"), port, os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"), from)
+	}
+
+	dir := os.Getenv("MAIL_FILE_DIR")
+	if dir == "" {
+		dir = "mail"
+	}
+	log.Printf("Warning: MAIL_PROVIDER is not \"smtp\"; password reset emails are written to %s and not delivered", dir)
+	return mailer.NewFileMailer(dir)
+}
+
+// passwordResetSettings reads the password reset settings from the environment
+func passwordResetSettings() user.PasswordResetSettings {
+	tokenExpiry, _ := time.ParseDuration(os.Getenv("PASSWORD_RESET_TOKEN_EXPIRY"))
+	maxPerEmail, _ := strconv.Atoi(os.Getenv("PASSWORD_RESET_MAX_PER_EMAIL"))
+	maxPerIP, _ := strconv.Atoi(os.Getenv("PASSWORD_RESET_MAX_PER_IP"))
+	requestWindow, _ := time.ParseDuration(os.Getenv("PASSWORD_RESET_REQUEST_WINDOW"))
+	return user.PasswordResetSettings{
+		TokenExpiry:         tokenExpiry,
+		ResetURL:            os.Getenv("PASSWORD_RESET_URL"),
+		MaxRequestsPerEmail: maxPerEmail,
+		MaxRequestsPerIP:    maxPerIP,
+		RequestWindow:       requestWindow,
+	}
+}
+
+// lockoutPolicy reads the login lockout policy from the environment
+func lockoutPolicy() domainAuth.LockoutPolicy {
+	policy := domainAuth.DefaultLockoutPolicy()
+	if maxFailures, err := strconv.Atoi(os.Getenv("LOGIN_MAX_ACCOUNT_FAILURES")); err == nil {
+		policy.MaxAccountFailures = maxFailures
+	}
+	if maxFailures, err := strconv.Atoi(os.Getenv("LOGIN_MAX_IP_FAILURES")); err == nil {
+		policy.MaxIPFailures = maxFailures
+	}
+	if duration, err := time.ParseDuration(os.Getenv("LOGIN_LOCKOUT_DURATION")); err == nil {
+		policy.LockoutDuration = duration
+	}
+	if window, err := time.ParseDuration(os.Getenv("LOGIN_FAILURE_WINDOW")); err == nil {
+		policy.FailureWindow = window
+	}
+	return policy
 }
 
 // Setup configures the application
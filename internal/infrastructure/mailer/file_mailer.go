@@ -0,0 +1,46 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/mail"
+)
+
+// FileMailer implements the mail.Mailer interface by writing each message to its own file
+// instead of sending it. It stands in for an SMTP server in development and tests. The files
+// hold live password reset links, so they are readable by their owner only.
+type FileMailer struct {
+	dir string
+}
+
+// NewFileMailer creates a new FileMailer writing to dir, which is created if missing
+func NewFileMailer(dir string) *FileMailer {
+	return &FileMailer{
+		dir: dir,
+	}
+}
+
+// Send implements the mail.Mailer interface
+func (m *FileMailer) Send(ctx context.Context, message mail.Message) error {
+	if err := os.MkdirAll(m.dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create mail directory: %w", err)
+	}
+
+	content, err := formatMessage("", message, time.Now())
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s-%s.eml", time.Now().UTC().Format("20060102T150405Z"), uuid.New())
+	if err := os.WriteFile(filepath.Join(m.dir, name), content, 0o600); err != nil {
+		return fmt.Errorf("failed to write mail: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package mailer_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/mail"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/infrastructure/mailer"
+)
+
+func TestFileMailer_Send(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "mail")
+	m := mailer.NewFileMailer(dir)
+
+	require.NoError(t, m.Send(context.Background(), mail.Message{
+		To:      "admin@example.com",
+		Subject: "Reset your password",
+		Body:    "Line one\nLine two",
+	}))
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	info, err := files[0].Info()
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	content, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "To: admin@example.com\r\n")
+	assert.Contains(t, string(content), "Subject: Reset your password\r\n")
+	assert.Contains(t, string(content), "\r\n\r\nLine one\r\nLine two\r\n")
+}
+
+func TestFileMailer_SendRejectsHeaderInjection(t *testing.T) {
+	m := mailer.NewFileMailer(t.TempDir())
+
+	err := m.Send(context.Background(), mail.Message{
+		To:      "admin@example.com\r\nBcc: attacker@example.com",
+		Subject: "Reset your password",
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,85 @@
+// Package mailer delivers email through an SMTP server, or writes it to files in its place.
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/mail"
+)
+
+// SMTPMailer implements the mail.Mailer interface by sending messages through an SMTP server.
+// The server must offer STARTTLS when a username is set, as net/smtp refuses to send
+// credentials in the clear to anything but localhost.
+type SMTPMailer struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     string
+}
+
+// NewSMTPMailer creates a new SMTPMailer
+func NewSMTPMailer(host, port, username, password, from string) *SMTPMailer {
+	return &SMTPMailer{
+		addr:     net.JoinHostPort(host, port),
+		host:     host,
+		username: username,
+		password: password,
+		from:     from,
+	}
+}
+
+// Send implements the mail.Mailer interface. net/smtp cannot be cancelled, so ctx is only
+// checked before sending.
+func (m *SMTPMailer) Send(ctx context.Context, message mail.Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	content, err := formatMessage(m.from, message, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if m.username != "" {
+		auth = smtp.PlainAuth("", m.username, m.password, m.host)
+	}
+
+	if err := smtp.SendMail(m.addr, auth, m.from, []string{message.To}, content); err != nil {
+		return fmt.Errorf("failed to send mail: %w", err)
+	}
+
+	return nil
+}
+
+// formatMessage renders a message as a plain text RFC 5322 email
+func formatMessage(from string, message mail.Message, date time.Time) ([]byte, error) {
+	// Header values must not smuggle in further headers
+	for _, value := range []string{from, message.To, message.Subject} {
+		if strings.ContainsAny(value, "\r\n") {
+			return nil, fmt.Errorf("invalid mail header value %q", value)
+		}
+	}
+
+	var buf bytes.Buffer
+	if from != "" {
+		fmt.Fprintf(&buf, "From: %s\r\n", from)
+	}
+	fmt.Fprintf(&buf, "To: %s\r\n", message.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", message.Subject)
+	fmt.Fprintf(&buf, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(message.Body, "\n", "\r\n"))
+	buf.WriteString("\r\n")
+
+	return buf.Bytes(), nil
+}
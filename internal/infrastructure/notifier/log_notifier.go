@@ -0,0 +1,41 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/notification"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/util"
+)
+
+// LogNotifier implements the notification.Notifier interface by writing messages to a log
+// or file instead of sending them. It stands in for the SMS gateway in development and tests.
+// No delivery receipts follow; post them to the callback endpoint with the logged message ID.
+type LogNotifier struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+// NewLogNotifier creates a new LogNotifier writing to out
+func NewLogNotifier(out io.Writer) *LogNotifier {
+	return &LogNotifier{
+		out: out,
+	}
+}
+
+// Send implements the notification.Notifier interface
+func (n *LogNotifier) Send(ctx context.Context, message notification.Message) (*notification.SendResult, error) {
+	messageID := "log-" + message.ID.String()
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if _, err := fmt.Fprintf(n.out, "%s SMS %s to %s: %s\n", time.Now().Format(time.RFC3339), messageID, util.MaskMSISDN(message.MSISDN), message.Text); err != nil {
+		return nil, fmt.Errorf("failed to write message: %w", err)
+	}
+
+	return &notification.SendResult{ProviderMessageID: messageID}, nil
+}
@@ -0,0 +1,93 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/notification"
+)
+
+// SMSGatewayNotifier implements the notification.Notifier interface by posting messages to
+// an HTTP SMS gateway. Operator SMPP binds are reached through the gateway, which reports
+// delivery by calling callbackURL with the message ID it returned.
+type SMSGatewayNotifier struct {
+	url         string
+	apiKey      string
+	senderID    string
+	callbackURL string
+	httpClient  *http.Client
+}
+
+// NewSMSGatewayNotifier creates a new SMSGatewayNotifier
+func NewSMSGatewayNotifier(url, apiKey, senderID, callbackURL string) *SMSGatewayNotifier {
+	return &SMSGatewayNotifier{
+		url:         url,
+		apiKey:      apiKey,
+		senderID:    senderID,
+		callbackURL: callbackURL,
+		httpClient:  &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+// sendSMSRequest is the body posted to the gateway
+type sendSMSRequest struct {
+	From        string `json:"from"`
+	To          string `json:"to"`
+	Text        string `json:"text"`
+	Reference   string `json:"reference"`
+	CallbackURL string `json:"callbackUrl,omitempty"`
+}
+
+// sendSMSResponse is the gateway's answer to an accepted message
+type sendSMSResponse struct {
+	MessageID string `json:"messageId"`
+}
+
+// Send implements the notification.Notifier interface
+func (n *SMSGatewayNotifier) Send(ctx context.Context, message notification.Message) (*notification.SendResult, error) {
+	body, err := json.Marshal(sendSMSRequest{
+		From:        n.senderID,
+		To:          message.MSISDN,
+		Text:        message.Text,
+		Reference:   message.ID.String(),
+		CallbackURL: n.callbackURL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SMS request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", n.apiKey))
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("SMS gateway rejected the message, status code: %d: %s", resp.StatusCode, bytes.TrimSpace(detail))
+	}
+
+	var result sendSMSResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if result.MessageID == "" {
+		return nil, fmt.Errorf("missing message ID in SMS gateway response")
+	}
+
+	return &notification.SendResult{ProviderMessageID: result.MessageID}, nil
+}
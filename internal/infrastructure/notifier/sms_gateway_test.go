@@ -0,0 +1,51 @@
+package notifier_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/notification"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/infrastructure/notifier"
+)
+
+func TestSMSGatewayNotifier_Send(t *testing.T) {
+	message := notification.Message{ID: uuid.New(), MSISDN: "2348031234567", Text: "You won"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "MyNumba", body["from"])
+		assert.Equal(t, message.MSISDN, body["to"])
+		assert.Equal(t, message.Text, body["text"])
+		assert.Equal(t, message.ID.String(), body["reference"])
+		assert.Equal(t, "https://promo.example/dlr", body["callbackUrl"])
+
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"messageId":"gw-42"}`))
+	}))
+	defer server.Close()
+
+	result, err := notifier.NewSMSGatewayNotifier(server.URL, "secret", "MyNumba", "https://promo.example/dlr").Send(context.Background(), message)
+	require.NoError(t, err)
+	assert.Equal(t, "gw-42", result.ProviderMessageID)
+}
+
+func TestSMSGatewayNotifier_SendRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid destination", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	_, err := notifier.NewSMSGatewayNotifier(server.URL, "secret", "MyNumba", "").Send(context.Background(), notification.Message{ID: uuid.New()})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid destination")
+}
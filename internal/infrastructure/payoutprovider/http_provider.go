@@ -0,0 +1,129 @@
+package payoutprovider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/payout"
+)
+
+// HTTPProvider implements the payout.PayoutProvider interface for a payout aggregator API that
+// handles both airtime top-ups and mobile money transfers
+type HTTPProvider struct {
+	name        string
+	baseURL     string
+	apiKey      string
+	callbackURL string
+	httpClient  *http.Client
+}
+
+// NewHTTPProvider creates a new HTTPProvider
+func NewHTTPProvider(name, baseURL, apiKey, callbackURL string) *HTTPProvider {
+	return &HTTPProvider{
+		name:        name,
+		baseURL:     baseURL,
+		apiKey:      apiKey,
+		callbackURL: callbackURL,
+		httpClient:  &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// payoutRequest is the body posted to create a payout
+type payoutRequest struct {
+	Reference   string  `json:"reference"`
+	Type        string  `json:"type"` // "airtime" or "mobile_money"
+	MSISDN      string  `json:"msisdn"`
+	Amount      float64 `json:"amount"`
+	Currency    string  `json:"currency"`
+	CallbackURL string  `json:"callbackUrl,omitempty"`
+}
+
+// payoutResponse is the provider's view of a payout
+type payoutResponse struct {
+	ID            string `json:"id"`
+	Status        string `json:"status"`
+	FailureReason string `json:"failureReason"`
+}
+
+// Name implements the payout.PayoutProvider interface
+func (p *HTTPProvider) Name() string {
+	return p.name
+}
+
+// Initiate implements the payout.PayoutProvider interface
+func (p *HTTPProvider) Initiate(ctx context.Context, request payout.PayoutRequest) (*payout.ProviderResult, error) {
+	payoutType := "mobile_money"
+	if request.Method == payout.MethodAirtime {
+		payoutType = "airtime"
+	}
+
+	body, err := json.Marshal(payoutRequest{
+		Reference:   request.Reference,
+		Type:        payoutType,
+		MSISDN:      request.MSISDN,
+		Amount:      request.Amount,
+		Currency:    request.Currency,
+		CallbackURL: p.callbackURL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payout request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/payouts", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	// Lets the provider return the original payout when a reference is sent again
+	req.Header.Set("Idempotency-Key", request.Reference)
+
+	return p.do(req)
+}
+
+// Status implements the payout.PayoutProvider interface
+func (p *HTTPProvider) Status(ctx context.Context, reference string) (*payout.ProviderResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+"/payouts/"+url.PathEscape(reference), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	return p.do(req)
+}
+
+// do executes a request and decodes the payout in the response
+func (p *HTTPProvider) do(req *http.Request) (*payout.ProviderResult, error) {
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", p.apiKey))
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("payout provider returned status code %d: %s", resp.StatusCode, bytes.TrimSpace(detail))
+	}
+
+	var result payoutResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	status, err := payout.ParseProviderStatus(result.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &payout.ProviderResult{
+		ProviderPayoutID: result.ID,
+		Status:           status,
+		FailureReason:    result.FailureReason,
+	}, nil
+}
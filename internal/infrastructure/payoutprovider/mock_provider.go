@@ -0,0 +1,87 @@
+package payoutprovider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/payout"
+)
+
+// MockProvider implements the payout.PayoutProvider interface without moving money, for
+// development and tests. A payout is pending when initiated and succeeds on the first status
+// check, unless its MSISDN was registered with FailMSISDN.
+type MockProvider struct {
+	name    string
+	mu      sync.Mutex
+	payouts map[string]*payout.ProviderResult
+	msisdns map[string]string
+	failing map[string]string
+}
+
+// NewMockProvider creates a new MockProvider
+func NewMockProvider(name string) *MockProvider {
+	return &MockProvider{
+		name:    name,
+		payouts: make(map[string]*payout.ProviderResult),
+		msisdns: make(map[string]string),
+		failing: make(map[string]string),
+	}
+}
+
+// FailMSISDN makes payouts to msisdn fail with reason
+func (p *MockProvider) FailMSISDN(msisdn, reason string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.failing[msisdn] = reason
+}
+
+// Name implements the payout.PayoutProvider interface
+func (p *MockProvider) Name() string {
+	return p.name
+}
+
+// Initiate implements the payout.PayoutProvider interface
+func (p *MockProvider) Initiate(ctx context.Context, request payout.PayoutRequest) (*payout.ProviderResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if result, ok := p.payouts[request.Reference]; ok {
+		copied := *result
+		return &copied, nil
+	}
+
+	result := &payout.ProviderResult{
+		ProviderPayoutID: fmt.Sprintf("mock-%d", len(p.payouts)+1),
+		Status:           payout.ProviderStatusPending,
+	}
+	p.payouts[request.Reference] = result
+	p.msisdns[request.Reference] = request.MSISDN
+
+	copied := *result
+	return &copied, nil
+}
+
+// Status implements the payout.PayoutProvider interface
+func (p *MockProvider) Status(ctx context.Context, reference string) (*payout.ProviderResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	result, ok := p.payouts[reference]
+	if !ok {
+		return nil, fmt.Errorf("unknown payout reference %s", reference)
+	}
+
+	if result.Status == payout.ProviderStatusPending {
+		if reason, failing := p.failing[p.msisdns[reference]]; failing {
+			result.Status = payout.ProviderStatusFailed
+			result.FailureReason = reason
+		} else {
+			result.Status = payout.ProviderStatusSucceeded
+		}
+	}
+
+	copied := *result
+	return &copied, nil
+}
@@ -0,0 +1,39 @@
+package payoutprovider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/payout"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/infrastructure/payoutprovider"
+)
+
+func TestMockProvider(t *testing.T) {
+	ctx := context.Background()
+	provider := payoutprovider.NewMockProvider("mock")
+	provider.FailMSISDN("2348000000000", "invalid wallet")
+
+	request := payout.PayoutRequest{Reference: "p-1", Method: payout.MethodAirtime, MSISDN: "2348031234567", Amount: 500, Currency: "NGN"}
+	first, err := provider.Initiate(ctx, request)
+	require.NoError(t, err)
+	assert.Equal(t, payout.ProviderStatusPending, first.Status)
+
+	// Initiating a reference again is idempotent
+	again, err := provider.Initiate(ctx, request)
+	require.NoError(t, err)
+	assert.Equal(t, first.ProviderPayoutID, again.ProviderPayoutID)
+
+	result, err := provider.Status(ctx, "p-1")
+	require.NoError(t, err)
+	assert.Equal(t, payout.ProviderStatusSucceeded, result.Status)
+
+	_, err = provider.Initiate(ctx, payout.PayoutRequest{Reference: "p-2", MSISDN: "2348000000000"})
+	require.NoError(t, err)
+	result, err = provider.Status(ctx, "p-2")
+	require.NoError(t, err)
+	assert.Equal(t, payout.ProviderStatusFailed, result.Status)
+	assert.Equal(t, "invalid wallet", result.FailureReason)
+}
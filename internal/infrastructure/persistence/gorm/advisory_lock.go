@@ -0,0 +1,42 @@
+package gorm
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// GormAdvisoryLock implements the schedule.RunLock and notification.DispatchLock interfaces
+// with a Postgres advisory lock.
+// The lock is transaction scoped, so it is released when fn returns or the connection drops.
+type GormAdvisoryLock struct {
+	db  *gorm.DB
+	key int64
+}
+
+// NewGormAdvisoryLock creates a new GormAdvisoryLock for a lock key
+func NewGormAdvisoryLock(db *gorm.DB, key int64) *GormAdvisoryLock {
+	return &GormAdvisoryLock{
+		db:  db,
+		key: key,
+	}
+}
+
+// TryRun implements the schedule.RunLock interface
+func (l *GormAdvisoryLock) TryRun(fn func() error) (bool, error) {
+	acquired := false
+	err := l.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", l.key).Scan(&acquired).Error; err != nil {
+			return fmt.Errorf("failed to acquire advisory lock: %w", err)
+		}
+
+		if !acquired {
+			return nil
+		}
+
+		// fn runs its own queries outside this transaction, which only holds the lock
+		return fn()
+	})
+
+	return acquired, err
+}
@@ -0,0 +1,228 @@
+package gorm
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/blacklist"
+)
+
+// blacklistUpsertBatchSize is the number of entries written per statement by Upsert
+const blacklistUpsertBatchSize = 1000
+
+// GormBlacklistRepository implements the blacklist.BlacklistRepository interface using GORM
+type GormBlacklistRepository struct {
+	db *gorm.DB
+}
+
+// NewGormBlacklistRepository creates a new GormBlacklistRepository
+func NewGormBlacklistRepository(db *gorm.DB) *GormBlacklistRepository {
+	return &GormBlacklistRepository{
+		db: db,
+	}
+}
+
+// BlacklistEntryModel is the GORM model for blacklist entries
+type BlacklistEntryModel struct {
+	ID        string `gorm:"primaryKey;type:uuid"`
+	MSISDN    string `gorm:"uniqueIndex"`
+	Reason    string
+	ExpiresAt *time.Time `gorm:"index"`
+	CreatedBy string     `gorm:"type:uuid"`
+	UpdatedBy string     `gorm:"type:uuid"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TableName returns the table name for the BlacklistEntryModel
+func (BlacklistEntryModel) TableName() string {
+	return "blacklist_entries"
+}
+
+// toBlacklistEntryModel converts a domain blacklist entry to a GORM model
+func toBlacklistEntryModel(e *blacklist.BlacklistEntry) *BlacklistEntryModel {
+	return &BlacklistEntryModel{
+		ID:        e.ID.String(),
+		MSISDN:    e.MSISDN,
+		Reason:    e.Reason,
+		ExpiresAt: e.ExpiresAt,
+		CreatedBy: e.CreatedBy.String(),
+		UpdatedBy: e.UpdatedBy.String(),
+		CreatedAt: e.CreatedAt,
+		UpdatedAt: e.UpdatedAt,
+	}
+}
+
+// toDomain converts a GORM model to a domain blacklist entry
+func (m *BlacklistEntryModel) toDomain() (*blacklist.BlacklistEntry, error) {
+	id, err := uuid.Parse(m.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Entries created by system jobs may not have a user
+	createdBy, _ := uuid.Parse(m.CreatedBy)
+	updatedBy, _ := uuid.Parse(m.UpdatedBy)
+
+	return &blacklist.BlacklistEntry{
+		ID:        id,
+		MSISDN:    m.MSISDN,
+		Reason:    m.Reason,
+		ExpiresAt: m.ExpiresAt,
+		CreatedBy: createdBy,
+		UpdatedBy: updatedBy,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}, nil
+}
+
+// Create implements the blacklist.BlacklistRepository interface
+func (r *GormBlacklistRepository) Create(entry *blacklist.BlacklistEntry) error {
+	model := toBlacklistEntryModel(entry)
+	result := r.db.Create(model)
+	if result.Error != nil {
+		return fmt.Errorf("failed to create blacklist entry: %w", result.Error)
+	}
+
+	return nil
+}
+
+// GetByID implements the blacklist.BlacklistRepository interface
+func (r *GormBlacklistRepository) GetByID(id uuid.UUID) (*blacklist.BlacklistEntry, error) {
+	var model BlacklistEntryModel
+	result := r.db.First(&model, "id = ?", id.String())
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, blacklist.NewBlacklistError(blacklist.ErrEntryNotFound, "Blacklist entry not found", result.Error)
+		}
+		return nil, fmt.Errorf("failed to get blacklist entry: %w", result.Error)
+	}
+
+	return model.toDomain()
+}
+
+// GetByMSISDN implements the blacklist.BlacklistRepository interface
+func (r *GormBlacklistRepository) GetByMSISDN(msisdn string) (*blacklist.BlacklistEntry, error) {
+	var model BlacklistEntryModel
+	result := r.db.First(&model, "msisdn = ?", msisdn)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, blacklist.NewBlacklistError(blacklist.ErrEntryNotFound, "Blacklist entry not found", result.Error)
+		}
+		return nil, fmt.Errorf("failed to get blacklist entry: %w", result.Error)
+	}
+
+	return model.toDomain()
+}
+
+// List implements the blacklist.BlacklistRepository interface
+func (r *GormBlacklistRepository) List(filters blacklist.BlacklistFilters, page, pageSize int) ([]blacklist.BlacklistEntry, int, error) {
+	var models []BlacklistEntryModel
+	var total int64
+
+	offset := (page - 1) * pageSize
+
+	query := r.db.Model(&BlacklistEntryModel{})
+	if filters.MSISDN != "" {
+		query = query.Where("msisdn LIKE ?", "%"+filters.MSISDN+"%")
+	}
+	if !filters.IncludeExpired {
+		query = query.Where("expires_at IS NULL OR expires_at > ?", time.Now())
+	}
+
+	// Get total count
+	result := query.Count(&total)
+	if result.Error != nil {
+		return nil, 0, fmt.Errorf("failed to count blacklist entries: %w", result.Error)
+	}
+
+	// Get paginated entries
+	result = query.Order("created_at DESC").Offset(offset).Limit(pageSize).Find(&models)
+	if result.Error != nil {
+		return nil, 0, fmt.Errorf("failed to list blacklist entries: %w", result.Error)
+	}
+
+	entries := make([]blacklist.BlacklistEntry, 0, len(models))
+	for _, model := range models {
+		entry, err := model.toDomain()
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to convert blacklist entry model to domain: %w", err)
+		}
+		entries = append(entries, *entry)
+	}
+
+	return entries, int(total), nil
+}
+
+// Update implements the blacklist.BlacklistRepository interface
+func (r *GormBlacklistRepository) Update(entry *blacklist.BlacklistEntry) error {
+	model := toBlacklistEntryModel(entry)
+	result := r.db.Save(model)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update blacklist entry: %w", result.Error)
+	}
+
+	return nil
+}
+
+// Delete implements the blacklist.BlacklistRepository interface
+func (r *GormBlacklistRepository) Delete(id uuid.UUID) error {
+	result := r.db.Delete(&BlacklistEntryModel{}, "id = ?", id.String())
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete blacklist entry: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return blacklist.NewBlacklistError(blacklist.ErrEntryNotFound, "Blacklist entry not found", nil)
+	}
+
+	return nil
+}
+
+// Upsert implements the blacklist.BlacklistRepository interface.
+// Entries for MSISDNs that are already blacklisted replace their reason and expiry.
+func (r *GormBlacklistRepository) Upsert(entries []*blacklist.BlacklistEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	models := make([]*BlacklistEntryModel, 0, len(entries))
+	for _, entry := range entries {
+		models = append(models, toBlacklistEntryModel(entry))
+	}
+
+	result := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "msisdn"}},
+		DoUpdates: clause.AssignmentColumns([]string{"reason", "expires_at", "updated_by", "updated_at"}),
+	}).CreateInBatches(models, blacklistUpsertBatchSize)
+	if result.Error != nil {
+		return fmt.Errorf("failed to upsert blacklist entries: %w", result.Error)
+	}
+
+	return nil
+}
+
+// ListActive implements the blacklist.BlacklistRepository interface
+func (r *GormBlacklistRepository) ListActive(at time.Time) ([]blacklist.BlacklistEntry, error) {
+	var models []BlacklistEntryModel
+	result := r.db.Where("expires_at IS NULL OR expires_at > ?", at).Find(&models)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list active blacklist entries: %w", result.Error)
+	}
+
+	entries := make([]blacklist.BlacklistEntry, 0, len(models))
+	for _, model := range models {
+		entry, err := model.toDomain()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert blacklist entry model to domain: %w", err)
+		}
+		entries = append(entries, *entry)
+	}
+
+	return entries, nil
+}
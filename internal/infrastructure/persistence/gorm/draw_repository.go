@@ -2,12 +2,15 @@ package gorm
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/participant"
@@ -35,10 +38,28 @@ type DrawModel struct {
 	TotalEligibleMSISDNs  int
 	TotalEntries          int
 	ExecutedByAdminID     string    `gorm:"type:uuid"`
+	AlgorithmVersion      string
+	SeedHash              string
+	Seed                  string
+	EntriesHash           string
+	SelectionPlan         string    `gorm:"type:text"`
+	FailureReason         string    `gorm:"type:text"`
+	VoidReason            string
+	VoidedByAdminID       string    `gorm:"type:uuid"`
+	VoidedAt              *time.Time
+	ReplacementDrawID     string    `gorm:"type:uuid"`
 	CreatedAt             time.Time
 	UpdatedAt             time.Time
 }
 
+// DrawEntryModel is the GORM model for the entry snapshot of a draw
+type DrawEntryModel struct {
+	DrawID   string `gorm:"primaryKey;type:uuid"`
+	Position int    `gorm:"primaryKey"`
+	MSISDN   string
+	Points   int
+}
+
 // WinnerModel is the GORM model for winners
 type WinnerModel struct {
 	ID            string    `gorm:"primaryKey;type:uuid"`
@@ -48,13 +69,35 @@ type WinnerModel struct {
 	Status        string
 	PaymentStatus string
 	PaymentNotes  string
+	PaymentReference  string
+	PaymentApprovedBy string `gorm:"type:uuid"`
+	PaymentApprovedAt *time.Time
 	PaidAt        *time.Time
 	IsRunnerUp    bool
 	RunnerUpRank  int
+	ReplacesWinnerID   string `gorm:"type:uuid"`
+	ReplacedByWinnerID string `gorm:"type:uuid"`
+	ReplacementReason  string
+	ReplacedAt         *time.Time
+	ClaimDeadline      *time.Time `gorm:"index"`
+	ClaimedAt          *time.Time
+	NotifiedAt         *time.Time
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
 
+// WinnerPaymentEventModel is the GORM model for a step in paying a winner's prize
+type WinnerPaymentEventModel struct {
+	ID         string `gorm:"primaryKey;type:uuid"`
+	WinnerID   string `gorm:"type:uuid;index"`
+	FromStatus string
+	ToStatus   string
+	ActorID    string `gorm:"type:uuid"`
+	Reference  string
+	Notes      string `gorm:"type:text"`
+	At         time.Time
+}
+
 // TableName returns the table name for the DrawModel
 func (DrawModel) TableName() string {
 	return "draws"
@@ -65,8 +108,25 @@ func (WinnerModel) TableName() string {
 	return "winners"
 }
 
+// TableName returns the table name for the WinnerPaymentEventModel
+func (WinnerPaymentEventModel) TableName() string {
+	return "winner_payment_events"
+}
+
+// TableName returns the table name for the DrawEntryModel
+func (DrawEntryModel) TableName() string {
+	return "draw_entries"
+}
+
 // toModel converts a domain draw entity to a GORM model
 func toDrawModel(d *draw.Draw) *DrawModel {
+	selectionPlan := ""
+	if len(d.SelectionPlan) > 0 {
+		if data, err := json.Marshal(d.SelectionPlan); err == nil {
+			selectionPlan = string(data)
+		}
+	}
+	
 	return &DrawModel{
 		ID:                    d.ID.String(),
 		DrawDate:              d.DrawDate,
@@ -75,6 +135,16 @@ func toDrawModel(d *draw.Draw) *DrawModel {
 		TotalEligibleMSISDNs:  d.TotalEligibleMSISDNs,
 		TotalEntries:          d.TotalEntries,
 		ExecutedByAdminID:     d.ExecutedByAdminID.String(),
+		AlgorithmVersion:      d.AlgorithmVersion,
+		SeedHash:              d.SeedHash,
+		Seed:                  d.Seed,
+		EntriesHash:           d.EntriesHash,
+		SelectionPlan:         selectionPlan,
+		FailureReason:         d.FailureReason,
+		VoidReason:            d.VoidReason,
+		VoidedByAdminID:       d.VoidedByAdminID.String(),
+		VoidedAt:              d.VoidedAt,
+		ReplacementDrawID:     d.ReplacementDrawID.String(),
 		CreatedAt:             d.CreatedAt,
 		UpdatedAt:             d.UpdatedAt,
 	}
@@ -97,6 +167,17 @@ func (m *DrawModel) toDomain() (*draw.Draw, error) {
 		return nil, err
 	}
 	
+	// Only voided and redrawn draws have these set
+	voidedByAdminID, _ := uuid.Parse(m.VoidedByAdminID)
+	replacementDrawID, _ := uuid.Parse(m.ReplacementDrawID)
+	
+	var selectionPlan []draw.TierSelection
+	if m.SelectionPlan != "" {
+		if err := json.Unmarshal([]byte(m.SelectionPlan), &selectionPlan); err != nil {
+			return nil, fmt.Errorf("invalid selection plan: %w", err)
+		}
+	}
+	
 	return &draw.Draw{
 		ID:                    id,
 		DrawDate:              m.DrawDate,
@@ -106,6 +187,16 @@ func (m *DrawModel) toDomain() (*draw.Draw, error) {
 		TotalEntries:          m.TotalEntries,
 		ExecutedByAdminID:     executedByAdminID,
 		ExecutedBy:            executedByAdminID, // Added for application layer compatibility
+		AlgorithmVersion:      m.AlgorithmVersion,
+		SeedHash:              m.SeedHash,
+		Seed:                  m.Seed,
+		EntriesHash:           m.EntriesHash,
+		SelectionPlan:         selectionPlan,
+		FailureReason:         m.FailureReason,
+		VoidReason:            m.VoidReason,
+		VoidedByAdminID:       voidedByAdminID,
+		VoidedAt:              m.VoidedAt,
+		ReplacementDrawID:     replacementDrawID,
 		CreatedAt:             m.CreatedAt,
 		UpdatedAt:             m.UpdatedAt,
 		Winners:               []draw.Winner{}, // Will be populated separately
@@ -122,9 +213,19 @@ func toWinnerModel(w *draw.Winner) *WinnerModel {
 		Status:        w.Status,
 		PaymentStatus: w.PaymentStatus,
 		PaymentNotes:  w.PaymentNotes,
+		PaymentReference:  w.PaymentReference,
+		PaymentApprovedBy: w.PaymentApprovedBy.String(),
+		PaymentApprovedAt: w.PaymentApprovedAt,
 		PaidAt:        w.PaidAt,
 		IsRunnerUp:    w.IsRunnerUp,
 		RunnerUpRank:  w.RunnerUpRank,
+		ReplacesWinnerID:   w.ReplacesWinnerID.String(),
+		ReplacedByWinnerID: w.ReplacedByWinnerID.String(),
+		ReplacementReason:  w.ReplacementReason,
+		ReplacedAt:         w.ReplacedAt,
+		ClaimDeadline:      w.ClaimDeadline,
+		ClaimedAt:          w.ClaimedAt,
+		NotifiedAt:         w.NotifiedAt,
 		CreatedAt:     w.CreatedAt,
 		UpdatedAt:     w.UpdatedAt,
 	}
@@ -147,6 +248,10 @@ func (m *WinnerModel) toDomain() (*draw.Winner, error) {
 		return nil, err
 	}
 	
+	replacesWinnerID, _ := uuid.Parse(m.ReplacesWinnerID)
+	replacedByWinnerID, _ := uuid.Parse(m.ReplacedByWinnerID)
+	paymentApprovedBy, _ := uuid.Parse(m.PaymentApprovedBy)
+	
 	return &draw.Winner{
 		ID:            id,
 		DrawID:        drawID,
@@ -157,9 +262,19 @@ func (m *WinnerModel) toDomain() (*draw.Winner, error) {
 		Status:        m.Status,
 		PaymentStatus: m.PaymentStatus,
 		PaymentNotes:  m.PaymentNotes,
+		PaymentReference:  m.PaymentReference,
+		PaymentApprovedBy: paymentApprovedBy,
+		PaymentApprovedAt: m.PaymentApprovedAt,
 		PaidAt:        m.PaidAt,
 		IsRunnerUp:    m.IsRunnerUp,
 		RunnerUpRank:  m.RunnerUpRank,
+		ReplacesWinnerID:   replacesWinnerID,
+		ReplacedByWinnerID: replacedByWinnerID,
+		ReplacementReason:  m.ReplacementReason,
+		ReplacedAt:         m.ReplacedAt,
+		ClaimDeadline:      m.ClaimDeadline,
+		ClaimedAt:          m.ClaimedAt,
+		NotifiedAt:         m.NotifiedAt,
 		CreatedAt:     m.CreatedAt,
 		UpdatedAt:     m.UpdatedAt,
 	}, nil
@@ -185,6 +300,36 @@ func (r *GormDrawRepository) Create(d *draw.Draw) error {
 	return nil
 }
 
+// LockDate implements the draw.DrawRepository interface. It takes a transaction-level advisory
+// lock keyed on the date, which has no effect outside a transaction.
+func (r *GormDrawRepository) LockDate(date time.Time) error {
+	key := "draw_date:" + date.Format("2006-01-02")
+	if err := r.db.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", key).Error; err != nil {
+		return fmt.Errorf("failed to lock draw date: %w", err)
+	}
+	return nil
+}
+
+// GetByIDForUpdate implements the draw.DrawRepository interface. The draw row stays locked
+// until the enclosing transaction ends; its winners are not loaded.
+func (r *GormDrawRepository) GetByIDForUpdate(id uuid.UUID) (*draw.Draw, error) {
+	var model DrawModel
+	result := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&model, "id = ?", id.String())
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, draw.NewDrawError(draw.ErrDrawNotFound, "Draw not found", result.Error)
+		}
+		return nil, fmt.Errorf("failed to get draw: %w", result.Error)
+	}
+	
+	drawEntity, err := model.toDomain()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert draw model to domain: %w", err)
+	}
+	
+	return drawEntity, nil
+}
+
 // GetByID implements the draw.DrawRepository interface
 func (r *GormDrawRepository) GetByID(id uuid.UUID) (*draw.Draw, error) {
 	var model DrawModel
@@ -260,7 +405,8 @@ func (r *GormDrawRepository) GetByDate(date time.Time) (*draw.Draw, error) {
 	// Format date to match database format (without time component)
 	formattedDate := date.Format("2006-01-02")
 	
-	result := r.db.Where("DATE(draw_date) = ?", formattedDate).First(&model)
+	// A redrawn date has several draws; the latest is the one that stands
+	result := r.db.Where("DATE(draw_date) = ?", formattedDate).Order("created_at DESC").First(&model)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, nil // No draw found for this date, which is not an error
@@ -305,37 +451,25 @@ func (r *GormDrawRepository) Update(d *draw.Draw) error {
 	return nil
 }
 
-// GetEligibilityStats implements the draw.DrawRepository interface
+// GetEligibilityStats implements the draw.DrawRepository interface. MSISDNs and points are
+// counted in one query over the recharges before the day after the date, apart from the
+// participant stream draws are built from, which ExecuteDraw checks against them.
 func (r *GormDrawRepository) GetEligibilityStats(date time.Time) (int, int, error) {
-	// This would typically involve a complex query to the participants table
-	// For demonstration purposes, we'll return placeholder values
-	
 	var totalEligibleMSISDNs int64
 	var totalEntries int64
 	
-	// Format date to match database format (without time component)
-	formattedDate := date.Format("2006-01-02")
+	// Recharges are compared with the start of the next day instead of being truncated to their date
+	nextDay := time.Date(date.Year(), date.Month(), date.Day()+1, 0, 0, 0, 0, time.UTC).Format("2006-01-02")
 	
-	// Count distinct MSISDNs for the given date
-	result := r.db.Table("participants").
-		Where("DATE(recharge_date) <= ?", formattedDate).
-		Distinct("msisdn").
-		Count(&totalEligibleMSISDNs)
-	
-	if result.Error != nil {
-		return 0, 0, fmt.Errorf("failed to count eligible MSISDNs: %w", result.Error)
-	}
-	
-	// Sum points for the given date
-	var err error
-	err = r.db.Table("participants").
-		Where("DATE(recharge_date) <= ?", formattedDate).
-		Select("SUM(points)").
+	// Count distinct MSISDNs and sum points up to the given date
+	err := r.db.Table("participants").
+		Where("recharge_date < ? AND points > 0", nextDay).
+		Select("COUNT(DISTINCT msisdn), COALESCE(SUM(points), 0)").
 		Row().
-		Scan(&totalEntries)
+		Scan(&totalEligibleMSISDNs, &totalEntries)
 	
 	if err != nil {
-		return 0, 0, fmt.Errorf("failed to sum points: %w", err)
+		return 0, 0, fmt.Errorf("failed to count eligible MSISDNs: %w", err)
 	}
 	
 	return int(totalEligibleMSISDNs), int(totalEntries), nil
@@ -368,18 +502,62 @@ func (r *GormDrawRepository) GetWinnerByID(id uuid.UUID) (*draw.Winner, error) {
 		return nil, fmt.Errorf("failed to convert winner model to domain: %w", err)
 	}
 	
+	var eventModels []WinnerPaymentEventModel
+	if err := r.db.Where("winner_id = ?", model.ID).Order("at ASC").Find(&eventModels).Error; err != nil {
+		return nil, fmt.Errorf("failed to get winner payment history: %w", err)
+	}
+	
+	winnerEntity.PaymentHistory = make([]draw.PaymentEvent, 0, len(eventModels))
+	for _, eventModel := range eventModels {
+		id, _ := uuid.Parse(eventModel.ID)
+		actorID, _ := uuid.Parse(eventModel.ActorID)
+		winnerEntity.PaymentHistory = append(winnerEntity.PaymentHistory, draw.PaymentEvent{
+			ID:         id,
+			WinnerID:   winnerEntity.ID,
+			FromStatus: eventModel.FromStatus,
+			ToStatus:   eventModel.ToStatus,
+			ActorID:    actorID,
+			Reference:  eventModel.Reference,
+			Notes:      eventModel.Notes,
+			At:         eventModel.At,
+		})
+	}
+	
 	return winnerEntity, nil
 }
 
-// UpdateWinner implements the draw.DrawRepository interface
+// UpdateWinner implements the draw.DrawRepository interface. The payment history is
+// append-only: events not stored yet are inserted and stored ones are left as they are.
 func (r *GormDrawRepository) UpdateWinner(w *draw.Winner) error {
-	model := toWinnerModel(w)
-	result := r.db.Save(model)
-	if result.Error != nil {
-		return fmt.Errorf("failed to update winner: %w", result.Error)
-	}
-	
-	return nil
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		model := toWinnerModel(w)
+		if err := tx.Save(model).Error; err != nil {
+			return fmt.Errorf("failed to update winner: %w", err)
+		}
+		
+		if len(w.PaymentHistory) == 0 {
+			return nil
+		}
+		
+		eventModels := make([]WinnerPaymentEventModel, 0, len(w.PaymentHistory))
+		for _, event := range w.PaymentHistory {
+			eventModels = append(eventModels, WinnerPaymentEventModel{
+				ID:         event.ID.String(),
+				WinnerID:   w.ID.String(),
+				FromStatus: event.FromStatus,
+				ToStatus:   event.ToStatus,
+				ActorID:    event.ActorID.String(),
+				Reference:  event.Reference,
+				Notes:      event.Notes,
+				At:         event.At,
+			})
+		}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&eventModels).Error; err != nil {
+			return fmt.Errorf("failed to save winner payment history: %w", err)
+		}
+		
+		return nil
+	})
 }
 
 // GetRunnerUps implements the draw.DrawRepository interface
@@ -387,7 +565,7 @@ func (r *GormDrawRepository) GetRunnerUps(drawID uuid.UUID, prizeTierID uuid.UUI
 	var models []WinnerModel
 	result := r.db.Where("draw_id = ? AND prize_tier_id = ? AND is_runner_up = ?", 
 		drawID.String(), prizeTierID.String(), true).
-		Order("runner_up_rank ASC").
+		Order("runner_up_rank ASC, created_at ASC").
 		Limit(limit).
 		Find(&models)
 	
@@ -411,22 +589,230 @@ func (r *GormDrawRepository) GetRunnerUps(drawID uuid.UUID, prizeTierID uuid.UUI
 	return runnerUps, nil
 }
 
+// ListWinnersByPrizeTier implements the draw.DrawRepository interface
+func (r *GormDrawRepository) ListWinnersByPrizeTier(drawID uuid.UUID, prizeTierID uuid.UUID) ([]draw.Winner, error) {
+	var models []WinnerModel
+	result := r.db.Where("draw_id = ? AND prize_tier_id = ?", drawID.String(), prizeTierID.String()).
+		Order("is_runner_up ASC, runner_up_rank ASC, created_at ASC").
+		Find(&models)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list winners: %w", result.Error)
+	}
+	
+	winners := make([]draw.Winner, 0, len(models))
+	for _, model := range models {
+		winner, err := model.toDomain()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert winner model to domain: %w", err)
+		}
+		winners = append(winners, *winner)
+	}
+	
+	return winners, nil
+}
+
+// ListExpiredClaims implements the draw.DrawRepository interface. It returns up to limit
+// winners of completed draws whose claim deadline passed before now without a claim,
+// the earliest deadline first.
+func (r *GormDrawRepository) ListExpiredClaims(now time.Time, limit int) ([]draw.Winner, error) {
+	var models []WinnerModel
+	result := r.db.Model(&WinnerModel{}).
+		Joins("JOIN draws ON draws.id = winners.draw_id").
+		Where("draws.status = ?", draw.StatusCompleted).
+		Where("winners.is_runner_up = ? AND winners.claim_deadline < ?", false, now).
+		Where("winners.status IN ? AND winners.payment_status NOT IN ?",
+			[]string{draw.WinnerStatusPendingNotification, draw.WinnerStatusNotified},
+			[]string{draw.PaymentStatusApproved, draw.PaymentStatusProcessing, draw.PaymentStatusPaid}).
+		Order("winners.claim_deadline ASC").
+		Limit(limit).
+		Find(&models)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list expired claims: %w", result.Error)
+	}
+	
+	winners := make([]draw.Winner, 0, len(models))
+	for _, model := range models {
+		winner, err := model.toDomain()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert winner model to domain: %w", err)
+		}
+		winners = append(winners, *winner)
+	}
+	
+	return winners, nil
+}
+
+// ListWinnersAwaitingNotification implements the draw.DrawRepository interface. It returns
+// prize holders of completed draws that have no notification in the outbox yet, oldest first.
+func (r *GormDrawRepository) ListWinnersAwaitingNotification(limit int) ([]draw.Winner, error) {
+	var models []WinnerModel
+	result := r.db.Model(&WinnerModel{}).
+		Joins("JOIN draws ON draws.id = winners.draw_id").
+		Where("draws.status = ?", draw.StatusCompleted).
+		Where("winners.is_runner_up = ? AND winners.status = ?", false, draw.WinnerStatusPendingNotification).
+		Where("NOT EXISTS (SELECT 1 FROM winner_notifications WHERE winner_notifications.winner_id = winners.id)").
+		Order("winners.created_at ASC").
+		Limit(limit).
+		Find(&models)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list winners awaiting notification: %w", result.Error)
+	}
+	
+	winners := make([]draw.Winner, 0, len(models))
+	for _, model := range models {
+		winner, err := model.toDomain()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert winner model to domain: %w", err)
+		}
+		winners = append(winners, *winner)
+	}
+	
+	return winners, nil
+}
+
+// ListPrizeWinnersByMSISDNs implements the draw.DrawRepository interface. It returns the
+// winners of completed draws with any of the MSISDNs, runner-ups excluded, oldest first.
+func (r *GormDrawRepository) ListPrizeWinnersByMSISDNs(msisdns []string) ([]draw.Winner, error) {
+	if len(msisdns) == 0 {
+		return []draw.Winner{}, nil
+	}
+	
+	var models []WinnerModel
+	result := r.db.Model(&WinnerModel{}).
+		Joins("JOIN draws ON draws.id = winners.draw_id").
+		Where("draws.status = ?", draw.StatusCompleted).
+		Where("winners.is_runner_up = ? AND winners.msisdn IN ?", false, msisdns).
+		Order("winners.created_at ASC").
+		Find(&models)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list winners by MSISDN: %w", result.Error)
+	}
+	
+	winners := make([]draw.Winner, 0, len(models))
+	for _, model := range models {
+		winner, err := model.toDomain()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert winner model to domain: %w", err)
+		}
+		winners = append(winners, *winner)
+	}
+	
+	return winners, nil
+}
+
+// CreateEntries implements the draw.DrawRepository interface
+func (r *GormDrawRepository) CreateEntries(drawID uuid.UUID, entries []draw.Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	
+	models := make([]DrawEntryModel, 0, len(entries))
+	for i, entry := range entries {
+		models = append(models, DrawEntryModel{
+			DrawID:   drawID.String(),
+			Position: i,
+			MSISDN:   entry.MSISDN,
+			Points:   entry.Points,
+		})
+	}
+	
+	result := r.db.CreateInBatches(models, 1000)
+	if result.Error != nil {
+		return fmt.Errorf("failed to create draw entries: %w", result.Error)
+	}
+	
+	return nil
+}
+
+// ListEntries implements the draw.DrawRepository interface
+func (r *GormDrawRepository) ListEntries(drawID uuid.UUID) ([]draw.Entry, error) {
+	var models []DrawEntryModel
+	result := r.db.Where("draw_id = ?", drawID.String()).Order("position ASC").Find(&models)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list draw entries: %w", result.Error)
+	}
+	
+	entries := make([]draw.Entry, 0, len(models))
+	for _, model := range models {
+		entries = append(entries, draw.Entry{
+			MSISDN: model.MSISDN,
+			Points: model.Points,
+		})
+	}
+	
+	return entries, nil
+}
+
+// ListWinningMSISDNs implements the draw.DrawRepository interface.
+// It returns the MSISDNs holding a prize, including promoted runner-ups, from completed
+// draws dated on or after from and before to.
+func (r *GormDrawRepository) ListWinningMSISDNs(from, to time.Time) ([]string, error) {
+	var msisdns []string
+	result := r.db.Model(&WinnerModel{}).
+		Joins("JOIN draws ON draws.id = winners.draw_id").
+		Where("DATE(draws.draw_date) >= ? AND DATE(draws.draw_date) < ?", from.Format("2006-01-02"), to.Format("2006-01-02")).
+		Where("draws.status = ?", draw.StatusCompleted).
+		Where("winners.is_runner_up = ? AND winners.status NOT IN ?", false, []string{draw.WinnerStatusReplaced, draw.WinnerStatusForfeited}).
+		Distinct("winners.msisdn").
+		Pluck("winners.msisdn", &msisdns)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list winning MSISDNs: %w", result.Error)
+	}
+	
+	return msisdns, nil
+}
+
+// winnerSortColumns maps the fields winners can be sorted by to their columns
+var winnerSortColumns = map[string]string{
+	draw.WinnerSortCreatedAt:     "created_at",
+	draw.WinnerSortMSISDN:        "msisdn",
+	draw.WinnerSortStatus:        "status",
+	draw.WinnerSortPaymentStatus: "payment_status",
+	draw.WinnerSortRunnerUpRank:  "runner_up_rank",
+}
+
 // ListWinners implements the draw.Repository interface
-func (r *GormDrawRepository) ListWinners(ctx context.Context, page, pageSize int, startDate, endDate string) ([]*draw.Winner, int, error) {
+func (r *GormDrawRepository) ListWinners(ctx context.Context, filters draw.WinnerFilters, page, pageSize int) ([]*draw.Winner, int, error) {
 	var models []WinnerModel
 	var total int64
 	
 	offset := (page - 1) * pageSize
 	
-	// Build query with date filters if provided
+	// Build query with the filters provided
 	query := r.db.Model(&WinnerModel{})
 	
-	if startDate != "" {
-		query = query.Where("DATE(created_at) >= ?", startDate)
+	if !filters.StartDate.IsZero() {
+		query = query.Where("DATE(created_at) >= ?", filters.StartDate.Format("2006-01-02"))
 	}
 	
-	if endDate != "" {
-		query = query.Where("DATE(created_at) <= ?", endDate)
+	if !filters.EndDate.IsZero() {
+		query = query.Where("DATE(created_at) <= ?", filters.EndDate.Format("2006-01-02"))
+	}
+	
+	if filters.DrawID != uuid.Nil {
+		query = query.Where("draw_id = ?", filters.DrawID.String())
+	}
+	
+	if filters.PrizeTierID != uuid.Nil {
+		query = query.Where("prize_tier_id = ?", filters.PrizeTierID.String())
+	}
+	
+	if filters.Status != "" {
+		query = query.Where("status = ?", filters.Status)
+	}
+	
+	if filters.PaymentStatus != "" {
+		query = query.Where("payment_status = ?", filters.PaymentStatus)
+	}
+	
+	if filters.IsRunnerUp != nil {
+		query = query.Where("is_runner_up = ?", *filters.IsRunnerUp)
+	}
+	
+	if filters.IsMaskedMSISDN() {
+		query = query.Where("msisdn LIKE ?", maskedMSISDNPattern(filters.MSISDN))
+	} else if filters.MSISDN != "" {
+		query = query.Where("msisdn = ?", filters.MSISDN)
 	}
 	
 	// Get total count
@@ -435,8 +821,17 @@ func (r *GormDrawRepository) ListWinners(ctx context.Context, page, pageSize int
 		return nil, 0, fmt.Errorf("failed to count winners: %w", result.Error)
 	}
 	
-	// Get paginated winners
-	result = query.Order("created_at DESC").Offset(offset).Limit(pageSize).Find(&models)
+	sortColumn, ok := winnerSortColumns[filters.SortBy]
+	if !ok {
+		sortColumn = "created_at"
+	}
+	sortOrder := "DESC"
+	if filters.SortOrder == draw.SortOrderAsc {
+		sortOrder = "ASC"
+	}
+	
+	// Get paginated winners, breaking ties by ID so pages do not overlap
+	result = query.Order(sortColumn + " " + sortOrder).Order("id " + sortOrder).Offset(offset).Limit(pageSize).Find(&models)
 	if result.Error != nil {
 		return nil, 0, fmt.Errorf("failed to list winners: %w", result.Error)
 	}
@@ -453,6 +848,28 @@ func (r *GormDrawRepository) ListWinners(ctx context.Context, page, pageSize int
 	return winners, int(total), nil
 }
 
+// maskedMSISDNPattern turns a masked MSISDN such as 234****567 into a LIKE pattern.
+// A run of * hides any number of digits, as masks do not keep the MSISDN's length.
+func maskedMSISDNPattern(masked string) string {
+	var pattern strings.Builder
+	hidden := false
+	for _, r := range masked {
+		if r == '*' {
+			if !hidden {
+				pattern.WriteRune('%')
+			}
+			hidden = true
+			continue
+		}
+		hidden = false
+		if r == '%' || r == '_' || r == '\\' {
+			pattern.WriteRune('\\')
+		}
+		pattern.WriteRune(r)
+	}
+	return pattern.String()
+}
+
 // ExecuteDraw implements the draw.Repository interface
 func (r *GormDrawRepository) ExecuteDraw(drawDate time.Time, prizeStructureID uuid.UUID, executedByAdminID uuid.UUID, eligibleParticipants []participant.Participant, prizeTiers []prize.PrizeTier) (*draw.Draw, error) {
 	// Create a new draw
@@ -461,7 +878,7 @@ func (r *GormDrawRepository) ExecuteDraw(drawDate time.Time, prizeStructureID uu
 		ID:                   drawID,
 		DrawDate:             drawDate,
 		PrizeStructureID:     prizeStructureID,
-		Status:               "Completed",
+		Status:               draw.StatusCompleted,
 		TotalEligibleMSISDNs: len(eligibleParticipants),
 		TotalEntries:         calculateTotalEntries(eligibleParticipants),
 		ExecutedByAdminID:    executedByAdminID,
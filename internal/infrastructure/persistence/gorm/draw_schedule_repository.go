@@ -0,0 +1,176 @@
+package gorm
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/schedule"
+)
+
+// GormDrawScheduleRepository implements the schedule.DrawScheduleRepository interface using GORM
+type GormDrawScheduleRepository struct {
+	db *gorm.DB
+}
+
+// NewGormDrawScheduleRepository creates a new GormDrawScheduleRepository
+func NewGormDrawScheduleRepository(db *gorm.DB) *GormDrawScheduleRepository {
+	return &GormDrawScheduleRepository{
+		db: db,
+	}
+}
+
+// DrawScheduleModel is the GORM model for draw schedules
+type DrawScheduleModel struct {
+	ID               string `gorm:"primaryKey;type:uuid"`
+	Name             string
+	CronExpression   string
+	TimeZone         string
+	PrizeStructureID string `gorm:"type:uuid"`
+	Enabled          bool   `gorm:"index"`
+	LastRunAt        *time.Time
+	LastRunStatus    string
+	LastRunError     string `gorm:"type:text"`
+	LastDrawID       string `gorm:"type:uuid"`
+	CreatedBy        string `gorm:"type:uuid"`
+	UpdatedBy        string `gorm:"type:uuid"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// TableName returns the table name for the DrawScheduleModel
+func (DrawScheduleModel) TableName() string {
+	return "draw_schedules"
+}
+
+// toDrawScheduleModel converts a domain draw schedule to a GORM model
+func toDrawScheduleModel(s *schedule.DrawSchedule) *DrawScheduleModel {
+	return &DrawScheduleModel{
+		ID:               s.ID.String(),
+		Name:             s.Name,
+		CronExpression:   s.CronExpression,
+		TimeZone:         s.TimeZone,
+		PrizeStructureID: s.PrizeStructureID.String(),
+		Enabled:          s.Enabled,
+		LastRunAt:        s.LastRunAt,
+		LastRunStatus:    s.LastRunStatus,
+		LastRunError:     s.LastRunError,
+		LastDrawID:       s.LastDrawID.String(),
+		CreatedBy:        s.CreatedBy.String(),
+		UpdatedBy:        s.UpdatedBy.String(),
+		CreatedAt:        s.CreatedAt,
+		UpdatedAt:        s.UpdatedAt,
+	}
+}
+
+// toDomain converts a GORM model to a domain draw schedule
+func (m *DrawScheduleModel) toDomain() (*schedule.DrawSchedule, error) {
+	id, err := uuid.Parse(m.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional references are stored as the nil UUID
+	prizeStructureID, _ := uuid.Parse(m.PrizeStructureID)
+	lastDrawID, _ := uuid.Parse(m.LastDrawID)
+	createdBy, _ := uuid.Parse(m.CreatedBy)
+	updatedBy, _ := uuid.Parse(m.UpdatedBy)
+
+	return &schedule.DrawSchedule{
+		ID:               id,
+		Name:             m.Name,
+		CronExpression:   m.CronExpression,
+		TimeZone:         m.TimeZone,
+		PrizeStructureID: prizeStructureID,
+		Enabled:          m.Enabled,
+		LastRunAt:        m.LastRunAt,
+		LastRunStatus:    m.LastRunStatus,
+		LastRunError:     m.LastRunError,
+		LastDrawID:       lastDrawID,
+		CreatedBy:        createdBy,
+		UpdatedBy:        updatedBy,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
+	}, nil
+}
+
+// Create implements the schedule.DrawScheduleRepository interface
+func (r *GormDrawScheduleRepository) Create(s *schedule.DrawSchedule) error {
+	result := r.db.Create(toDrawScheduleModel(s))
+	if result.Error != nil {
+		return fmt.Errorf("failed to create draw schedule: %w", result.Error)
+	}
+
+	return nil
+}
+
+// GetByID implements the schedule.DrawScheduleRepository interface
+func (r *GormDrawScheduleRepository) GetByID(id uuid.UUID) (*schedule.DrawSchedule, error) {
+	var model DrawScheduleModel
+	result := r.db.First(&model, "id = ?", id.String())
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, schedule.NewScheduleError(schedule.ErrScheduleNotFound, "Draw schedule not found", result.Error)
+		}
+		return nil, fmt.Errorf("failed to get draw schedule: %w", result.Error)
+	}
+
+	return model.toDomain()
+}
+
+// List implements the schedule.DrawScheduleRepository interface
+func (r *GormDrawScheduleRepository) List() ([]schedule.DrawSchedule, error) {
+	return r.find(r.db.Order("created_at ASC"))
+}
+
+// ListEnabled implements the schedule.DrawScheduleRepository interface
+func (r *GormDrawScheduleRepository) ListEnabled() ([]schedule.DrawSchedule, error) {
+	return r.find(r.db.Where("enabled = ?", true).Order("created_at ASC"))
+}
+
+// find loads the draw schedules matched by a query
+func (r *GormDrawScheduleRepository) find(query *gorm.DB) ([]schedule.DrawSchedule, error) {
+	var models []DrawScheduleModel
+	result := query.Find(&models)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list draw schedules: %w", result.Error)
+	}
+
+	schedules := make([]schedule.DrawSchedule, 0, len(models))
+	for _, model := range models {
+		s, err := model.toDomain()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert draw schedule model to domain: %w", err)
+		}
+		schedules = append(schedules, *s)
+	}
+
+	return schedules, nil
+}
+
+// Update implements the schedule.DrawScheduleRepository interface
+func (r *GormDrawScheduleRepository) Update(s *schedule.DrawSchedule) error {
+	result := r.db.Save(toDrawScheduleModel(s))
+	if result.Error != nil {
+		return fmt.Errorf("failed to update draw schedule: %w", result.Error)
+	}
+
+	return nil
+}
+
+// Delete implements the schedule.DrawScheduleRepository interface
+func (r *GormDrawScheduleRepository) Delete(id uuid.UUID) error {
+	result := r.db.Delete(&DrawScheduleModel{}, "id = ?", id.String())
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete draw schedule: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return schedule.NewScheduleError(schedule.ErrScheduleNotFound, "Draw schedule not found", nil)
+	}
+
+	return nil
+}
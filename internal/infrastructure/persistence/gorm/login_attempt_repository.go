@@ -0,0 +1,100 @@
+package gorm
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
+)
+
+// GormLoginAttemptRepository implements the auth.LoginAttemptRepository interface using GORM
+type GormLoginAttemptRepository struct {
+	db *gorm.DB
+}
+
+// NewGormLoginAttemptRepository creates a new GormLoginAttemptRepository
+func NewGormLoginAttemptRepository(db *gorm.DB) *GormLoginAttemptRepository {
+	return &GormLoginAttemptRepository{
+		db: db,
+	}
+}
+
+// LoginAttemptModel is the GORM model for failed login counts
+type LoginAttemptModel struct {
+	Scope         string `gorm:"primaryKey"`
+	Subject       string `gorm:"primaryKey"`
+	Failures      int
+	LastFailureAt time.Time
+	LockedUntil   *time.Time
+}
+
+// TableName returns the table name for the LoginAttemptModel
+func (LoginAttemptModel) TableName() string {
+	return "login_attempts"
+}
+
+// toDomain converts a GORM model to a domain login attempt
+func (m *LoginAttemptModel) toDomain() *auth.LoginAttempt {
+	return &auth.LoginAttempt{
+		Scope:         m.Scope,
+		Subject:       m.Subject,
+		Failures:      m.Failures,
+		LastFailureAt: m.LastFailureAt,
+		LockedUntil:   m.LockedUntil,
+	}
+}
+
+// Get implements the auth.LoginAttemptRepository interface
+func (r *GormLoginAttemptRepository) Get(scope, subject string) (*auth.LoginAttempt, error) {
+	var model LoginAttemptModel
+	result := r.db.Where("scope = ? AND subject = ?", scope, subject).First(&model)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return &auth.LoginAttempt{Scope: scope, Subject: subject}, nil
+		}
+		return nil, fmt.Errorf("failed to get login attempts: %w", result.Error)
+	}
+
+	return model.toDomain(), nil
+}
+
+// RecordFailure implements the auth.LoginAttemptRepository interface. The count is kept in a
+// single upsert so concurrent failures on different replicas are all counted.
+func (r *GormLoginAttemptRepository) RecordFailure(scope, subject string, now time.Time, policy auth.LockoutPolicy) (*auth.LoginAttempt, error) {
+	var model LoginAttemptModel
+	result := r.db.Raw(`INSERT INTO login_attempts (scope, subject, failures, last_failure_at, locked_until)
+		VALUES (@scope, @subject, 1, @now, CASE WHEN 1 >= @max THEN @lockedUntil::timestamptz END)
+		ON CONFLICT (scope, subject) DO UPDATE SET
+			failures = CASE WHEN login_attempts.last_failure_at < @windowStart THEN 1 ELSE login_attempts.failures + 1 END,
+			last_failure_at = @now,
+			locked_until = CASE
+				WHEN (CASE WHEN login_attempts.last_failure_at < @windowStart THEN 1 ELSE login_attempts.failures + 1 END) >= @max
+					AND (login_attempts.locked_until IS NULL OR login_attempts.locked_until <= @now)
+				THEN @lockedUntil::timestamptz
+				ELSE login_attempts.locked_until END
+		RETURNING scope, subject, failures, last_failure_at, locked_until`,
+		map[string]interface{}{
+			"scope":       scope,
+			"subject":     subject,
+			"now":         now,
+			"windowStart": now.Add(-policy.FailureWindow),
+			"max":         policy.MaxFailures(scope),
+			"lockedUntil": now.Add(policy.LockoutDuration),
+		}).Scan(&model)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to record failed login: %w", result.Error)
+	}
+
+	return model.toDomain(), nil
+}
+
+// Reset implements the auth.LoginAttemptRepository interface
+func (r *GormLoginAttemptRepository) Reset(scope, subject string) error {
+	if err := r.db.Where("scope = ? AND subject = ?", scope, subject).Delete(&LoginAttemptModel{}).Error; err != nil {
+		return fmt.Errorf("failed to reset login attempts: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package gorm
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/notification"
+)
+
+// GormNotificationRepository implements the notification.NotificationRepository interface using GORM
+type GormNotificationRepository struct {
+	db *gorm.DB
+}
+
+// NewGormNotificationRepository creates a new GormNotificationRepository
+func NewGormNotificationRepository(db *gorm.DB) *GormNotificationRepository {
+	return &GormNotificationRepository{
+		db: db,
+	}
+}
+
+// NotificationModel is the GORM model for the winner notification outbox
+type NotificationModel struct {
+	ID                string `gorm:"primaryKey;type:uuid"`
+	WinnerID          string `gorm:"type:uuid;uniqueIndex"`
+	DrawID            string `gorm:"type:uuid;index"`
+	MSISDN            string
+	Channel           string
+	Message           string `gorm:"type:text"`
+	Status            string `gorm:"index:idx_winner_notifications_due,priority:1"`
+	Attempts          int
+	MaxAttempts       int
+	NextAttemptAt     time.Time `gorm:"index:idx_winner_notifications_due,priority:2"`
+	LastError         string    `gorm:"type:text"`
+	ProviderMessageID string    `gorm:"index"`
+	SentAt            *time.Time
+	DeliveredAt       *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// TableName returns the table name for the NotificationModel
+func (NotificationModel) TableName() string {
+	return "winner_notifications"
+}
+
+// toNotificationModel converts a domain notification to a GORM model
+func toNotificationModel(n *notification.Notification) *NotificationModel {
+	return &NotificationModel{
+		ID:                n.ID.String(),
+		WinnerID:          n.WinnerID.String(),
+		DrawID:            n.DrawID.String(),
+		MSISDN:            n.MSISDN,
+		Channel:           n.Channel,
+		Message:           n.Message,
+		Status:            n.Status,
+		Attempts:          n.Attempts,
+		MaxAttempts:       n.MaxAttempts,
+		NextAttemptAt:     n.NextAttemptAt,
+		LastError:         n.LastError,
+		ProviderMessageID: n.ProviderMessageID,
+		SentAt:            n.SentAt,
+		DeliveredAt:       n.DeliveredAt,
+		CreatedAt:         n.CreatedAt,
+		UpdatedAt:         n.UpdatedAt,
+	}
+}
+
+// toDomain converts a GORM model to a domain notification
+func (m *NotificationModel) toDomain() (*notification.Notification, error) {
+	id, err := uuid.Parse(m.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	winnerID, err := uuid.Parse(m.WinnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	drawID, _ := uuid.Parse(m.DrawID)
+
+	return &notification.Notification{
+		ID:                id,
+		WinnerID:          winnerID,
+		DrawID:            drawID,
+		MSISDN:            m.MSISDN,
+		Channel:           m.Channel,
+		Message:           m.Message,
+		Status:            m.Status,
+		Attempts:          m.Attempts,
+		MaxAttempts:       m.MaxAttempts,
+		NextAttemptAt:     m.NextAttemptAt,
+		LastError:         m.LastError,
+		ProviderMessageID: m.ProviderMessageID,
+		SentAt:            m.SentAt,
+		DeliveredAt:       m.DeliveredAt,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
+	}, nil
+}
+
+// Create implements the notification.NotificationRepository interface
+func (r *GormNotificationRepository) Create(n *notification.Notification) (bool, error) {
+	result := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "winner_id"}},
+		DoNothing: true,
+	}).Create(toNotificationModel(n))
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to create notification: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// GetByID implements the notification.NotificationRepository interface
+func (r *GormNotificationRepository) GetByID(id uuid.UUID) (*notification.Notification, error) {
+	return r.getWhere("id = ?", id.String())
+}
+
+// GetByProviderMessageID implements the notification.NotificationRepository interface
+func (r *GormNotificationRepository) GetByProviderMessageID(providerMessageID string) (*notification.Notification, error) {
+	return r.getWhere("provider_message_id = ?", providerMessageID)
+}
+
+// getWhere returns the notification matching a condition
+func (r *GormNotificationRepository) getWhere(query string, args ...interface{}) (*notification.Notification, error) {
+	var model NotificationModel
+	result := r.db.Where(query, args...).First(&model)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, notification.NewNotificationError(notification.ErrNotificationNotFound, "Notification not found", result.Error)
+		}
+		return nil, fmt.Errorf("failed to get notification: %w", result.Error)
+	}
+
+	return model.toDomain()
+}
+
+// ListByWinnerID implements the notification.NotificationRepository interface
+func (r *GormNotificationRepository) ListByWinnerID(winnerID uuid.UUID) ([]notification.Notification, error) {
+	var models []NotificationModel
+	result := r.db.Where("winner_id = ?", winnerID.String()).Order("created_at ASC").Find(&models)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", result.Error)
+	}
+
+	return toNotifications(models)
+}
+
+// ListDue implements the notification.NotificationRepository interface
+func (r *GormNotificationRepository) ListDue(now time.Time, limit int) ([]notification.Notification, error) {
+	var models []NotificationModel
+	result := r.db.
+		Where("status = ? AND next_attempt_at <= ?", notification.StatusPending, now).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Find(&models)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list due notifications: %w", result.Error)
+	}
+
+	return toNotifications(models)
+}
+
+// Update implements the notification.NotificationRepository interface
+func (r *GormNotificationRepository) Update(n *notification.Notification) error {
+	result := r.db.Save(toNotificationModel(n))
+	if result.Error != nil {
+		return fmt.Errorf("failed to update notification: %w", result.Error)
+	}
+
+	return nil
+}
+
+// toNotifications converts GORM models to domain notifications
+func toNotifications(models []NotificationModel) ([]notification.Notification, error) {
+	notifications := make([]notification.Notification, 0, len(models))
+	for _, model := range models {
+		n, err := model.toDomain()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert notification model to domain: %w", err)
+		}
+		notifications = append(notifications, *n)
+	}
+
+	return notifications, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -46,6 +47,7 @@ type UploadAuditModel struct {
 	TotalRows       int
 	SuccessfulRows  int
 	ErrorCount      int
+	DuplicateCount  int
 	ErrorDetails    []string `gorm:"-"` // Not stored directly in the database
 	ErrorDetailsStr string   `gorm:"column:error_details"`
 	CreatedAt       time.Time
@@ -105,13 +107,7 @@ func (m *ParticipantModel) toDomain() (*participant.Participant, error) {
 // toUploadAuditModel converts a domain upload audit entity to a GORM model
 func toUploadAuditModel(a *participant.UploadAudit) *UploadAuditModel {
 	// Convert error details slice to string for storage
-	errorDetailsStr := ""
-	for i, detail := range a.ErrorDetails {
-		if i > 0 {
-			errorDetailsStr += "\n"
-		}
-		errorDetailsStr += detail
-	}
+	errorDetailsStr := strings.Join(a.ErrorDetails, "\n")
 	
 	return &UploadAuditModel{
 		ID:              a.ID.String(),
@@ -122,6 +118,7 @@ func toUploadAuditModel(a *participant.UploadAudit) *UploadAuditModel {
 		TotalRows:       a.TotalRows,
 		SuccessfulRows:  a.SuccessfulRows,
 		ErrorCount:      a.ErrorCount,
+		DuplicateCount:  a.DuplicateCount,
 		ErrorDetails:    a.ErrorDetails,
 		ErrorDetailsStr: errorDetailsStr,
 		CreatedAt:       a.CreatedAt,
@@ -141,10 +138,10 @@ func (m *UploadAuditModel) toDomain() (*participant.UploadAudit, error) {
 		return nil, err
 	}
 	
-	// Convert error details string to slice
+	// Convert error details string to slice, one entry per stored line
 	var errorDetails []string
 	if m.ErrorDetailsStr != "" {
-		errorDetails = []string{m.ErrorDetailsStr}
+		errorDetails = strings.Split(m.ErrorDetailsStr, "\n")
 	} else {
 		errorDetails = []string{}
 	}
@@ -158,6 +155,7 @@ func (m *UploadAuditModel) toDomain() (*participant.UploadAudit, error) {
 		TotalRows:      m.TotalRows,
 		SuccessfulRows: m.SuccessfulRows,
 		ErrorCount:     m.ErrorCount,
+		DuplicateCount: m.DuplicateCount,
 		ErrorDetails:   errorDetails,
 		CreatedAt:      m.CreatedAt,
 		UpdatedAt:      m.UpdatedAt,
@@ -311,6 +309,48 @@ func (r *GormParticipantRepository) ListByDate(date time.Time, page, pageSize in
 	return participants, int(total), nil
 }
 
+// ForEachEligible implements the participant.ParticipantRepository interface. The recharges
+// are summed per MSISDN by the database, and the MSISDNs are paged by key, so no page is
+// skipped or repeated when participants are uploaded meanwhile.
+func (r *GormParticipantRepository) ForEachEligible(date, windowStart time.Time, batchSize int, fn func(batch []participant.RechargeTotals) error) error {
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+	
+	// Format dates to match database format (without time component)
+	formattedDate := date.Format("2006-01-02")
+	formattedWindowStart := windowStart.Format("2006-01-02")
+	
+	lastMSISDN := ""
+	for {
+		var batch []participant.RechargeTotals
+		result := r.db.Model(&ParticipantModel{}).
+			Select("msisdn, SUM(points) AS total_points, SUM(CASE WHEN DATE(recharge_date) >= ? THEN points ELSE 0 END) AS window_points", formattedWindowStart).
+			Where("DATE(recharge_date) <= ? AND points > 0 AND msisdn > ?", formattedDate, lastMSISDN).
+			Group("msisdn").
+			Order("msisdn").
+			Limit(batchSize).
+			Scan(&batch)
+		
+		if result.Error != nil {
+			return fmt.Errorf("failed to stream eligible participants: %w", result.Error)
+		}
+		
+		if len(batch) == 0 {
+			return nil
+		}
+		
+		if err := fn(batch); err != nil {
+			return err
+		}
+		
+		if len(batch) < batchSize {
+			return nil
+		}
+		lastMSISDN = batch[len(batch)-1].MSISDN
+	}
+}
+
 // GetStats implements the participant.ParticipantRepository interface
 func (r *GormParticipantRepository) GetStats(date time.Time) (int, int, float64, error) {
 	var totalParticipants int64
@@ -439,6 +479,36 @@ func (r *GormParticipantRepository) DeleteUpload(ctx context.Context, uploadID u
 	return nil
 }
 
+// CreateUploadAudit implements the participant.ParticipantRepository interface
+func (r *GormParticipantRepository) CreateUploadAudit(audit *participant.UploadAudit) error {
+	model := toUploadAuditModel(audit)
+	result := r.db.Create(model)
+	if result.Error != nil {
+		return fmt.Errorf("failed to create upload audit: %w", result.Error)
+	}
+	
+	return nil
+}
+
+// GetUploadAuditByID implements the participant.ParticipantRepository interface
+func (r *GormParticipantRepository) GetUploadAuditByID(id uuid.UUID) (*participant.UploadAudit, error) {
+	var model UploadAuditModel
+	result := r.db.First(&model, "id = ?", id.String())
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, participant.NewParticipantError(participant.ErrUploadAuditNotFound, "Upload audit not found", result.Error)
+		}
+		return nil, fmt.Errorf("failed to get upload audit: %w", result.Error)
+	}
+	
+	audit, err := model.toDomain()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert upload audit model to domain: %w", err)
+	}
+	
+	return audit, nil
+}
+
 // GetStatsByDate implements the participant.ParticipantRepository interface
 func (r *GormParticipantRepository) GetStatsByDate(date time.Time) (int, int, error) {
 	var totalParticipants int64
@@ -0,0 +1,122 @@
+package gorm
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
+)
+
+// GormPasswordResetTokenRepository implements the auth.PasswordResetTokenRepository interface using GORM
+type GormPasswordResetTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewGormPasswordResetTokenRepository creates a new GormPasswordResetTokenRepository
+func NewGormPasswordResetTokenRepository(db *gorm.DB) *GormPasswordResetTokenRepository {
+	return &GormPasswordResetTokenRepository{
+		db: db,
+	}
+}
+
+// PasswordResetTokenModel is the GORM model for password reset tokens
+type PasswordResetTokenModel struct {
+	ID        string    `gorm:"primaryKey;type:uuid"`
+	UserID    string    `gorm:"type:uuid;index"`
+	TokenHash string    `gorm:"uniqueIndex"`
+	ExpiresAt time.Time `gorm:"index"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// TableName returns the table name for the PasswordResetTokenModel
+func (PasswordResetTokenModel) TableName() string {
+	return "password_reset_tokens"
+}
+
+// toDomain converts a GORM model to a domain password reset token
+func (m *PasswordResetTokenModel) toDomain() (*auth.PasswordResetToken, error) {
+	id, err := uuid.Parse(m.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(m.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.PasswordResetToken{
+		ID:        id,
+		UserID:    userID,
+		TokenHash: m.TokenHash,
+		ExpiresAt: m.ExpiresAt,
+		UsedAt:    m.UsedAt,
+		CreatedAt: m.CreatedAt,
+	}, nil
+}
+
+// Create implements the auth.PasswordResetTokenRepository interface
+func (r *GormPasswordResetTokenRepository) Create(token *auth.PasswordResetToken) error {
+	model := &PasswordResetTokenModel{
+		ID:        token.ID.String(),
+		UserID:    token.UserID.String(),
+		TokenHash: token.TokenHash,
+		ExpiresAt: token.ExpiresAt,
+		UsedAt:    token.UsedAt,
+		CreatedAt: token.CreatedAt,
+	}
+	if err := r.db.Create(model).Error; err != nil {
+		return fmt.Errorf("failed to create password reset token: %w", err)
+	}
+	return nil
+}
+
+// GetByTokenHash implements the auth.PasswordResetTokenRepository interface
+func (r *GormPasswordResetTokenRepository) GetByTokenHash(tokenHash string) (*auth.PasswordResetToken, error) {
+	var model PasswordResetTokenModel
+	result := r.db.Where("token_hash = ?", tokenHash).First(&model)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, auth.NewAuthError(auth.ErrInvalidResetToken, "Password reset token is invalid or has expired", result.Error)
+		}
+		return nil, fmt.Errorf("failed to get password reset token: %w", result.Error)
+	}
+
+	return model.toDomain()
+}
+
+// Use implements the auth.PasswordResetTokenRepository interface. The conditional update
+// lets only one of several concurrent resets with the same token succeed.
+func (r *GormPasswordResetTokenRepository) Use(id uuid.UUID, usedAt time.Time) (bool, error) {
+	result := r.db.Model(&PasswordResetTokenModel{}).
+		Where("id = ? AND used_at IS NULL", id.String()).
+		Update("used_at", usedAt)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to use password reset token: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// InvalidateUserTokens implements the auth.PasswordResetTokenRepository interface
+func (r *GormPasswordResetTokenRepository) InvalidateUserTokens(userID uuid.UUID, at time.Time) error {
+	result := r.db.Model(&PasswordResetTokenModel{}).
+		Where("user_id = ? AND used_at IS NULL", userID.String()).
+		Update("used_at", at)
+	if result.Error != nil {
+		return fmt.Errorf("failed to invalidate password reset tokens: %w", result.Error)
+	}
+	return nil
+}
+
+// DeleteExpired implements the auth.PasswordResetTokenRepository interface
+func (r *GormPasswordResetTokenRepository) DeleteExpired(before time.Time) error {
+	if err := r.db.Where("expires_at < ?", before).Delete(&PasswordResetTokenModel{}).Error; err != nil {
+		return fmt.Errorf("failed to delete expired password reset tokens: %w", err)
+	}
+	return nil
+}
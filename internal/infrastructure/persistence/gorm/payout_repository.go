@@ -0,0 +1,204 @@
+package gorm
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/payout"
+)
+
+// GormPayoutRepository implements the payout.PayoutRepository interface using GORM
+type GormPayoutRepository struct {
+	db *gorm.DB
+}
+
+// NewGormPayoutRepository creates a new GormPayoutRepository
+func NewGormPayoutRepository(db *gorm.DB) *GormPayoutRepository {
+	return &GormPayoutRepository{
+		db: db,
+	}
+}
+
+// PayoutModel is the GORM model for payouts
+type PayoutModel struct {
+	ID                string `gorm:"primaryKey;type:uuid"`
+	WinnerID          string `gorm:"type:uuid;uniqueIndex"`
+	DrawID            string `gorm:"type:uuid;index"`
+	MSISDN            string
+	Method            string
+	Amount            float64
+	Currency          string
+	Status            string `gorm:"index:idx_payouts_unsettled,priority:1"`
+	Provider          string
+	Attempts          int
+	ProviderReference string `gorm:"uniqueIndex:idx_payouts_provider_reference,where:provider_reference <> ''"`
+	ProviderPayoutID  string
+	FailureReason     string    `gorm:"type:text"`
+	NextCheckAt       time.Time `gorm:"index:idx_payouts_unsettled,priority:2"`
+	RequestedBy       string    `gorm:"type:uuid"`
+	CompletedAt       *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// TableName returns the table name for the PayoutModel
+func (PayoutModel) TableName() string {
+	return "payouts"
+}
+
+// toPayoutModel converts a domain payout to a GORM model
+func toPayoutModel(p *payout.Payout) *PayoutModel {
+	return &PayoutModel{
+		ID:                p.ID.String(),
+		WinnerID:          p.WinnerID.String(),
+		DrawID:            p.DrawID.String(),
+		MSISDN:            p.MSISDN,
+		Method:            p.Method,
+		Amount:            p.Amount,
+		Currency:          p.Currency,
+		Status:            p.Status,
+		Provider:          p.Provider,
+		Attempts:          p.Attempts,
+		ProviderReference: p.ProviderReference,
+		ProviderPayoutID:  p.ProviderPayoutID,
+		FailureReason:     p.FailureReason,
+		NextCheckAt:       p.NextCheckAt,
+		RequestedBy:       p.RequestedBy.String(),
+		CompletedAt:       p.CompletedAt,
+		CreatedAt:         p.CreatedAt,
+		UpdatedAt:         p.UpdatedAt,
+	}
+}
+
+// toDomain converts a GORM model to a domain payout
+func (m *PayoutModel) toDomain() (*payout.Payout, error) {
+	id, err := uuid.Parse(m.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	winnerID, err := uuid.Parse(m.WinnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	drawID, _ := uuid.Parse(m.DrawID)
+	requestedBy, _ := uuid.Parse(m.RequestedBy)
+
+	return &payout.Payout{
+		ID:                id,
+		WinnerID:          winnerID,
+		DrawID:            drawID,
+		MSISDN:            m.MSISDN,
+		Method:            m.Method,
+		Amount:            m.Amount,
+		Currency:          m.Currency,
+		Status:            m.Status,
+		Provider:          m.Provider,
+		Attempts:          m.Attempts,
+		ProviderReference: m.ProviderReference,
+		ProviderPayoutID:  m.ProviderPayoutID,
+		FailureReason:     m.FailureReason,
+		NextCheckAt:       m.NextCheckAt,
+		RequestedBy:       requestedBy,
+		CompletedAt:       m.CompletedAt,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
+	}, nil
+}
+
+// Create implements the payout.PayoutRepository interface
+func (r *GormPayoutRepository) Create(p *payout.Payout) (bool, error) {
+	result := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "winner_id"}},
+		DoNothing: true,
+	}).Create(toPayoutModel(p))
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to create payout: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// GetByID implements the payout.PayoutRepository interface
+func (r *GormPayoutRepository) GetByID(id uuid.UUID) (*payout.Payout, error) {
+	return r.getWhere("id = ?", id.String())
+}
+
+// GetByWinnerID implements the payout.PayoutRepository interface
+func (r *GormPayoutRepository) GetByWinnerID(winnerID uuid.UUID) (*payout.Payout, error) {
+	return r.getWhere("winner_id = ?", winnerID.String())
+}
+
+// GetByProviderReference implements the payout.PayoutRepository interface
+func (r *GormPayoutRepository) GetByProviderReference(reference string) (*payout.Payout, error) {
+	return r.getWhere("provider_reference = ?", reference)
+}
+
+// getWhere returns the payout matching a condition
+func (r *GormPayoutRepository) getWhere(query string, args ...interface{}) (*payout.Payout, error) {
+	var model PayoutModel
+	result := r.db.Where(query, args...).First(&model)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, payout.NewPayoutError(payout.ErrPayoutNotFound, "Payout not found", result.Error)
+		}
+		return nil, fmt.Errorf("failed to get payout: %w", result.Error)
+	}
+
+	return model.toDomain()
+}
+
+// ListUnsettled implements the payout.PayoutRepository interface. It returns payouts sent or
+// being sent to their provider whose next check is due, oldest first.
+func (r *GormPayoutRepository) ListUnsettled(now time.Time, limit int) ([]payout.Payout, error) {
+	var models []PayoutModel
+	result := r.db.
+		Where("status IN ? AND provider_reference <> '' AND next_check_at <= ?",
+			[]string{payout.StatusPending, payout.StatusProcessing}, now).
+		Order("next_check_at ASC").
+		Limit(limit).
+		Find(&models)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list unsettled payouts: %w", result.Error)
+	}
+
+	payouts := make([]payout.Payout, 0, len(models))
+	for _, model := range models {
+		p, err := model.toDomain()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert payout model to domain: %w", err)
+		}
+		payouts = append(payouts, *p)
+	}
+
+	return payouts, nil
+}
+
+// Update implements the payout.PayoutRepository interface
+func (r *GormPayoutRepository) Update(p *payout.Payout) error {
+	result := r.db.Save(toPayoutModel(p))
+	if result.Error != nil {
+		return fmt.Errorf("failed to update payout: %w", result.Error)
+	}
+
+	return nil
+}
+
+// SaveAttempt implements the payout.PayoutRepository interface
+func (r *GormPayoutRepository) SaveAttempt(p *payout.Payout, previousAttempts int) (bool, error) {
+	result := r.db.Model(&PayoutModel{}).
+		Where("id = ? AND attempts = ?", p.ID.String(), previousAttempts).
+		Select("*").
+		Updates(toPayoutModel(p))
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to save payout attempt: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
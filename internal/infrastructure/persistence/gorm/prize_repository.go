@@ -1,6 +1,7 @@
 package gorm
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	
+	drawDomain "github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
 	prizeDomain "github.com/ArowuTest/GP-Backend-Promo/internal/domain/prize"
 )
 
@@ -31,6 +33,7 @@ type PrizeStructureModel struct {
 	IsActive    bool
 	ValidFrom   time.Time
 	ValidTo     *time.Time
+	EligibilityRules string `gorm:"type:text"` // JSON encoded draw.EligibilityRules
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	DeletedBy   *string   `gorm:"type:uuid"`
@@ -47,6 +50,9 @@ type PrizeTierModel struct {
 	Value            float64
 	ValueNGN         float64
 	Quantity         int
+	NumberOfRunnerUps *int // Null for tiers saved before the count was stored
+	ClaimWindowHours int
+	NotificationTemplate string `gorm:"type:text"`
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
 }
@@ -80,6 +86,11 @@ func (PrizeModel) TableName() string {
 
 // toPrizeStructureModel converts a domain prize structure entity to a GORM model
 func toPrizeStructureModel(ps *prizeDomain.PrizeStructure) *PrizeStructureModel {
+	eligibilityRules := ""
+	if data, err := json.Marshal(ps.EligibilityRules); err == nil {
+		eligibilityRules = string(data)
+	}
+	
 	return &PrizeStructureModel{
 		ID:          ps.ID.String(),
 		Name:        ps.Name,
@@ -87,6 +98,7 @@ func toPrizeStructureModel(ps *prizeDomain.PrizeStructure) *PrizeStructureModel
 		IsActive:    ps.IsActive,
 		ValidFrom:   ps.ValidFrom,
 		ValidTo:     ps.ValidTo,
+		EligibilityRules: eligibilityRules,
 		CreatedAt:   ps.CreatedAt,
 		UpdatedAt:   ps.UpdatedAt,
 	}
@@ -99,6 +111,14 @@ func (m *PrizeStructureModel) toDomain(prizeTiers []prizeDomain.PrizeTier) (*pri
 		return nil, err
 	}
 	
+	// Structures created before eligibility rules existed use the default rules
+	var eligibilityRules drawDomain.EligibilityRules
+	if m.EligibilityRules != "" {
+		if err := json.Unmarshal([]byte(m.EligibilityRules), &eligibilityRules); err != nil {
+			return nil, fmt.Errorf("invalid eligibility rules: %w", err)
+		}
+	}
+	
 	return &prizeDomain.PrizeStructure{
 		ID:          id,
 		Name:        m.Name,
@@ -107,6 +127,7 @@ func (m *PrizeStructureModel) toDomain(prizeTiers []prizeDomain.PrizeTier) (*pri
 		ValidFrom:   m.ValidFrom,
 		ValidTo:     m.ValidTo,
 		Prizes:      prizeTiers,
+		EligibilityRules: eligibilityRules,
 		CreatedAt:   m.CreatedAt,
 		UpdatedAt:   m.UpdatedAt,
 	}, nil
@@ -114,6 +135,7 @@ func (m *PrizeStructureModel) toDomain(prizeTiers []prizeDomain.PrizeTier) (*pri
 
 // toPrizeTierModel converts a domain prize tier entity to a GORM model
 func toPrizeTierModel(pt *prizeDomain.PrizeTier) *PrizeTierModel {
+	numberOfRunnerUps := pt.NumberOfRunnerUps
 	return &PrizeTierModel{
 		ID:               pt.ID.String(),
 		PrizeStructureID: pt.PrizeStructureID.String(),
@@ -123,6 +145,9 @@ func toPrizeTierModel(pt *prizeDomain.PrizeTier) *PrizeTierModel {
 		Value:            pt.Value,
 		ValueNGN:         pt.ValueNGN,
 		Quantity:         pt.Quantity,
+		NumberOfRunnerUps: &numberOfRunnerUps,
+		ClaimWindowHours: pt.ClaimWindowHours,
+		NotificationTemplate: pt.NotificationTemplate,
 		CreatedAt:        pt.CreatedAt,
 		UpdatedAt:        pt.UpdatedAt,
 	}
@@ -140,6 +165,11 @@ func (m *PrizeTierModel) toDomain() (*prizeDomain.PrizeTier, error) {
 		return nil, err
 	}
 	
+	numberOfRunnerUps := prizeDomain.DefaultNumberOfRunnerUps(m.Quantity)
+	if m.NumberOfRunnerUps != nil {
+		numberOfRunnerUps = *m.NumberOfRunnerUps
+	}
+	
 	return &prizeDomain.PrizeTier{
 		ID:               id,
 		PrizeStructureID: prizeStructureID,
@@ -149,6 +179,9 @@ func (m *PrizeTierModel) toDomain() (*prizeDomain.PrizeTier, error) {
 		Value:            m.Value,
 		ValueNGN:         m.ValueNGN,
 		Quantity:         m.Quantity,
+		NumberOfRunnerUps: numberOfRunnerUps,
+		ClaimWindowHours: m.ClaimWindowHours,
+		NotificationTemplate: m.NotificationTemplate,
 		CreatedAt:        m.CreatedAt,
 		UpdatedAt:        m.UpdatedAt,
 	}, nil
@@ -0,0 +1,228 @@
+package gorm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/report"
+)
+
+// GormReportRepository implements the report.ReportRepository interface using GORM
+type GormReportRepository struct {
+	db *gorm.DB
+}
+
+// NewGormReportRepository creates a new GormReportRepository
+func NewGormReportRepository(db *gorm.DB) *GormReportRepository {
+	return &GormReportRepository{
+		db: db,
+	}
+}
+
+// winnerReportRow is a row of the winners report query
+type winnerReportRow struct {
+	WinnerID         string
+	DrawID           string
+	DrawDate         time.Time
+	MSISDN           string `gorm:"column:msisdn"`
+	PrizeTierName    string
+	PrizeTierRank    int
+	PrizeValue       float64
+	Status           string
+	PaymentStatus    string
+	PaymentReference string
+	IsRunnerUp       bool
+	RunnerUpRank     int
+	ClaimDeadline    *time.Time
+	ClaimedAt        *time.Time
+	PaidAt           *time.Time
+}
+
+// paymentLedgerReportRow is a row of the payment ledger query
+type paymentLedgerReportRow struct {
+	EventID       string
+	WinnerID      string
+	DrawID        string
+	DrawDate      time.Time
+	MSISDN        string `gorm:"column:msisdn"`
+	PrizeTierName string
+	Amount        float64
+	FromStatus    string
+	ToStatus      string
+	ActorID       string
+	Reference     string
+	Notes         string
+	At            time.Time
+}
+
+// prizeLiabilityReportRow is a row of the prize liability query
+type prizeLiabilityReportRow struct {
+	DrawID        string
+	DrawDate      time.Time
+	PrizeTierName string
+	PrizeTierRank int
+	PrizeValue    float64
+	Winners       int
+	Paid          int
+	InPayment     int
+}
+
+// StreamWinners implements the report.ReportRepository interface
+func (r *GormReportRepository) StreamWinners(filters report.Filters, fn func(report.WinnerRow) error) error {
+	query := r.db.Table("winners").
+		Select(`winners.id AS winner_id, winners.draw_id, draws.draw_date, winners.msisdn,
+			COALESCE(prize_tiers.name, '') AS prize_tier_name, COALESCE(prize_tiers.rank, 0) AS prize_tier_rank,
+			COALESCE(prize_tiers.value, 0) AS prize_value, winners.status, winners.payment_status,
+			winners.payment_reference, winners.is_runner_up, winners.runner_up_rank,
+			winners.claim_deadline, winners.claimed_at, winners.paid_at`).
+		Joins("JOIN draws ON draws.id = winners.draw_id").
+		Joins("LEFT JOIN prize_tiers ON prize_tiers.id = winners.prize_tier_id").
+		Where("draws.status = ?", draw.StatusCompleted)
+	query = filterByDraw(query, filters, "DATE(draws.draw_date)").
+		Order("draws.draw_date, winners.draw_id, prize_tier_rank, winners.is_runner_up, winners.runner_up_rank, winners.msisdn")
+
+	rows, err := query.Rows()
+	if err != nil {
+		return fmt.Errorf("failed to query winners report: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row winnerReportRow
+		if err := r.db.ScanRows(rows, &row); err != nil {
+			return fmt.Errorf("failed to read winners report row: %w", err)
+		}
+
+		if err := fn(report.WinnerRow{
+			WinnerID:         parseUUIDOrNil(row.WinnerID),
+			DrawID:           parseUUIDOrNil(row.DrawID),
+			DrawDate:         row.DrawDate,
+			MSISDN:           row.MSISDN,
+			PrizeTierName:    row.PrizeTierName,
+			PrizeTierRank:    row.PrizeTierRank,
+			PrizeValue:       row.PrizeValue,
+			Status:           row.Status,
+			PaymentStatus:    row.PaymentStatus,
+			PaymentReference: row.PaymentReference,
+			IsRunnerUp:       row.IsRunnerUp,
+			RunnerUpRank:     row.RunnerUpRank,
+			ClaimDeadline:    row.ClaimDeadline,
+			ClaimedAt:        row.ClaimedAt,
+			PaidAt:           row.PaidAt,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// StreamPaymentLedger implements the report.ReportRepository interface
+func (r *GormReportRepository) StreamPaymentLedger(filters report.Filters, fn func(report.PaymentLedgerRow) error) error {
+	query := r.db.Table("winner_payment_events").
+		Select(`winner_payment_events.id AS event_id, winner_payment_events.winner_id, winners.draw_id, draws.draw_date,
+			winners.msisdn, COALESCE(prize_tiers.name, '') AS prize_tier_name, COALESCE(prize_tiers.value, 0) AS amount,
+			winner_payment_events.from_status, winner_payment_events.to_status, winner_payment_events.actor_id,
+			winner_payment_events.reference, winner_payment_events.notes, winner_payment_events.at`).
+		Joins("JOIN winners ON winners.id = winner_payment_events.winner_id").
+		Joins("JOIN draws ON draws.id = winners.draw_id").
+		Joins("LEFT JOIN prize_tiers ON prize_tiers.id = winners.prize_tier_id")
+	query = filterByDraw(query, filters, "DATE(winner_payment_events.at)").
+		Order("winner_payment_events.at, winner_payment_events.id")
+
+	rows, err := query.Rows()
+	if err != nil {
+		return fmt.Errorf("failed to query payment ledger: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row paymentLedgerReportRow
+		if err := r.db.ScanRows(rows, &row); err != nil {
+			return fmt.Errorf("failed to read payment ledger row: %w", err)
+		}
+
+		if err := fn(report.PaymentLedgerRow{
+			EventID:       parseUUIDOrNil(row.EventID),
+			WinnerID:      parseUUIDOrNil(row.WinnerID),
+			DrawID:        parseUUIDOrNil(row.DrawID),
+			DrawDate:      row.DrawDate,
+			MSISDN:        row.MSISDN,
+			PrizeTierName: row.PrizeTierName,
+			Amount:        row.Amount,
+			FromStatus:    row.FromStatus,
+			ToStatus:      row.ToStatus,
+			ActorID:       parseUUIDOrNil(row.ActorID),
+			Reference:     row.Reference,
+			Notes:         row.Notes,
+			At:            row.At,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// ListPrizeLiability implements the report.ReportRepository interface
+func (r *GormReportRepository) ListPrizeLiability(filters report.Filters) ([]report.PrizeLiabilityRow, error) {
+	query := r.db.Table("winners").
+		Select(`winners.draw_id, draws.draw_date, COALESCE(prize_tiers.name, '') AS prize_tier_name,
+			COALESCE(prize_tiers.rank, 0) AS prize_tier_rank, COALESCE(prize_tiers.value, 0) AS prize_value,
+			COUNT(*) AS winners,
+			SUM(CASE WHEN winners.payment_status = ? THEN 1 ELSE 0 END) AS paid,
+			SUM(CASE WHEN winners.payment_status IN ? THEN 1 ELSE 0 END) AS in_payment`,
+			draw.PaymentStatusPaid, []string{draw.PaymentStatusApproved, draw.PaymentStatusProcessing}).
+		Joins("JOIN draws ON draws.id = winners.draw_id").
+		Joins("LEFT JOIN prize_tiers ON prize_tiers.id = winners.prize_tier_id").
+		Where("draws.status = ?", draw.StatusCompleted).
+		Where("winners.is_runner_up = ? AND winners.status NOT IN ?", false, []string{draw.WinnerStatusReplaced, draw.WinnerStatusForfeited})
+	query = filterByDraw(query, filters, "DATE(draws.draw_date)").
+		Group("winners.draw_id, draws.draw_date, prize_tiers.id, prize_tiers.name, prize_tiers.rank, prize_tiers.value").
+		Order("draws.draw_date, winners.draw_id, prize_tier_rank")
+
+	var rows []prizeLiabilityReportRow
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to query prize liability: %w", err)
+	}
+
+	liability := make([]report.PrizeLiabilityRow, 0, len(rows))
+	for _, row := range rows {
+		liability = append(liability, report.PrizeLiabilityRow{
+			DrawID:        parseUUIDOrNil(row.DrawID),
+			DrawDate:      row.DrawDate,
+			PrizeTierName: row.PrizeTierName,
+			PrizeTierRank: row.PrizeTierRank,
+			PrizeValue:    row.PrizeValue,
+			Winners:       row.Winners,
+			Paid:          row.Paid,
+			InPayment:     row.InPayment,
+		})
+	}
+
+	return liability, nil
+}
+
+// filterByDraw applies the draw and date range of the report filters, dating rows by dateColumn
+func filterByDraw(query *gorm.DB, filters report.Filters, dateColumn string) *gorm.DB {
+	if filters.DrawID != uuid.Nil {
+		query = query.Where("winners.draw_id = ?", filters.DrawID.String())
+	}
+	if !filters.StartDate.IsZero() {
+		query = query.Where(dateColumn+" >= ?", filters.StartDate.Format("2006-01-02"))
+	}
+	if !filters.EndDate.IsZero() {
+		query = query.Where(dateColumn+" <= ?", filters.EndDate.Format("2006-01-02"))
+	}
+	return query
+}
+
+// parseUUIDOrNil parses a UUID column, returning uuid.Nil for empty or invalid values
+func parseUUIDOrNil(s string) uuid.UUID {
+	id, _ := uuid.Parse(s)
+	return id
+}
@@ -0,0 +1,214 @@
+package gorm
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
+)
+
+// GormTokenRepository implements the auth.TokenRepository interface using GORM
+type GormTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewGormTokenRepository creates a new GormTokenRepository
+func NewGormTokenRepository(db *gorm.DB) *GormTokenRepository {
+	return &GormTokenRepository{
+		db: db,
+	}
+}
+
+// RefreshTokenModel is the GORM model for refresh tokens
+type RefreshTokenModel struct {
+	ID              string `gorm:"primaryKey;type:uuid"`
+	UserID          string `gorm:"type:uuid;index"`
+	FamilyID        string `gorm:"type:uuid;index"`
+	TokenHash       string `gorm:"uniqueIndex"`
+	TokenVersion    int
+	AccessTokenID   string
+	AccessExpiresAt time.Time
+	ExpiresAt       time.Time `gorm:"index"`
+	UsedAt          *time.Time
+	RevokedAt       *time.Time
+	CreatedAt       time.Time
+}
+
+// TableName returns the table name for the RefreshTokenModel
+func (RefreshTokenModel) TableName() string {
+	return "refresh_tokens"
+}
+
+// RevokedTokenModel is the GORM model for revoked access tokens
+type RevokedTokenModel struct {
+	TokenID   string    `gorm:"primaryKey"`
+	UserID    string    `gorm:"type:uuid"`
+	ExpiresAt time.Time `gorm:"index"`
+	RevokedAt time.Time
+}
+
+// TableName returns the table name for the RevokedTokenModel
+func (RevokedTokenModel) TableName() string {
+	return "revoked_tokens"
+}
+
+// toRefreshTokenModel converts a domain refresh token to a GORM model
+func toRefreshTokenModel(t *auth.RefreshToken) *RefreshTokenModel {
+	return &RefreshTokenModel{
+		ID:              t.ID.String(),
+		UserID:          t.UserID.String(),
+		FamilyID:        t.FamilyID.String(),
+		TokenHash:       t.TokenHash,
+		TokenVersion:    t.TokenVersion,
+		AccessTokenID:   t.AccessTokenID,
+		AccessExpiresAt: t.AccessExpiresAt,
+		ExpiresAt:       t.ExpiresAt,
+		UsedAt:          t.UsedAt,
+		RevokedAt:       t.RevokedAt,
+		CreatedAt:       t.CreatedAt,
+	}
+}
+
+// toDomain converts a GORM model to a domain refresh token
+func (m *RefreshTokenModel) toDomain() (*auth.RefreshToken, error) {
+	id, err := uuid.Parse(m.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(m.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	familyID, err := uuid.Parse(m.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.RefreshToken{
+		ID:              id,
+		UserID:          userID,
+		FamilyID:        familyID,
+		TokenHash:       m.TokenHash,
+		TokenVersion:    m.TokenVersion,
+		AccessTokenID:   m.AccessTokenID,
+		AccessExpiresAt: m.AccessExpiresAt,
+		ExpiresAt:       m.ExpiresAt,
+		UsedAt:          m.UsedAt,
+		RevokedAt:       m.RevokedAt,
+		CreatedAt:       m.CreatedAt,
+	}, nil
+}
+
+// CreateRefreshToken implements the auth.TokenRepository interface
+func (r *GormTokenRepository) CreateRefreshToken(token *auth.RefreshToken) error {
+	if err := r.db.Create(toRefreshTokenModel(token)).Error; err != nil {
+		return fmt.Errorf("failed to create refresh token: %w", err)
+	}
+	return nil
+}
+
+// GetRefreshTokenByHash implements the auth.TokenRepository interface
+func (r *GormTokenRepository) GetRefreshTokenByHash(tokenHash string) (*auth.RefreshToken, error) {
+	var model RefreshTokenModel
+	result := r.db.Where("token_hash = ?", tokenHash).First(&model)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, auth.NewAuthError(auth.ErrInvalidRefreshToken, "Refresh token not found", result.Error)
+		}
+		return nil, fmt.Errorf("failed to get refresh token: %w", result.Error)
+	}
+
+	return model.toDomain()
+}
+
+// UseRefreshToken implements the auth.TokenRepository interface. The conditional update
+// lets only one of several concurrent refreshes with the same token succeed.
+func (r *GormTokenRepository) UseRefreshToken(id uuid.UUID, usedAt time.Time) (bool, error) {
+	result := r.db.Model(&RefreshTokenModel{}).
+		Where("id = ? AND used_at IS NULL AND revoked_at IS NULL", id.String()).
+		Update("used_at", usedAt)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to use refresh token: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// RevokeFamily implements the auth.TokenRepository interface
+func (r *GormTokenRepository) RevokeFamily(familyID uuid.UUID, revokedAt time.Time) error {
+	return r.revokeWhere("family_id = ?", familyID.String(), revokedAt)
+}
+
+// RevokeUserTokens implements the auth.TokenRepository interface
+func (r *GormTokenRepository) RevokeUserTokens(userID uuid.UUID, revokedAt time.Time) error {
+	return r.revokeWhere("user_id = ?", userID.String(), revokedAt)
+}
+
+// revokeWhere revokes the refresh tokens matching a condition and adds the access tokens
+// issued with them that have not yet expired to the revocation list
+func (r *GormTokenRepository) revokeWhere(query string, arg interface{}, revokedAt time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`INSERT INTO revoked_tokens (token_id, user_id, expires_at, revoked_at)
+			SELECT access_token_id, user_id, access_expires_at, ? FROM refresh_tokens
+			WHERE `+query+` AND access_token_id <> '' AND access_expires_at > ?
+			ON CONFLICT (token_id) DO NOTHING`, revokedAt, arg, revokedAt).Error; err != nil {
+			return fmt.Errorf("failed to revoke access tokens: %w", err)
+		}
+
+		if err := tx.Model(&RefreshTokenModel{}).
+			Where(query+" AND revoked_at IS NULL", arg).
+			Update("revoked_at", revokedAt).Error; err != nil {
+			return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// RevokeAccessToken implements the auth.TokenRepository interface
+func (r *GormTokenRepository) RevokeAccessToken(token auth.RevokedToken) error {
+	result := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "token_id"}},
+		DoNothing: true,
+	}).Create(&RevokedTokenModel{
+		TokenID:   token.TokenID,
+		UserID:    token.UserID.String(),
+		ExpiresAt: token.ExpiresAt,
+		RevokedAt: token.RevokedAt,
+	})
+	if result.Error != nil {
+		return fmt.Errorf("failed to revoke access token: %w", result.Error)
+	}
+
+	return nil
+}
+
+// IsAccessTokenRevoked implements the auth.TokenRepository interface
+func (r *GormTokenRepository) IsAccessTokenRevoked(tokenID string) (bool, error) {
+	var count int64
+	if err := r.db.Model(&RevokedTokenModel{}).Where("token_id = ?", tokenID).Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check token revocation: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// DeleteExpired implements the auth.TokenRepository interface
+func (r *GormTokenRepository) DeleteExpired(before time.Time) error {
+	if err := r.db.Where("expires_at < ?", before).Delete(&RefreshTokenModel{}).Error; err != nil {
+		return fmt.Errorf("failed to delete expired refresh tokens: %w", err)
+	}
+
+	if err := r.db.Where("expires_at < ?", before).Delete(&RevokedTokenModel{}).Error; err != nil {
+		return fmt.Errorf("failed to delete expired revoked tokens: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package gorm
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
+)
+
+// GormTwoFactorRepository implements the auth.TwoFactorRepository interface using GORM
+type GormTwoFactorRepository struct {
+	db *gorm.DB
+}
+
+// NewGormTwoFactorRepository creates a new GormTwoFactorRepository
+func NewGormTwoFactorRepository(db *gorm.DB) *GormTwoFactorRepository {
+	return &GormTwoFactorRepository{
+		db: db,
+	}
+}
+
+// TwoFactorModel is the GORM model for TOTP second factors
+type TwoFactorModel struct {
+	UserID       string `gorm:"primaryKey;type:uuid"`
+	Secret       string `gorm:"not null"`
+	EnabledAt    *time.Time
+	LastUsedStep int64 `gorm:"not null;default:0"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// TableName returns the table name for the TwoFactorModel
+func (TwoFactorModel) TableName() string {
+	return "user_two_factors"
+}
+
+// RecoveryCodeModel is the GORM model for recovery codes
+type RecoveryCodeModel struct {
+	ID        string `gorm:"primaryKey;type:uuid"`
+	UserID    string `gorm:"type:uuid;index"`
+	CodeHash  string `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// TableName returns the table name for the RecoveryCodeModel
+func (RecoveryCodeModel) TableName() string {
+	return "recovery_codes"
+}
+
+// toDomain converts a GORM model to a domain second factor
+func (m *TwoFactorModel) toDomain() (*auth.TwoFactor, error) {
+	userID, err := uuid.Parse(m.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.TwoFactor{
+		UserID:       userID,
+		Secret:       m.Secret,
+		EnabledAt:    m.EnabledAt,
+		LastUsedStep: m.LastUsedStep,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}, nil
+}
+
+// GetTwoFactor implements the auth.TwoFactorRepository interface
+func (r *GormTwoFactorRepository) GetTwoFactor(userID uuid.UUID) (*auth.TwoFactor, error) {
+	var model TwoFactorModel
+	result := r.db.Where("user_id = ?", userID.String()).First(&model)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, auth.NewAuthError(auth.ErrTwoFactorNotEnrolled, "Two-factor authentication is not set up", result.Error)
+		}
+		return nil, fmt.Errorf("failed to get two-factor authentication: %w", result.Error)
+	}
+
+	return model.toDomain()
+}
+
+// SaveTwoFactor implements the auth.TwoFactorRepository interface
+func (r *GormTwoFactorRepository) SaveTwoFactor(twoFactor *auth.TwoFactor) error {
+	model := &TwoFactorModel{
+		UserID:       twoFactor.UserID.String(),
+		Secret:       twoFactor.Secret,
+		EnabledAt:    twoFactor.EnabledAt,
+		LastUsedStep: twoFactor.LastUsedStep,
+		CreatedAt:    twoFactor.CreatedAt,
+		UpdatedAt:    twoFactor.UpdatedAt,
+	}
+
+	result := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"secret", "enabled_at", "last_used_step", "created_at", "updated_at"}),
+	}).Create(model)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save two-factor authentication: %w", result.Error)
+	}
+	return nil
+}
+
+// EnableTwoFactor implements the auth.TwoFactorRepository interface
+func (r *GormTwoFactorRepository) EnableTwoFactor(userID uuid.UUID, enabledAt time.Time, step int64, recoveryCodeHashes []string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// Only a pending enrollment can be enabled
+		result := tx.Model(&TwoFactorModel{}).
+			Where("user_id = ? AND enabled_at IS NULL", userID.String()).
+			Updates(map[string]interface{}{
+				"enabled_at":     enabledAt,
+				"last_used_step": step,
+				"updated_at":     enabledAt,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to enable two-factor authentication: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return auth.NewAuthError(auth.ErrTwoFactorNotEnrolled, "No pending two-factor enrollment", nil)
+		}
+
+		if err := tx.Where("user_id = ?", userID.String()).Delete(&RecoveryCodeModel{}).Error; err != nil {
+			return fmt.Errorf("failed to delete recovery codes: %w", err)
+		}
+
+		models := make([]RecoveryCodeModel, len(recoveryCodeHashes))
+		for i, hash := range recoveryCodeHashes {
+			models[i] = RecoveryCodeModel{
+				ID:        uuid.New().String(),
+				UserID:    userID.String(),
+				CodeHash:  hash,
+				CreatedAt: enabledAt,
+			}
+		}
+		if len(models) > 0 {
+			if err := tx.Create(&models).Error; err != nil {
+				return fmt.Errorf("failed to create recovery codes: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// DeleteTwoFactor implements the auth.TwoFactorRepository interface
+func (r *GormTwoFactorRepository) DeleteTwoFactor(userID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID.String()).Delete(&RecoveryCodeModel{}).Error; err != nil {
+			return fmt.Errorf("failed to delete recovery codes: %w", err)
+		}
+		if err := tx.Where("user_id = ?", userID.String()).Delete(&TwoFactorModel{}).Error; err != nil {
+			return fmt.Errorf("failed to delete two-factor authentication: %w", err)
+		}
+		return nil
+	})
+}
+
+// UseStep implements the auth.TwoFactorRepository interface. The conditional update lets only
+// one of several concurrent requests with the same code succeed.
+func (r *GormTwoFactorRepository) UseStep(userID uuid.UUID, step int64) (bool, error) {
+	result := r.db.Model(&TwoFactorModel{}).
+		Where("user_id = ? AND last_used_step < ?", userID.String(), step).
+		Updates(map[string]interface{}{
+			"last_used_step": step,
+			"updated_at":     time.Now(),
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to use TOTP code: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// UseRecoveryCode implements the auth.TwoFactorRepository interface
+func (r *GormTwoFactorRepository) UseRecoveryCode(userID uuid.UUID, codeHash string, usedAt time.Time) (bool, error) {
+	result := r.db.Model(&RecoveryCodeModel{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID.String(), codeHash).
+		Update("used_at", usedAt)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to use recovery code: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
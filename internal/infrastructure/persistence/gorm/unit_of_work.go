@@ -0,0 +1,26 @@
+package gorm
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+)
+
+// GormUnitOfWork implements the draw.UnitOfWork interface using GORM transactions
+type GormUnitOfWork struct {
+	db *gorm.DB
+}
+
+// NewGormUnitOfWork creates a new GormUnitOfWork
+func NewGormUnitOfWork(db *gorm.DB) *GormUnitOfWork {
+	return &GormUnitOfWork{
+		db: db,
+	}
+}
+
+// Do implements the draw.UnitOfWork interface
+func (u *GormUnitOfWork) Do(fn func(drawRepository draw.DrawRepository) error) error {
+	return u.db.Transaction(func(tx *gorm.DB) error {
+		return fn(NewGormDrawRepository(tx))
+	})
+}
@@ -34,6 +34,7 @@ type UserModel struct {
 	PasswordHash string
 	LastLogin    *time.Time
 	IsActive     bool
+	TokenVersion int `gorm:"not null;default:0"`
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
@@ -54,6 +55,7 @@ func toUserModel(u *user.User) *UserModel {
 		PasswordHash: u.PasswordHash,
 		LastLogin:    u.LastLogin,
 		IsActive:     u.IsActive,
+		TokenVersion: u.TokenVersion,
 		CreatedAt:    u.CreatedAt,
 		UpdatedAt:    u.UpdatedAt,
 	}
@@ -66,15 +68,22 @@ func (m *UserModel) toDomain() (*user.User, error) {
 		return nil, err
 	}
 	
+	// Rows not yet migrated may hold a legacy role spelling
+	role := m.Role
+	if canonical, ok := user.NormalizeRole(role); ok {
+		role = canonical
+	}
+	
 	return &user.User{
 		ID:           id,
 		Email:        m.Email,
 		Username:     m.Username,
 		FullName:     m.FullName,
-		Role:         m.Role,
+		Role:         role,
 		PasswordHash: m.PasswordHash,
 		LastLogin:    m.LastLogin,
 		IsActive:     m.IsActive,
+		TokenVersion: m.TokenVersion,
 		CreatedAt:    m.CreatedAt,
 		UpdatedAt:    m.UpdatedAt,
 	}, nil
@@ -232,3 +241,28 @@ func (r *GormUserRepository) VerifyCredentials(email, password string) (*user.Us
 	
 	return userEntity, nil
 }
+
+// MigrateLegacyRoles rewrites roles stored with a legacy spelling, such as "super_admin",
+// to their canonical form. Roles that match no defined role are left for an admin to fix.
+func (r *GormUserRepository) MigrateLegacyRoles() (int64, error) {
+	var roles []string
+	if err := r.db.Model(&UserModel{}).Distinct("role").Pluck("role", &roles).Error; err != nil {
+		return 0, fmt.Errorf("failed to list user roles: %w", err)
+	}
+	
+	var migrated int64
+	for _, role := range roles {
+		canonical, ok := user.NormalizeRole(role)
+		if !ok || canonical == role {
+			continue
+		}
+		
+		result := r.db.Model(&UserModel{}).Where("role = ?", role).Update("role", canonical)
+		if result.Error != nil {
+			return migrated, fmt.Errorf("failed to migrate role %s: %w", role, result.Error)
+		}
+		migrated += result.RowsAffected
+	}
+	
+	return migrated, nil
+}
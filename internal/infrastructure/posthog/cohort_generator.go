@@ -41,12 +41,15 @@ func (cg *CohortGenerator) EnsureDailyCohort(ctx context.Context, date time.Time
 	}
 	
 	// Create filters for the cohort
-	// These filters define eligibility criteria for the draw
+	// These match the participants the Postgres path considers: points on or before
+	// the draw date. Persons flagged as blacklisted in PostHog are left out as well.
+	// The blacklist and the prize structure's eligibility rules are applied afterwards
+	// by draw.EligibilityEvaluator, so both data sources exclude the same MSISDNs.
 	filters := []Filter{
 		{
 			Property: "recharge_date",
 			Operator: "is_date_before",
-			Value:    dateStr,
+			Value:    date.AddDate(0, 0, 1).Format("2006-01-02"),
 			Type:     "person",
 		},
 		{
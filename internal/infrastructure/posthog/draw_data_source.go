@@ -7,21 +7,26 @@ import (
 	
 	"github.com/google/uuid"
 	
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/blacklist"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/participant"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
 )
 
 // PostHogDataSource implements the DrawDataSource interface using PostHog as the data source
 type PostHogDataSource struct {
-	client          PostHogClientInterface
-	cohortGenerator *CohortGenerator
+	client              PostHogClientInterface
+	cohortGenerator     *CohortGenerator
+	blacklistRepository blacklist.BlacklistRepository
 }
 
-// NewPostHogDataSource creates a new PostHogDataSource
-func NewPostHogDataSource(client PostHogClientInterface, cohortGenerator *CohortGenerator) *PostHogDataSource {
+// NewPostHogDataSource creates a new PostHogDataSource. The cohort only leaves out persons
+// flagged as blacklisted in PostHog; blacklistRepository supplies the blacklist
+// GetEligibleEntries excludes the other blacklisted MSISDNs with.
+func NewPostHogDataSource(client PostHogClientInterface, cohortGenerator *CohortGenerator, blacklistRepository blacklist.BlacklistRepository) *PostHogDataSource {
 	return &PostHogDataSource{
-		client:          client,
-		cohortGenerator: cohortGenerator,
+		client:              client,
+		cohortGenerator:     cohortGenerator,
+		blacklistRepository: blacklistRepository,
 	}
 }
 
@@ -61,13 +66,21 @@ func (ds *PostHogDataSource) GetEligibleParticipants(ctx context.Context, date t
 			}
 		}
 		
+		// Extract recharge date from properties, falling back to the draw date
+		rechargeDate := date
+		if dateVal, ok := person.Properties["recharge_date"].(string); ok {
+			if d, err := parsePersonDate(dateVal); err == nil {
+				rechargeDate = d
+			}
+		}
+		
 		// Create participant entity
 		participant := participant.Participant{
 			ID:             uuid.New(),
 			MSISDN:         msisdn,
 			Points:         points,
 			RechargeAmount: rechargeAmount,
-			RechargeDate:   date,
+			RechargeDate:   rechargeDate,
 			CreatedAt:      time.Now(),
 			UpdatedAt:      time.Now(),
 		}
@@ -78,6 +91,34 @@ func (ds *PostHogDataSource) GetEligibleParticipants(ctx context.Context, date t
 	return participants, nil
 }
 
+// GetEligibleEntries applies a prize structure's eligibility rules to the daily cohort.
+// recentWinners are the MSISDNs that won within the rules' cool-down period. MSISDNs on the
+// blacklist are excluded in addition to those listed in the rules, as for Postgres participants.
+func (ds *PostHogDataSource) GetEligibleEntries(
+	ctx context.Context,
+	date time.Time,
+	rules draw.EligibilityRules,
+	recentWinners []string,
+) (*draw.EligibilityResult, error) {
+	blacklisted, err := ds.blacklistRepository.ListActive(time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blacklist: %w", err)
+	}
+	rules = rules.WithBlacklistedMSISDNs(blacklist.MSISDNs(blacklisted))
+	
+	participants, err := ds.GetEligibleParticipants(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+	
+	evaluator := draw.NewEligibilityEvaluator(rules, date, recentWinners)
+	for _, p := range participants {
+		evaluator.Add(p.MSISDN, p.Points, p.RechargeDate)
+	}
+	
+	return evaluator.Result(), nil
+}
+
 // GetParticipantEntries calculates the number of entries a participant has based on points
 func (ds *PostHogDataSource) GetParticipantEntries(ctx context.Context, msisdn string) (int, error) {
 	// This would typically query PostHog for the participant's points
@@ -116,3 +157,11 @@ func (ds *PostHogDataSource) RecordDrawResult(ctx context.Context, drawID string
 	
 	return nil
 }
+
+// parsePersonDate parses a date person property, which PostHog stores either as a date or a timestamp
+func parsePersonDate(value string) (time.Time, error) {
+	if d, err := time.Parse("2006-01-02", value); err == nil {
+		return d, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
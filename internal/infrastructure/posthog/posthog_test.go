@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/blacklist"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/infrastructure/posthog"
 )
@@ -52,6 +53,17 @@ func (m *MockPostHogClient) CaptureEvent(ctx context.Context, distinctID string,
 	return args.Error(0)
 }
 
+// MockBlacklistRepository is a mock implementation of the blacklist repository
+type MockBlacklistRepository struct {
+	blacklist.BlacklistRepository
+	mock.Mock
+}
+
+func (m *MockBlacklistRepository) ListActive(at time.Time) ([]blacklist.BlacklistEntry, error) {
+	args := m.Called(at)
+	return args.Get(0).([]blacklist.BlacklistEntry), args.Error(1)
+}
+
 // TestPostHogDataSource_GetEligibleParticipants tests the GetEligibleParticipants method
 func TestPostHogDataSource_GetEligibleParticipants(t *testing.T) {
 	// Create mock client
@@ -93,7 +105,7 @@ func TestPostHogDataSource_GetEligibleParticipants(t *testing.T) {
 	
 	// Create cohort generator and data source
 	cohortGenerator := posthog.NewCohortGenerator(mockClient)
-	dataSource := posthog.NewPostHogDataSource(mockClient, cohortGenerator)
+	dataSource := posthog.NewPostHogDataSource(mockClient, cohortGenerator, new(MockBlacklistRepository))
 	
 	// Call the method being tested
 	participants, err := dataSource.GetEligibleParticipants(context.Background(), testDate)
@@ -111,6 +123,120 @@ func TestPostHogDataSource_GetEligibleParticipants(t *testing.T) {
 	mockClient.AssertExpectations(t)
 }
 
+// TestPostHogDataSource_GetEligibleEntries tests that eligibility rules are applied to the cohort
+func TestPostHogDataSource_GetEligibleEntries(t *testing.T) {
+	// Create mock client
+	mockClient := new(MockPostHogClient)
+	
+	// Create test date
+	testDate := time.Date(2025, 5, 20, 0, 0, 0, 0, time.UTC)
+	cohortID := uuid.New().String()
+	
+	// Setup mock expectations
+	mockClient.On("ListCohorts", mock.Anything).Return([]posthog.Cohort{}, nil)
+	mockClient.On("CreateCohort", mock.Anything, "Eligible Participants - 2025-05-20", mock.Anything).Return(cohortID, nil)
+	
+	// One person per rule, plus one eligible person
+	person := func(msisdn string, points float64, rechargeDate string) posthog.Person {
+		return posthog.Person{
+			ID:         uuid.New().String(),
+			DistinctID: msisdn,
+			Properties: map[string]interface{}{
+				"points":        points,
+				"recharge_date": rechargeDate,
+			},
+			CreatedAt: time.Now(),
+		}
+	}
+	testPersons := []posthog.Person{
+		person("2347000000001", 5, "2025-05-20"),
+		person("2347000000002", 3, "2025-05-10"),
+		person("2347000000003", 4, "2025-05-19"),
+		person("2347000000004", 2, "2025-05-20T10:30:00Z"),
+		person("2347000000005", 1, "2025-05-18"),
+	}
+	
+	mockClient.On("GetCohortPersons", mock.Anything, cohortID).Return(testPersons, nil)
+	
+	// Create cohort generator and data source
+	cohortGenerator := posthog.NewCohortGenerator(mockClient)
+	mockBlacklist := new(MockBlacklistRepository)
+	mockBlacklist.On("ListActive", mock.Anything).Return([]blacklist.BlacklistEntry{}, nil)
+	dataSource := posthog.NewPostHogDataSource(mockClient, cohortGenerator, mockBlacklist)
+	
+	rules := draw.EligibilityRules{
+		RechargeWindowDays: 7,
+		MinimumPoints:      2,
+		BlacklistedMSISDNs: []string{"2347000000003"},
+		WinCooldownDays:    7,
+	}
+	
+	// Call the method being tested
+	result, err := dataSource.GetEligibleEntries(context.Background(), testDate, rules, []string{"2347000000004"})
+	
+	// Assert expectations
+	assert.NoError(t, err)
+	assert.Equal(t, []draw.Entry{{MSISDN: "2347000000001", Points: 5}}, result.Entries)
+	assert.Equal(t, 5, result.TotalEntries)
+	assert.Equal(t, 5, result.CandidateMSISDNs)
+	assert.Equal(t, 15, result.CandidateEntries)
+	assert.Equal(t, []draw.RuleExclusion{
+		{Rule: draw.RuleRechargeWindow, ExcludedMSISDNs: 1},
+		{Rule: draw.RuleBlacklist, ExcludedMSISDNs: 1},
+		{Rule: draw.RuleWinCooldown, ExcludedMSISDNs: 1},
+		{Rule: draw.RuleMinimumPoints, ExcludedMSISDNs: 1},
+	}, result.Exclusions)
+	
+	mockClient.AssertExpectations(t)
+}
+
+// TestPostHogDataSource_GetEligibleEntriesExcludesBlacklist tests that MSISDNs on the blacklist
+// are excluded even though the cohort includes them and the rules do not list them
+func TestPostHogDataSource_GetEligibleEntriesExcludesBlacklist(t *testing.T) {
+	// Create mock client
+	mockClient := new(MockPostHogClient)
+	
+	// Create test date
+	testDate := time.Date(2025, 5, 20, 0, 0, 0, 0, time.UTC)
+	cohortID := uuid.New().String()
+	
+	// Setup mock expectations
+	mockClient.On("ListCohorts", mock.Anything).Return([]posthog.Cohort{}, nil)
+	mockClient.On("CreateCohort", mock.Anything, "Eligible Participants - 2025-05-20", mock.Anything).Return(cohortID, nil)
+	mockClient.On("GetCohortPersons", mock.Anything, cohortID).Return([]posthog.Person{
+		{ID: uuid.New().String(), DistinctID: "2347000000001", Properties: map[string]interface{}{"points": float64(5)}},
+		{ID: uuid.New().String(), DistinctID: "2347000000002", Properties: map[string]interface{}{"points": float64(8)}},
+		{ID: uuid.New().String(), DistinctID: "2347000000003", Properties: map[string]interface{}{"points": float64(2)}},
+	}, nil)
+	
+	mockBlacklist := new(MockBlacklistRepository)
+	mockBlacklist.On("ListActive", mock.Anything).Return([]blacklist.BlacklistEntry{
+		{ID: uuid.New(), MSISDN: "2347000000002"},
+	}, nil)
+	
+	// Create cohort generator and data source
+	cohortGenerator := posthog.NewCohortGenerator(mockClient)
+	dataSource := posthog.NewPostHogDataSource(mockClient, cohortGenerator, mockBlacklist)
+	
+	rules := draw.EligibilityRules{
+		BlacklistedMSISDNs: []string{"2347000000003"},
+	}
+	
+	// Call the method being tested
+	result, err := dataSource.GetEligibleEntries(context.Background(), testDate, rules, nil)
+	
+	// Assert expectations
+	assert.NoError(t, err)
+	assert.Equal(t, []draw.Entry{{MSISDN: "2347000000001", Points: 5}}, result.Entries)
+	assert.Contains(t, result.Exclusions, draw.RuleExclusion{Rule: draw.RuleBlacklist, ExcludedMSISDNs: 2})
+	
+	// The rules passed in are left as they were
+	assert.Equal(t, []string{"2347000000003"}, rules.BlacklistedMSISDNs)
+	
+	mockClient.AssertExpectations(t)
+	mockBlacklist.AssertExpectations(t)
+}
+
 // TestPostHogDataSource_RecordDrawResult tests the RecordDrawResult method
 func TestPostHogDataSource_RecordDrawResult(t *testing.T) {
 	// Create mock client
@@ -153,7 +279,7 @@ func TestPostHogDataSource_RecordDrawResult(t *testing.T) {
 	
 	// Create cohort generator and data source
 	cohortGenerator := posthog.NewCohortGenerator(mockClient)
-	dataSource := posthog.NewPostHogDataSource(mockClient, cohortGenerator)
+	dataSource := posthog.NewPostHogDataSource(mockClient, cohortGenerator, new(MockBlacklistRepository))
 	
 	// Call the method being tested
 	err := dataSource.RecordDrawResult(context.Background(), drawID, testWinners)
@@ -174,9 +300,17 @@ func TestCohortGenerator_EnsureDailyCohort(t *testing.T) {
 	cohortName := "Eligible Participants - " + testDateStr
 	cohortID := uuid.New().String()
 	
-	// Test case 1: Cohort doesn't exist yet
+	// Test case 1: Cohort doesn't exist yet; persons blacklisted in PostHog are filtered out
+	blacklistFilter := posthog.Filter{Property: "blacklisted", Operator: "is_not", Value: true, Type: "person"}
 	mockClient.On("ListCohorts", mock.Anything).Return([]posthog.Cohort{}, nil).Once()
-	mockClient.On("CreateCohort", mock.Anything, cohortName, mock.Anything).Return(cohortID, nil).Once()
+	mockClient.On("CreateCohort", mock.Anything, cohortName, mock.MatchedBy(func(filters []posthog.Filter) bool {
+		for _, filter := range filters {
+			if filter == blacklistFilter {
+				return true
+			}
+		}
+		return false
+	})).Return(cohortID, nil).Once()
 	
 	// Create cohort generator
 	cohortGenerator := posthog.NewCohortGenerator(mockClient)
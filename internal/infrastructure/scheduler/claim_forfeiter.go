@@ -0,0 +1,65 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	drawApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/draw"
+)
+
+// ClaimForfeiter periodically forfeits the prizes of winners whose claim window has closed
+type ClaimForfeiter struct {
+	forfeiter *drawApp.ForfeitExpiredClaimsService
+	interval  time.Duration
+}
+
+// NewClaimForfeiter creates a new ClaimForfeiter that checks for expired claims every interval
+func NewClaimForfeiter(forfeiter *drawApp.ForfeitExpiredClaimsService, interval time.Duration) *ClaimForfeiter {
+	return &ClaimForfeiter{
+		forfeiter: forfeiter,
+		interval:  interval,
+	}
+}
+
+// Start checks for expired claims straight away and then every interval until ctx is
+// cancelled. It returns a channel that is closed once the forfeiter has stopped.
+func (f *ClaimForfeiter) Start(ctx context.Context) <-chan struct{} {
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(f.interval)
+		defer ticker.Stop()
+
+		for {
+			f.runOnce(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return done
+}
+
+// runOnce forfeits one batch of expired claims, logging the outcome of each
+func (f *ClaimForfeiter) runOnce(ctx context.Context) {
+	output, err := f.forfeiter.ForfeitExpiredClaims(ctx, time.Now())
+	if err != nil {
+		log.Printf("Claim forfeiter: %v", err)
+		return
+	}
+
+	for _, forfeiture := range output.Forfeitures {
+		if forfeiture.Error != "" {
+			log.Printf("Claim forfeiter: winner %s, draw %s: %s", forfeiture.WinnerID, forfeiture.DrawID, forfeiture.Error)
+			continue
+		}
+		log.Printf("Claim forfeiter: winner %s, draw %s forfeited, promoted runner-up %s", forfeiture.WinnerID, forfeiture.DrawID, forfeiture.PromotedWinnerID)
+	}
+}
@@ -0,0 +1,72 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	scheduleApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/schedule"
+)
+
+// DrawScheduler periodically executes the draws of due draw schedules
+type DrawScheduler struct {
+	runner   *scheduleApp.RunDueDrawSchedulesService
+	interval time.Duration
+}
+
+// NewDrawScheduler creates a new DrawScheduler that checks for due schedules every interval
+func NewDrawScheduler(runner *scheduleApp.RunDueDrawSchedulesService, interval time.Duration) *DrawScheduler {
+	return &DrawScheduler{
+		runner:   runner,
+		interval: interval,
+	}
+}
+
+// Start checks for due schedules straight away, which catches up runs missed while the
+// server was down, and then every interval until ctx is cancelled. It returns a channel
+// that is closed once the scheduler has stopped.
+func (s *DrawScheduler) Start(ctx context.Context) <-chan struct{} {
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			s.runOnce(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return done
+}
+
+// runOnce runs the due schedules, logging the outcome of each draw
+func (s *DrawScheduler) runOnce(ctx context.Context) {
+	output, err := s.runner.RunDueDrawSchedules(ctx, time.Now())
+	if err != nil {
+		log.Printf("Draw scheduler: %v", err)
+	}
+	if output == nil || !output.LockAcquired {
+		return
+	}
+
+	for _, run := range output.Runs {
+		if run.DrawDate.IsZero() {
+			log.Printf("Draw scheduler: schedule %s: %s: %s", run.ScheduleID, run.Status, run.Error)
+			continue
+		}
+		if run.Error != "" {
+			log.Printf("Draw scheduler: schedule %s, draw date %s: %s: %s", run.ScheduleID, run.DrawDate.Format("2006-01-02"), run.Status, run.Error)
+			continue
+		}
+		log.Printf("Draw scheduler: schedule %s, draw date %s: %s, draw %s", run.ScheduleID, run.DrawDate.Format("2006-01-02"), run.Status, run.DrawID)
+	}
+}
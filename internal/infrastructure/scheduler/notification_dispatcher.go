@@ -0,0 +1,66 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	notificationApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/notification"
+)
+
+// NotificationDispatcher periodically notifies winners through the notification outbox
+type NotificationDispatcher struct {
+	dispatcher *notificationApp.DispatchWinnerNotificationsService
+	interval   time.Duration
+}
+
+// NewNotificationDispatcher creates a new NotificationDispatcher that dispatches the outbox every interval
+func NewNotificationDispatcher(dispatcher *notificationApp.DispatchWinnerNotificationsService, interval time.Duration) *NotificationDispatcher {
+	return &NotificationDispatcher{
+		dispatcher: dispatcher,
+		interval:   interval,
+	}
+}
+
+// Start dispatches the outbox straight away and then every interval until ctx is
+// cancelled. It returns a channel that is closed once the dispatcher has stopped.
+func (d *NotificationDispatcher) Start(ctx context.Context) <-chan struct{} {
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(d.interval)
+		defer ticker.Stop()
+
+		for {
+			d.runOnce(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return done
+}
+
+// runOnce dispatches one batch, logging what happened
+func (d *NotificationDispatcher) runOnce(ctx context.Context) {
+	output, err := d.dispatcher.DispatchWinnerNotifications(ctx, time.Now())
+	if err != nil {
+		log.Printf("Notification dispatcher: %v", err)
+	}
+	if output == nil || !output.LockAcquired {
+		return
+	}
+
+	for _, issue := range output.Issues {
+		log.Printf("Notification dispatcher: winner %s, notification %s: %s", issue.WinnerID, issue.NotificationID, issue.Error)
+	}
+	if output.Enqueued+output.Sent+output.Retrying+output.Failed > 0 {
+		log.Printf("Notification dispatcher: %d enqueued, %d sent, %d retrying, %d failed", output.Enqueued, output.Sent, output.Retrying, output.Failed)
+	}
+}
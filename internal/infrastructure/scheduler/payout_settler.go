@@ -0,0 +1,66 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	payoutApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/payout"
+)
+
+// PayoutSettler periodically checks unsettled payouts with their providers
+type PayoutSettler struct {
+	settler  *payoutApp.SettlePayoutsService
+	interval time.Duration
+}
+
+// NewPayoutSettler creates a new PayoutSettler that settles payouts every interval
+func NewPayoutSettler(settler *payoutApp.SettlePayoutsService, interval time.Duration) *PayoutSettler {
+	return &PayoutSettler{
+		settler:  settler,
+		interval: interval,
+	}
+}
+
+// Start settles payouts straight away and then every interval until ctx is
+// cancelled. It returns a channel that is closed once the settler has stopped.
+func (s *PayoutSettler) Start(ctx context.Context) <-chan struct{} {
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			s.runOnce(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return done
+}
+
+// runOnce settles one batch, logging what happened
+func (s *PayoutSettler) runOnce(ctx context.Context) {
+	output, err := s.settler.SettlePayouts(ctx, time.Now())
+	if err != nil {
+		log.Printf("Payout settler: %v", err)
+	}
+	if output == nil || !output.LockAcquired {
+		return
+	}
+
+	for _, issue := range output.Issues {
+		log.Printf("Payout settler: payout %s: %s", issue.PayoutID, issue.Error)
+	}
+	if output.Succeeded+output.Failed > 0 {
+		log.Printf("Payout settler: %d checked, %d succeeded, %d failed", output.Checked, output.Succeeded, output.Failed)
+	}
+}
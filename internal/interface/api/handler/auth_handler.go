@@ -0,0 +1,379 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	userApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/user"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/user"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/dto/request"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/dto/response"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/util"
+)
+
+// AuthHandler handles session HTTP requests: refreshing tokens, logging out, lifting login
+// lockouts, managing two-factor authentication and recovering forgotten passwords
+type AuthHandler struct {
+	refreshTokenService     *userApp.RefreshTokenService
+	logoutService           *userApp.LogoutService
+	unlockUserService       *userApp.UnlockUserService
+	twoFactorService        *userApp.TwoFactorService
+	passwordRecoveryService *userApp.PasswordRecoveryService
+}
+
+// NewAuthHandler creates a new AuthHandler
+func NewAuthHandler(
+	refreshTokenService *userApp.RefreshTokenService,
+	logoutService *userApp.LogoutService,
+	unlockUserService *userApp.UnlockUserService,
+	twoFactorService *userApp.TwoFactorService,
+	passwordRecoveryService *userApp.PasswordRecoveryService,
+) *AuthHandler {
+	return &AuthHandler{
+		refreshTokenService:     refreshTokenService,
+		logoutService:           logoutService,
+		unlockUserService:       unlockUserService,
+		twoFactorService:        twoFactorService,
+		passwordRecoveryService: passwordRecoveryService,
+	}
+}
+
+// RefreshToken handles POST /api/v1/auth/refresh
+func (h *AuthHandler) RefreshToken(c *gin.Context) {
+	var req request.RefreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid request: " + err.Error(),
+		})
+		return
+	}
+
+	output, err := h.refreshTokenService.RefreshToken(c.Request.Context(), userApp.RefreshTokenInput{
+		RefreshToken: req.RefreshToken,
+		IPAddress:    c.ClientIP(),
+		UserAgent:    c.Request.UserAgent(),
+	})
+	if err != nil {
+		writeAuthError(c, "Failed to refresh token", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Data: response.LoginResponse{
+			Token: output.Tokens.AccessToken,
+			User: response.UserResponse{
+				ID:       output.User.ID.String(),
+				Username: output.User.Username,
+				Email:    output.User.Email,
+				Role:     output.User.Role,
+				IsActive: true,
+				FullName: output.User.Username,
+			},
+			Expiry:        util.FormatTimeOrEmpty(output.Tokens.AccessExpiresAt, time.RFC3339),
+			RefreshToken:  output.Tokens.RefreshToken,
+			RefreshExpiry: util.FormatTimeOrEmpty(output.Tokens.RefreshExpiresAt, time.RFC3339),
+		},
+	})
+}
+
+// Logout handles POST /api/v1/auth/logout. It revokes the access token of the request
+// and every token of its session.
+func (h *AuthHandler) Logout(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	// Tokens issued before sessions existed carry no sid
+	sessionID, _ := uuid.Parse(c.GetString("sessionID"))
+
+	if err := h.logoutService.Logout(c.Request.Context(), userApp.LogoutInput{
+		UserID:          userID,
+		SessionID:       sessionID,
+		AccessTokenID:   c.GetString("tokenID"),
+		AccessExpiresAt: c.GetTime("tokenExpiresAt"),
+	}); err != nil {
+		writeAuthError(c, "Failed to log out", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Logged out",
+	})
+}
+
+// UnlockUser handles POST /api/v1/admin/users/:id/unlock
+func (h *AuthHandler) UnlockUser(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid user ID",
+			Details: "User ID must be a valid UUID",
+		})
+		return
+	}
+
+	// The body is optional
+	var req request.UnlockUserRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, response.ErrorResponse{
+				Success: false,
+				Error:   "Invalid request: " + err.Error(),
+			})
+			return
+		}
+	}
+
+	adminID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.unlockUserService.UnlockUser(c.Request.Context(), userApp.UnlockUserInput{
+		UserID:     userID,
+		IPAddress:  req.IPAddress,
+		UnlockedBy: adminID,
+	}); err != nil {
+		writeAuthError(c, "Failed to unlock user", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "User unlocked",
+	})
+}
+
+// EnrollTwoFactor handles POST /api/v1/auth/2fa/enroll
+func (h *AuthHandler) EnrollTwoFactor(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	output, err := h.twoFactorService.EnrollTwoFactor(c.Request.Context(), userID)
+	if err != nil {
+		writeAuthError(c, "Failed to start two-factor enrollment", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Scan the provisioning URI with an authenticator app and confirm with its first code",
+		Data: response.TwoFactorEnrollmentResponse{
+			Secret:          output.Secret,
+			ProvisioningURI: output.ProvisioningURI,
+		},
+	})
+}
+
+// ConfirmTwoFactor handles POST /api/v1/auth/2fa/confirm
+func (h *AuthHandler) ConfirmTwoFactor(c *gin.Context) {
+	var req request.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid request: " + err.Error(),
+		})
+		return
+	}
+
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	output, err := h.twoFactorService.ConfirmTwoFactor(c.Request.Context(), userApp.ConfirmTwoFactorInput{
+		UserID: userID,
+		Code:   req.Code,
+	})
+	if err != nil {
+		writeAuthError(c, "Failed to enable two-factor authentication", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Two-factor authentication enabled. Store the recovery codes safely, they are not shown again",
+		Data: response.RecoveryCodesResponse{
+			RecoveryCodes: output.RecoveryCodes,
+		},
+	})
+}
+
+// DisableTwoFactor handles POST /api/v1/auth/2fa/disable
+func (h *AuthHandler) DisableTwoFactor(c *gin.Context) {
+	var req request.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid request: " + err.Error(),
+		})
+		return
+	}
+
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.twoFactorService.DisableTwoFactor(c.Request.Context(), userApp.DisableTwoFactorInput{
+		UserID:    userID,
+		Code:      req.Code,
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}); err != nil {
+		writeAuthError(c, "Failed to disable two-factor authentication", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Two-factor authentication disabled",
+	})
+}
+
+// ResetTwoFactor handles POST /api/v1/admin/users/:id/2fa/reset
+func (h *AuthHandler) ResetTwoFactor(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid user ID",
+			Details: "User ID must be a valid UUID",
+		})
+		return
+	}
+
+	adminID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.twoFactorService.ResetTwoFactor(c.Request.Context(), userApp.ResetTwoFactorInput{
+		UserID:  userID,
+		ResetBy: adminID,
+	}); err != nil {
+		writeAuthError(c, "Failed to reset two-factor authentication", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Two-factor authentication reset",
+	})
+}
+
+// ForgotPassword handles POST /api/v1/auth/forgot-password. It answers alike whether or not
+// the email is registered.
+func (h *AuthHandler) ForgotPassword(c *gin.Context) {
+	var req request.ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid request: " + err.Error(),
+		})
+		return
+	}
+
+	if err := h.passwordRecoveryService.ForgotPassword(c.Request.Context(), userApp.ForgotPasswordInput{
+		Email:     req.Email,
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}); err != nil {
+		writeAuthError(c, "Failed to request password reset", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "If the email belongs to an active account, a password reset link has been sent to it",
+	})
+}
+
+// ResetForgottenPassword handles POST /api/v1/auth/reset-password
+func (h *AuthHandler) ResetForgottenPassword(c *gin.Context) {
+	var req request.ResetForgottenPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid request: " + err.Error(),
+		})
+		return
+	}
+
+	if err := h.passwordRecoveryService.ResetForgottenPassword(c.Request.Context(), userApp.ResetForgottenPasswordInput{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+		IPAddress:   c.ClientIP(),
+		UserAgent:   c.Request.UserAgent(),
+	}); err != nil {
+		writeAuthError(c, "Failed to reset password", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Password reset, please log in with the new password",
+	})
+}
+
+// writeAuthError writes an auth error with the status matching its code
+func writeAuthError(c *gin.Context, message string, err error) {
+	var userErr *user.UserError
+	if errors.As(err, &userErr) && (userErr.Code == user.ErrUserNotFound || userErr.Code == user.ErrInvalidPassword || userErr.Code == user.ErrInsufficientRole) {
+		status := http.StatusNotFound
+		switch userErr.Code {
+		case user.ErrInvalidPassword:
+			status = http.StatusBadRequest
+		case user.ErrInsufficientRole:
+			status = http.StatusForbidden
+		}
+		c.JSON(status, response.ErrorResponse{
+			Success: false,
+			Error:   message + ": " + userErr.Error(),
+			Details: userErr.Code,
+		})
+		return
+	}
+
+	var authErr *auth.AuthError
+	if !errors.As(err, &authErr) {
+		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+			Success: false,
+			Error:   message + ": " + err.Error(),
+		})
+		return
+	}
+
+	status := http.StatusBadRequest
+	switch authErr.Code {
+	case auth.ErrInvalidRefreshToken, auth.ErrRefreshTokenReused, auth.ErrTokenRevoked,
+		auth.ErrInvalidLoginChallenge, auth.ErrInvalidTwoFactorCode:
+		status = http.StatusUnauthorized
+	case auth.ErrTwoFactorRequired:
+		status = http.StatusForbidden
+	case auth.ErrTwoFactorAlreadyEnabled:
+		status = http.StatusConflict
+	case auth.ErrAccountLocked:
+		status = http.StatusLocked
+	case auth.ErrLoginThrottled, auth.ErrPasswordResetThrottled:
+		status = http.StatusTooManyRequests
+	}
+
+	c.JSON(status, response.ErrorResponse{
+		Success: false,
+		Error:   message + ": " + authErr.Error(),
+		Details: authErr.Code,
+	})
+}
@@ -0,0 +1,388 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	blacklistApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/blacklist"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/blacklist"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/dto/request"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/dto/response"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/spreadsheet"
+)
+
+// BlacklistHandler handles blacklist-related HTTP requests
+type BlacklistHandler struct {
+	createBlacklistEntryService *blacklistApp.CreateBlacklistEntryService
+	getBlacklistEntryService    *blacklistApp.GetBlacklistEntryService
+	listBlacklistEntriesService *blacklistApp.ListBlacklistEntriesService
+	updateBlacklistEntryService *blacklistApp.UpdateBlacklistEntryService
+	deleteBlacklistEntryService *blacklistApp.DeleteBlacklistEntryService
+	importBlacklistService      *blacklistApp.ImportBlacklistService
+}
+
+// NewBlacklistHandler creates a new BlacklistHandler
+func NewBlacklistHandler(
+	createBlacklistEntryService *blacklistApp.CreateBlacklistEntryService,
+	getBlacklistEntryService *blacklistApp.GetBlacklistEntryService,
+	listBlacklistEntriesService *blacklistApp.ListBlacklistEntriesService,
+	updateBlacklistEntryService *blacklistApp.UpdateBlacklistEntryService,
+	deleteBlacklistEntryService *blacklistApp.DeleteBlacklistEntryService,
+	importBlacklistService *blacklistApp.ImportBlacklistService,
+) *BlacklistHandler {
+	return &BlacklistHandler{
+		createBlacklistEntryService: createBlacklistEntryService,
+		getBlacklistEntryService:    getBlacklistEntryService,
+		listBlacklistEntriesService: listBlacklistEntriesService,
+		updateBlacklistEntryService: updateBlacklistEntryService,
+		deleteBlacklistEntryService: deleteBlacklistEntryService,
+		importBlacklistService:      importBlacklistService,
+	}
+}
+
+// ListBlacklistEntries handles GET /api/admin/blacklist
+func (h *BlacklistHandler) ListBlacklistEntries(c *gin.Context) {
+	// Parse pagination parameters
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+	if err != nil || pageSize < 1 {
+		pageSize = 10
+	}
+
+	includeExpired, _ := strconv.ParseBool(c.DefaultQuery("includeExpired", "false"))
+
+	output, err := h.listBlacklistEntriesService.ListBlacklistEntries(c.Request.Context(), blacklistApp.ListBlacklistEntriesInput{
+		Page:           page,
+		PageSize:       pageSize,
+		MSISDN:         c.Query("msisdn"),
+		IncludeExpired: includeExpired,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+			Success: false,
+			Error:   "Failed to list blacklist entries: " + err.Error(),
+		})
+		return
+	}
+
+	now := time.Now()
+	entries := make([]response.BlacklistEntryResponse, 0, len(output.Entries))
+	for i := range output.Entries {
+		entries = append(entries, toBlacklistEntryResponse(&output.Entries[i], now))
+	}
+
+	c.JSON(http.StatusOK, response.PaginatedResponse{
+		Success: true,
+		Data:    entries,
+		Pagination: response.Pagination{
+			Page:       output.Page,
+			PageSize:   output.PageSize,
+			TotalRows:  output.TotalCount,
+			TotalPages: output.TotalPages,
+			TotalItems: int64(output.TotalCount),
+		},
+	})
+}
+
+// GetBlacklistEntry handles GET /api/admin/blacklist/:id
+func (h *BlacklistHandler) GetBlacklistEntry(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid blacklist entry ID format",
+		})
+		return
+	}
+
+	entry, err := h.getBlacklistEntryService.GetBlacklistEntry(c.Request.Context(), id)
+	if err != nil {
+		writeBlacklistError(c, "Failed to get blacklist entry", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Blacklist entry retrieved successfully",
+		Data:    toBlacklistEntryResponse(entry, time.Now()),
+	})
+}
+
+// CreateBlacklistEntry handles POST /api/admin/blacklist
+func (h *BlacklistHandler) CreateBlacklistEntry(c *gin.Context) {
+	var req request.CreateBlacklistEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid request: " + err.Error(),
+		})
+		return
+	}
+
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	expiresAt, err := parseOptionalExpiry(req.ExpiresAt)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	entry, err := h.createBlacklistEntryService.CreateBlacklistEntry(c.Request.Context(), blacklistApp.CreateBlacklistEntryInput{
+		MSISDN:    req.MSISDN,
+		Reason:    req.Reason,
+		ExpiresAt: expiresAt,
+		CreatedBy: userID,
+	})
+	if err != nil {
+		writeBlacklistError(c, "Failed to create blacklist entry", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.SuccessResponse{
+		Success: true,
+		Message: "MSISDN blacklisted successfully",
+		Data:    toBlacklistEntryResponse(entry, time.Now()),
+	})
+}
+
+// UpdateBlacklistEntry handles PUT /api/admin/blacklist/:id
+func (h *BlacklistHandler) UpdateBlacklistEntry(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid blacklist entry ID format",
+		})
+		return
+	}
+
+	var req request.UpdateBlacklistEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid request: " + err.Error(),
+		})
+		return
+	}
+
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	expiresAt, err := parseOptionalExpiry(req.ExpiresAt)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	entry, err := h.updateBlacklistEntryService.UpdateBlacklistEntry(c.Request.Context(), blacklistApp.UpdateBlacklistEntryInput{
+		ID:        id,
+		Reason:    req.Reason,
+		ExpiresAt: expiresAt,
+		UpdatedBy: userID,
+	})
+	if err != nil {
+		writeBlacklistError(c, "Failed to update blacklist entry", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Blacklist entry updated successfully",
+		Data:    toBlacklistEntryResponse(entry, time.Now()),
+	})
+}
+
+// DeleteBlacklistEntry handles DELETE /api/admin/blacklist/:id
+func (h *BlacklistHandler) DeleteBlacklistEntry(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid blacklist entry ID format",
+		})
+		return
+	}
+
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	err = h.deleteBlacklistEntryService.DeleteBlacklistEntry(c.Request.Context(), blacklistApp.DeleteBlacklistEntryInput{
+		ID:        id,
+		DeletedBy: userID,
+	})
+	if err != nil {
+		writeBlacklistError(c, "Failed to delete blacklist entry", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "MSISDN removed from blacklist successfully",
+	})
+}
+
+// ImportBlacklist handles POST /api/admin/blacklist/import.
+// The file needs an msisdn column and may have reason and expiry columns;
+// the "reason" form field is used for rows without a reason.
+func (h *BlacklistHandler) ImportBlacklist(c *gin.Context) {
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Failed to get file: " + err.Error(),
+		})
+		return
+	}
+	defer file.Close()
+
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	rows, err := spreadsheet.NewReader(file, header.Size, header.Filename)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Failed to read file: " + err.Error(),
+		})
+		return
+	}
+
+	output, err := h.importBlacklistService.ImportBlacklist(c.Request.Context(), blacklistApp.ImportBlacklistInput{
+		Rows:          rows,
+		DefaultReason: c.PostForm("reason"),
+		ImportedBy:    userID,
+		FileName:      header.Filename,
+	})
+	if err != nil {
+		writeBlacklistError(c, "Failed to import blacklist", err)
+		return
+	}
+
+	rowErrors := output.RowErrors
+	if len(rowErrors) > maxRowErrorsInResponse {
+		rowErrors = rowErrors[:maxRowErrorsInResponse]
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: fmt.Sprintf("Successfully blacklisted %d MSISDNs", output.Imported),
+		Data: map[string]interface{}{
+			"totalRows":  output.TotalRows,
+			"imported":   output.Imported,
+			"errorCount": len(output.RowErrors),
+			"rowErrors":  rowErrors,
+		},
+	})
+}
+
+// writeBlacklistError maps blacklist domain errors to HTTP status codes
+func writeBlacklistError(c *gin.Context, message string, err error) {
+	status := http.StatusInternalServerError
+
+	var blacklistErr *blacklist.BlacklistError
+	if errors.As(err, &blacklistErr) {
+		switch blacklistErr.Code {
+		case blacklist.ErrEntryNotFound:
+			status = http.StatusNotFound
+		case blacklist.ErrEntryAlreadyExists:
+			status = http.StatusConflict
+		case blacklist.ErrInvalidEntry, blacklist.ErrInvalidImportFile:
+			status = http.StatusBadRequest
+		}
+	}
+
+	c.JSON(status, response.ErrorResponse{
+		Success: false,
+		Error:   message + ": " + err.Error(),
+	})
+}
+
+// parseOptionalExpiry parses an expiry given as a date or an RFC3339 timestamp
+func parseOptionalExpiry(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	for _, layout := range []string{"2006-01-02", time.RFC3339} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return &t, nil
+		}
+	}
+
+	return nil, errors.New("invalid expiry format, expected YYYY-MM-DD or RFC3339")
+}
+
+// toBlacklistEntryResponse converts a blacklist entry for a response
+func toBlacklistEntryResponse(entry *blacklist.BlacklistEntry, now time.Time) response.BlacklistEntryResponse {
+	resp := response.BlacklistEntryResponse{
+		ID:        entry.ID,
+		MSISDN:    entry.MSISDN,
+		Reason:    entry.Reason,
+		IsActive:  entry.IsActive(now),
+		CreatedBy: entry.CreatedBy,
+		CreatedAt: entry.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: entry.UpdatedAt.Format(time.RFC3339),
+	}
+	if entry.ExpiresAt != nil {
+		resp.ExpiresAt = entry.ExpiresAt.Format(time.RFC3339)
+	}
+	return resp
+}
+
+// getUserID reads the authenticated user's ID from the context, writing an error response when it is missing or invalid
+func getUserID(c *gin.Context) (uuid.UUID, bool) {
+	userIDValue, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, response.ErrorResponse{
+			Success: false,
+			Error:   "User not authenticated",
+		})
+		return uuid.Nil, false
+	}
+
+	switch id := userIDValue.(type) {
+	case uuid.UUID:
+		return id, true
+	case string:
+		userID, err := uuid.Parse(id)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+				Success: false,
+				Error:   "Invalid user ID format in token",
+			})
+			return uuid.Nil, false
+		}
+		return userID, true
+	default:
+		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid user ID type in token",
+		})
+		return uuid.Nil, false
+	}
+}
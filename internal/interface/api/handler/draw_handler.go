@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -10,7 +11,11 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/ArowuTest/GP-Backend-Promo/internal/adapter"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/entity"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/dto/request"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/dto/response"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/spreadsheet"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/util"
 )
 
@@ -133,10 +138,19 @@ func (h *DrawHandler) GetDrawByID(c *gin.Context) {
 		DrawDate:       util.FormatTimeOrEmpty(output.DrawDate, "2006-01-02"),
 		Status:         output.Status,
 		PrizeStructure: output.PrizeStructureID.String(),
+		SeedHash:       output.SeedHash,
+		FailureReason:  output.FailureReason,
+		VoidReason:     output.VoidReason,
 		Winners:        winners,
 		CreatedAt:      util.FormatTimeOrEmpty(output.CreatedAt, time.RFC3339),
 		CreatedBy:      output.ExecutedByAdminID.String(),
 	}
+	if output.VoidedAt != nil {
+		drawResponse.VoidedAt = output.VoidedAt.Format(time.RFC3339)
+	}
+	if output.ReplacementDrawID != uuid.Nil {
+		drawResponse.ReplacementDrawID = output.ReplacementDrawID.String()
+	}
 
 	c.JSON(http.StatusOK, response.SuccessResponse{
 		Success: true,
@@ -157,20 +171,22 @@ func (h *DrawHandler) GetWinners(c *gin.Context) {
 		pageSize = 10
 	}
 
-	// Parse filters if provided - not using these yet but keeping for future implementation
-	_ = c.DefaultQuery("startDate", "")
-	_ = c.DefaultQuery("endDate", "")
-
-	// Get winners through adapter - using GetWinners instead of ListWinners to match adapter method
-	output, err := h.drawServiceAdapter.GetWinners(c.Request.Context(), page, pageSize, uuid.Nil, "", "", "", false)
+	filters, err := parseWinnerFilters(c)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
 			Success: false,
-			Error:   "Failed to get winners: " + err.Error(),
+			Error:   "Invalid winner filters",
+			Details: err.Error(),
 		})
 		return
 	}
 
+	output, err := h.drawServiceAdapter.GetWinners(c.Request.Context(), page, pageSize, filters)
+	if err != nil {
+		writeDrawError(c, "Failed to get winners", err)
+		return
+	}
+
 	// Prepare response with explicit type conversions at DTO boundary
 	winners := make([]response.WinnerResponse, 0, len(output.Winners))
 	for _, w := range output.Winners {
@@ -203,6 +219,59 @@ func (h *DrawHandler) GetWinners(c *gin.Context) {
 	})
 }
 
+// parseWinnerFilters reads the winner filters and ordering from the query string
+func parseWinnerFilters(c *gin.Context) (draw.WinnerFilters, error) {
+	filters := draw.WinnerFilters{
+		Status:        c.Query("status"),
+		PaymentStatus: c.Query("paymentStatus"),
+		MSISDN:        c.Query("msisdn"),
+		SortBy:        c.Query("sortBy"),
+		SortOrder:     c.Query("sortOrder"),
+	}
+
+	if value := c.Query("startDate"); value != "" {
+		startDate, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return filters, errors.New("startDate must be in YYYY-MM-DD format")
+		}
+		filters.StartDate = startDate
+	}
+
+	if value := c.Query("endDate"); value != "" {
+		endDate, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return filters, errors.New("endDate must be in YYYY-MM-DD format")
+		}
+		filters.EndDate = endDate
+	}
+
+	if value := c.Query("drawId"); value != "" {
+		drawID, err := uuid.Parse(value)
+		if err != nil {
+			return filters, errors.New("drawId must be a valid UUID")
+		}
+		filters.DrawID = drawID
+	}
+
+	if value := c.Query("prizeTierId"); value != "" {
+		prizeTierID, err := uuid.Parse(value)
+		if err != nil {
+			return filters, errors.New("prizeTierId must be a valid UUID")
+		}
+		filters.PrizeTierID = prizeTierID
+	}
+
+	if value := c.Query("isRunnerUp"); value != "" {
+		isRunnerUp, err := strconv.ParseBool(value)
+		if err != nil {
+			return filters, errors.New("isRunnerUp must be true or false")
+		}
+		filters.IsRunnerUp = &isRunnerUp
+	}
+
+	return filters, nil
+}
+
 // ExecuteDraw handles POST /api/admin/draws
 func (h *DrawHandler) ExecuteDraw(c *gin.Context) {
 	var req struct {
@@ -266,13 +335,20 @@ func (h *DrawHandler) ExecuteDraw(c *gin.Context) {
 	// Execute draw through adapter
 	output, err := h.drawServiceAdapter.ExecuteDraw(c.Request.Context(), drawDate, req.PrizeStructureID, executedBy, 3)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
-			Success: false,
-			Error:   "Failed to execute draw: " + err.Error(),
-		})
+		writeDrawError(c, "Failed to execute draw", err)
 		return
 	}
 
+	runnerUps := make([]response.TierRunnerUpsResponse, 0, len(output.RunnerUps))
+	for _, tier := range output.RunnerUps {
+		runnerUps = append(runnerUps, response.TierRunnerUpsResponse{
+			PrizeTierID: tier.PrizeTierID.String(),
+			PrizeName:   tier.PrizeName,
+			Requested:   tier.Requested,
+			Available:   tier.Available,
+		})
+	}
+
 	// Create a response that matches the frontend expectations
 	drawResponse := response.DrawResponse{
 		ID:             output.ID,
@@ -281,6 +357,8 @@ func (h *DrawHandler) ExecuteDraw(c *gin.Context) {
 		DrawDate:       req.DrawDate,
 		Status:         output.Status,
 		PrizeStructure: req.PrizeStructureID.String(),
+		SeedHash:       output.SeedHash,
+		RunnerUps:      runnerUps,
 		CreatedAt:      util.FormatTimeOrEmpty(output.CreatedAt, time.RFC3339),
 		CreatedBy:      executedBy.String(),
 	}
@@ -292,91 +370,160 @@ func (h *DrawHandler) ExecuteDraw(c *gin.Context) {
 	})
 }
 
-// GetEligibilityStats handles GET /api/admin/draws/eligibility-stats
-func (h *DrawHandler) GetEligibilityStats(c *gin.Context) {
-	// Parse draw date
-	drawDateStr := c.DefaultQuery("drawDate", time.Now().Format("2006-01-02"))
-	drawDate, err := time.Parse("2006-01-02", drawDateStr)
+// VerifyDraw handles GET /api/admin/draws/:id/verify
+func (h *DrawHandler) VerifyDraw(c *gin.Context) {
+	// Parse draw ID
+	drawID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		c.JSON(http.StatusBadRequest, response.ErrorResponse{
 			Success: false,
-			Error:   "Invalid draw date format, expected YYYY-MM-DD",
+			Error:   "Invalid draw ID format",
 		})
 		return
 	}
 
-	// Get eligibility stats through adapter
-	output, err := h.drawServiceAdapter.GetEligibilityStats(c.Request.Context(), drawDate)
+	output, err := h.drawServiceAdapter.VerifyDraw(c.Request.Context(), drawID)
 	if err != nil {
+		var drawErr *draw.DrawError
+		if errors.As(err, &drawErr) {
+			status := http.StatusBadRequest
+			if drawErr.Code == draw.ErrDrawNotFound {
+				status = http.StatusNotFound
+			}
+			c.JSON(status, response.ErrorResponse{
+				Success: false,
+				Error:   drawErr.Error(),
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
 			Success: false,
-			Error:   "Failed to get eligibility stats: " + err.Error(),
+			Error:   "Failed to verify draw: " + err.Error(),
 		})
 		return
 	}
 
-	// Prepare response that matches frontend expectations
+	message := "Draw verified: the revealed seed reproduces the recorded winners"
+	if !output.Verified {
+		message = "Draw verification failed"
+	}
+
 	c.JSON(http.StatusOK, response.SuccessResponse{
 		Success: true,
-		Data: response.EligibilityStatsResponse{
-			Date:          drawDateStr,
-			TotalEligible: output.TotalEligible,
-			TotalEntries:  output.TotalEntries,
-		},
+		Message: message,
+		Data:    output,
 	})
 }
 
-// InvokeRunnerUp handles POST /api/admin/winners/:id/invoke-runner-up
-func (h *DrawHandler) InvokeRunnerUp(c *gin.Context) {
-	// Parse winner ID
-	winnerID, err := uuid.Parse(c.Param("id"))
+// SimulateDraw handles POST /api/admin/draws/simulate
+func (h *DrawHandler) SimulateDraw(c *gin.Context) {
+	var req request.SimulateDrawRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid request: " + err.Error(),
+		})
+		return
+	}
+
+	simulatedBy, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	drawDate, err := time.Parse("2006-01-02", req.DrawDate)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, response.ErrorResponse{
 			Success: false,
-			Error:   "Invalid winner ID format",
+			Error:   "Invalid draw date format, expected YYYY-MM-DD",
 		})
 		return
 	}
 
-	// Get user ID from context
-	userIDValue, exists := c.Get("userID")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, response.ErrorResponse{
+	output, err := h.drawServiceAdapter.SimulateDraw(c.Request.Context(), drawDate, req.PrizeStructureID, simulatedBy)
+	if err != nil {
+		writeDrawError(c, "Failed to simulate draw", err)
+		return
+	}
+
+	message := "Draw simulated: the draw would succeed"
+	if !output.WouldSucceed {
+		message = "Draw simulated: the draw would fail"
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: message,
+		Data:    output,
+	})
+}
+
+// GetEligibilityStats handles GET /api/admin/draws/eligibility-stats
+func (h *DrawHandler) GetEligibilityStats(c *gin.Context) {
+	// Parse draw date
+	drawDateStr := c.DefaultQuery("drawDate", time.Now().Format("2006-01-02"))
+	drawDate, err := time.Parse("2006-01-02", drawDateStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
 			Success: false,
-			Error:   "User not authenticated",
+			Error:   "Invalid draw date format, expected YYYY-MM-DD",
 		})
 		return
 	}
 
-	// Type assertion with safety check
-	var invokedBy uuid.UUID
-	switch id := userIDValue.(type) {
-	case uuid.UUID:
-		invokedBy = id
-	case string:
-		var err error
-		invokedBy, err = uuid.Parse(id)
+	// Optional prize structure whose eligibility rules should be applied
+	var prizeStructureID uuid.UUID
+	if id := c.Query("prizeStructureId"); id != "" {
+		prizeStructureID, err = uuid.Parse(id)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+			c.JSON(http.StatusBadRequest, response.ErrorResponse{
 				Success: false,
-				Error:   "Invalid user ID format in token",
-				Details: "User ID must be a valid UUID",
+				Error:   "Invalid prize structure ID format",
 			})
 			return
 		}
-	default:
+	}
+
+	// Get eligibility stats through adapter
+	output, err := h.drawServiceAdapter.GetEligibilityStats(c.Request.Context(), drawDate, prizeStructureID)
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
 			Success: false,
-			Error:   "Invalid user ID type in token",
-			Details: "User ID must be a UUID or string",
+			Error:   "Failed to get eligibility stats: " + err.Error(),
 		})
 		return
 	}
 
-	// Parse request body
-	var req struct {
-		Reason string `json:"reason" binding:"required"`
+	exclusions := make([]response.EligibilityExclusionResponse, 0, len(output.Exclusions))
+	for _, exclusion := range output.Exclusions {
+		exclusions = append(exclusions, response.EligibilityExclusionResponse{
+			Rule:            exclusion.Rule,
+			ExcludedMSISDNs: exclusion.ExcludedMSISDNs,
+		})
+	}
+
+	resp := response.EligibilityStatsResponse{
+		Date:             drawDateStr,
+		TotalEligible:    output.TotalEligible,
+		TotalEntries:     output.TotalEntries,
+		CandidateMSISDNs: output.CandidateMSISDNs,
+		CandidateEntries: output.CandidateEntries,
+		Exclusions:       exclusions,
 	}
+	if output.PrizeStructureID != uuid.Nil {
+		resp.PrizeStructureID = output.PrizeStructureID.String()
+	}
+
+	// Prepare response that matches frontend expectations
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// InvokeRunnerUp handles POST /api/admin/winners/:id/invoke-runner-up
+func (h *DrawHandler) InvokeRunnerUp(c *gin.Context) {
+	var req request.InvokeRunnerUpRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, response.ErrorResponse{
 			Success: false,
@@ -385,55 +532,87 @@ func (h *DrawHandler) InvokeRunnerUp(c *gin.Context) {
 		return
 	}
 
-	// Invoke runner-up through adapter
-	newWinner, err := h.drawServiceAdapter.InvokeRunnerUp(c.Request.Context(), winnerID, req.Reason, invokedBy)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+	// The winner comes from the path on /winners/:id/invoke-runner-up and from the body on /draws/invoke-runner-up
+	winnerID := req.WinnerID
+	if idParam := c.Param("id"); idParam != "" {
+		var err error
+		winnerID, err = uuid.Parse(idParam)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, response.ErrorResponse{
+				Success: false,
+				Error:   "Invalid winner ID format",
+			})
+			return
+		}
+	}
+
+	if winnerID == uuid.Nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
 			Success: false,
-			Error:   "Failed to invoke runner-up: " + err.Error(),
+			Error:   "Winner ID is required",
 		})
 		return
 	}
 
-	// Prepare response with new winner information
-	newWinnerResponse := response.WinnerResponse{
-		ID:            newWinner.ID,
-		DrawID:        newWinner.DrawID.String(),
-		MSISDN:        newWinner.MSISDN,
-		MaskedMSISDN:  maskMSISDN(newWinner.MSISDN),
-		PrizeTierID:   newWinner.PrizeTierID.String(),
-		PrizeName:     newWinner.PrizeName,
-		PrizeValue:    fmt.Sprintf("%.2f", newWinner.PrizeValue),
-		PaymentStatus: newWinner.PaymentStatus,
-		Status:        newWinner.Status,
-		IsRunnerUp:    newWinner.IsRunnerUp,
-		RunnerUpRank:  newWinner.RunnerUpRank,
-		CreatedAt:     util.FormatTimeOrEmpty(newWinner.CreatedAt, time.RFC3339),
+	invokedBy, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	// Invoke runner-up through adapter
+	originalWinner, newWinner, err := h.drawServiceAdapter.InvokeRunnerUp(c.Request.Context(), winnerID, req.Reason, invokedBy)
+	if err != nil {
+		writeDrawError(c, "Failed to invoke runner-up", err)
+		return
 	}
 
 	c.JSON(http.StatusOK, response.SuccessResponse{
 		Success: true,
 		Data: response.RunnerUpInvocationResult{
 			Message:        "Runner-up successfully invoked",
-			OriginalWinner: response.WinnerResponse{}, // Not available in current implementation
-			NewWinner:      newWinnerResponse,
+			OriginalWinner: toReplacementWinnerResponse(originalWinner),
+			NewWinner:      toReplacementWinnerResponse(newWinner),
 		},
 	})
 }
 
-// UpdateWinnerPaymentStatus handles PUT /api/admin/winners/:id/payment-status
-func (h *DrawHandler) UpdateWinnerPaymentStatus(c *gin.Context) {
-	// Parse winner ID
-	winnerIDStr := c.Param("id")
-	if winnerIDStr == "" {
+// GetReplacementHistory handles GET /api/admin/winners/:id/replacement-history
+func (h *DrawHandler) GetReplacementHistory(c *gin.Context) {
+	winnerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
 		c.JSON(http.StatusBadRequest, response.ErrorResponse{
 			Success: false,
-			Error:   "Winner ID is required",
+			Error:   "Invalid winner ID format",
 		})
 		return
 	}
-	
-	winnerID, err := uuid.Parse(winnerIDStr)
+
+	history, err := h.drawServiceAdapter.GetReplacementHistory(c.Request.Context(), winnerID)
+	if err != nil {
+		writeDrawError(c, "Failed to get replacement history", err)
+		return
+	}
+
+	chain := make([]response.WinnerResponse, 0, len(history.Chain))
+	for i := range history.Chain {
+		chain = append(chain, toReplacementWinnerResponse(&history.Chain[i]))
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Data: response.ReplacementHistoryResponse{
+			DrawID:             history.DrawID.String(),
+			PrizeTierID:        history.PrizeTierID.String(),
+			CurrentWinnerID:    history.CurrentWinnerID.String(),
+			RemainingRunnerUps: history.RemainingRunnerUps,
+			Chain:              chain,
+		},
+	})
+}
+
+// ConfirmWinnerClaim handles POST /api/admin/winners/:id/confirm-claim
+func (h *DrawHandler) ConfirmWinnerClaim(c *gin.Context) {
+	winnerID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		c.JSON(http.StatusBadRequest, response.ErrorResponse{
 			Success: false,
@@ -442,10 +621,72 @@ func (h *DrawHandler) UpdateWinnerPaymentStatus(c *gin.Context) {
 		return
 	}
 
-	var req struct {
-		PaymentStatus string `json:"paymentStatus" binding:"required"`
-		PaymentRef    string `json:"paymentRef"`
+	userID, ok := getUserID(c)
+	if !ok {
+		return
 	}
+
+	winner, err := h.drawServiceAdapter.ConfirmWinnerClaim(c.Request.Context(), winnerID, userID)
+	if err != nil {
+		writeDrawError(c, "Failed to confirm winner claim", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Winner claim confirmed successfully",
+		Data:    toReplacementWinnerResponse(winner),
+	})
+}
+
+// toReplacementWinnerResponse converts a winner of a replacement chain to a WinnerResponse
+func toReplacementWinnerResponse(winner *entity.Winner) response.WinnerResponse {
+	winnerResponse := response.WinnerResponse{
+		ID:                winner.ID,
+		DrawID:            winner.DrawID.String(),
+		MSISDN:            winner.MSISDN,
+		MaskedMSISDN:      maskMSISDN(winner.MSISDN),
+		PrizeTierID:       winner.PrizeTierID.String(),
+		PrizeName:         winner.PrizeName,
+		PrizeValue:        fmt.Sprintf("%.2f", winner.PrizeValue),
+		PaymentStatus:     winner.PaymentStatus,
+		Status:            winner.Status,
+		IsRunnerUp:        winner.IsRunnerUp,
+		RunnerUpRank:      winner.RunnerUpRank,
+		ReplacementReason: winner.ReplacementReason,
+		CreatedAt:         util.FormatTimeOrEmpty(winner.CreatedAt, time.RFC3339),
+	}
+	if winner.ReplacesWinnerID != uuid.Nil {
+		winnerResponse.ReplacesWinnerID = winner.ReplacesWinnerID.String()
+	}
+	if winner.ReplacedByWinnerID != uuid.Nil {
+		winnerResponse.ReplacedByWinnerID = winner.ReplacedByWinnerID.String()
+	}
+	if winner.ReplacedAt != nil {
+		winnerResponse.ReplacedAt = winner.ReplacedAt.Format(time.RFC3339)
+	}
+	if winner.ClaimDeadline != nil {
+		winnerResponse.ClaimDeadline = winner.ClaimDeadline.Format(time.RFC3339)
+	}
+	if winner.ClaimedAt != nil {
+		winnerResponse.ClaimedAt = winner.ClaimedAt.Format(time.RFC3339)
+	}
+	return winnerResponse
+}
+
+// UpdateWinnerPaymentStatus handles PUT /api/admin/winners/:id/payment-status. Payments move
+// Pending → Approved → Paid or Failed, and a prize is marked paid by an admin other than its approver.
+func (h *DrawHandler) UpdateWinnerPaymentStatus(c *gin.Context) {
+	winnerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid winner ID format",
+		})
+		return
+	}
+
+	var req request.UpdateWinnerPaymentStatusRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, response.ErrorResponse{
 			Success: false,
@@ -454,73 +695,154 @@ func (h *DrawHandler) UpdateWinnerPaymentStatus(c *gin.Context) {
 		return
 	}
 
-	// Get user ID from context
-	userIDValue, exists := c.Get("userID")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, response.ErrorResponse{
+	updatedBy, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	output, err := h.drawServiceAdapter.UpdateWinnerPaymentStatus(c.Request.Context(), winnerID, req.PaymentStatus, req.PaymentRef, req.Notes, updatedBy)
+	if err != nil {
+		writeDrawError(c, "Failed to update winner payment status", err)
+		return
+	}
+
+	winnerResponse := response.WinnerResponse{
+		ID:             output.ID,
+		DrawID:         output.DrawID.String(),
+		MSISDN:         output.MSISDN,
+		MaskedMSISDN:   maskMSISDN(output.MSISDN),
+		PrizeTierID:    output.PrizeTierID.String(),
+		PrizeName:      output.PrizeName,
+		PrizeValue:     fmt.Sprintf("%.2f", output.PrizeValue),
+		PaymentStatus:  output.PaymentStatus,
+		PaymentRef:     output.PaymentReference,
+		PaymentNotes:   output.PaymentNotes,
+		PaymentHistory: toPaymentEventResponses(output.PaymentHistory),
+		Status:         output.Status,
+		IsRunnerUp:     output.IsRunnerUp,
+		RunnerUpRank:   output.RunnerUpRank,
+		CreatedAt:      util.FormatTimeOrEmpty(output.CreatedAt, time.RFC3339),
+		UpdatedAt:      util.FormatTimeOrEmpty(output.UpdatedAt, time.RFC3339),
+	}
+	if output.PaymentApprovedBy != uuid.Nil {
+		winnerResponse.PaymentApprovedBy = output.PaymentApprovedBy.String()
+	}
+	if output.PaymentApprovedAt != nil {
+		winnerResponse.PaymentApprovedAt = output.PaymentApprovedAt.Format(time.RFC3339)
+	}
+	if output.PaidAt != nil {
+		winnerResponse.PaymentDate = output.PaidAt.Format(time.RFC3339)
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Data:    winnerResponse,
+	})
+}
+
+// GetWinnerPaymentHistory handles GET /api/admin/winners/:id/payment-history
+func (h *DrawHandler) GetWinnerPaymentHistory(c *gin.Context) {
+	winnerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
 			Success: false,
-			Error:   "User not authenticated",
+			Error:   "Invalid winner ID format",
 		})
 		return
 	}
 
-	// Type assertion with safety check
-	var updatedBy uuid.UUID
-	switch id := userIDValue.(type) {
-	case uuid.UUID:
-		updatedBy = id
-	case string:
-		var err error
-		updatedBy, err = uuid.Parse(id)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, response.ErrorResponse{
-				Success: false,
-				Error:   "Invalid user ID format in token",
-				Details: "User ID must be a valid UUID",
-			})
-			return
-		}
-	default:
-		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+	history, err := h.drawServiceAdapter.GetWinnerPaymentHistory(c.Request.Context(), winnerID)
+	if err != nil {
+		writeDrawError(c, "Failed to get payment history", err)
+		return
+	}
+
+	historyResponse := response.PaymentHistoryResponse{
+		WinnerID:      history.WinnerID.String(),
+		PaymentStatus: history.PaymentStatus,
+		PaymentRef:    history.PaymentReference,
+		Events:        toPaymentEventResponses(history.Events),
+	}
+	if history.PaymentApprovedBy != uuid.Nil {
+		historyResponse.PaymentApprovedBy = history.PaymentApprovedBy.String()
+	}
+	if history.PaymentApprovedAt != nil {
+		historyResponse.PaymentApprovedAt = history.PaymentApprovedAt.Format(time.RFC3339)
+	}
+	if history.PaidAt != nil {
+		historyResponse.PaymentDate = history.PaidAt.Format(time.RFC3339)
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Data:    historyResponse,
+	})
+}
+
+// ReconcileWinnerPayments handles POST /api/admin/winners/payments/reconcile. The CSV or XLSX
+// bank statement needs msisdn, amount and reference columns; the "dryRun" form field set to true
+// reports the matches without marking any winner paid.
+func (h *DrawHandler) ReconcileWinnerPayments(c *gin.Context) {
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
 			Success: false,
-			Error:   "Invalid user ID type in token",
-			Details: "User ID must be a UUID or string",
+			Error:   "Failed to get file: " + err.Error(),
 		})
 		return
 	}
+	defer file.Close()
+
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
 
-	// Update winner payment status through adapter
-	output, err := h.drawServiceAdapter.UpdateWinnerPaymentStatus(c.Request.Context(), winnerID, req.PaymentStatus, updatedBy)
+	rows, err := spreadsheet.NewReader(file, header.Size, header.Filename)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
 			Success: false,
-			Error:   "Failed to update winner payment status: " + err.Error(),
+			Error:   "Failed to read file: " + err.Error(),
 		})
 		return
 	}
 
-	// Prepare response with complete winner information
+	dryRun, _ := strconv.ParseBool(c.PostForm("dryRun"))
+	output, err := h.drawServiceAdapter.ReconcileWinnerPayments(c.Request.Context(), rows, userID, header.Filename, dryRun)
+	if err != nil {
+		writeDrawError(c, "Failed to reconcile winner payments", err)
+		return
+	}
+
+	message := fmt.Sprintf("Marked %d winners paid", len(output.Matched))
+	if output.DryRun {
+		message = fmt.Sprintf("Dry run: %d winners would be marked paid", len(output.Matched))
+	}
+
 	c.JSON(http.StatusOK, response.SuccessResponse{
 		Success: true,
-		Data: response.WinnerResponse{
-			ID:            output.ID,
-			DrawID:        output.DrawID.String(),
-			MSISDN:        output.MSISDN,
-			MaskedMSISDN:  maskMSISDN(output.MSISDN),
-			PrizeTierID:   output.PrizeTierID.String(),
-			PrizeName:     output.PrizeName,
-			PrizeValue:    fmt.Sprintf("%.2f", output.PrizeValue),
-			PaymentStatus: output.PaymentStatus,
-			PaymentNotes:  output.PaymentNotes,
-			Status:        output.Status,
-			IsRunnerUp:    output.IsRunnerUp,
-			RunnerUpRank:  output.RunnerUpRank,
-			CreatedAt:     util.FormatTimeOrEmpty(output.CreatedAt, time.RFC3339),
-			UpdatedAt:     util.FormatTimeOrEmpty(output.UpdatedAt, time.RFC3339),
-		},
+		Message: message,
+		Data:    output,
 	})
 }
 
+// toPaymentEventResponses converts payment events to PaymentEventResponses
+func toPaymentEventResponses(events []entity.PaymentEvent) []response.PaymentEventResponse {
+	responses := make([]response.PaymentEventResponse, 0, len(events))
+	for _, event := range events {
+		responses = append(responses, response.PaymentEventResponse{
+			ID:         event.ID.String(),
+			FromStatus: event.FromStatus,
+			ToStatus:   event.ToStatus,
+			ActorID:    event.ActorID.String(),
+			Reference:  event.Reference,
+			Notes:      event.Notes,
+			At:         event.At.Format(time.RFC3339),
+		})
+	}
+	return responses
+}
+
 // Helper function to mask MSISDN
 func maskMSISDN(msisdn string) string {
 	if len(msisdn) <= 6 {
@@ -533,3 +855,125 @@ func maskMSISDN(msisdn string) string {
 
 	return masked
 }
+
+// ScheduleDraw handles POST /api/admin/draws/schedule
+func (h *DrawHandler) ScheduleDraw(c *gin.Context) {
+	var req request.ScheduleDrawRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid request: " + err.Error(),
+		})
+		return
+	}
+
+	scheduledBy, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	drawDate, err := time.Parse("2006-01-02", req.DrawDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid draw date format, expected YYYY-MM-DD",
+		})
+		return
+	}
+
+	output, err := h.drawServiceAdapter.ScheduleDraw(c.Request.Context(), drawDate, req.PrizeStructureID, scheduledBy)
+	if err != nil {
+		writeDrawError(c, "Failed to schedule draw", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.SuccessResponse{
+		Success: true,
+		Message: "Draw scheduled successfully",
+		Data: response.DrawResponse{
+			ID:             output.DrawID,
+			Name:           "Draw for " + req.DrawDate,
+			DrawDate:       req.DrawDate,
+			Status:         output.Status,
+			PrizeStructure: output.PrizeStructureID.String(),
+			Winners:        []response.WinnerResponse{},
+			CreatedAt:      util.FormatTimeOrEmpty(output.CreatedAt, time.RFC3339),
+			CreatedBy:      scheduledBy.String(),
+		},
+	})
+}
+
+// VoidDraw handles POST /api/admin/draws/:id/void
+func (h *DrawHandler) VoidDraw(c *gin.Context) {
+	drawID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid draw ID format",
+		})
+		return
+	}
+
+	var req request.VoidDrawRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid request: " + err.Error(),
+		})
+		return
+	}
+
+	voidedBy, ok := getUserID(c)
+	if !ok {
+		return
+	}
+	role, _ := c.Get("role")
+	roleName, _ := role.(string)
+
+	output, err := h.drawServiceAdapter.VoidDraw(c.Request.Context(), drawID, req.Reason, voidedBy, roleName)
+	if err != nil {
+		writeDrawError(c, "Failed to void draw", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Draw voided successfully",
+		Data: response.DrawStatusResponse{
+			ID:             output.DrawID,
+			PreviousStatus: output.PreviousStatus,
+			Status:         output.Status,
+			VoidReason:     output.VoidReason,
+			VoidedAt:       output.VoidedAt.Format(time.RFC3339),
+		},
+	})
+}
+
+// writeDrawError writes the HTTP status and error code matching a draw error
+func writeDrawError(c *gin.Context, message string, err error) {
+	var drawErr *draw.DrawError
+	if !errors.As(err, &drawErr) {
+		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+			Success: false,
+			Error:   message + ": " + err.Error(),
+		})
+		return
+	}
+
+	status := http.StatusBadRequest
+	switch drawErr.Code {
+	case draw.ErrDrawNotFound, draw.ErrWinnerNotFound:
+		status = http.StatusNotFound
+	case draw.ErrDrawAlreadyExists, draw.ErrInvalidStatusTransition, draw.ErrDrawNotCompleted, draw.ErrClaimClosed,
+		draw.ErrInvalidPaymentTransition, draw.ErrPrizeStructureMismatch:
+		status = http.StatusConflict
+	case draw.ErrVoidNotAuthorized, draw.ErrPaymentApproverConflict:
+		status = http.StatusForbidden
+	}
+
+	c.JSON(status, response.ErrorResponse{
+		Success: false,
+		Error:   message + ": " + drawErr.Error(),
+		Details: drawErr.Code,
+	})
+}
@@ -0,0 +1,273 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	scheduleApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/schedule"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/schedule"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/dto/request"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/dto/response"
+)
+
+// DrawScheduleHandler handles draw schedule HTTP requests
+type DrawScheduleHandler struct {
+	createDrawScheduleService *scheduleApp.CreateDrawScheduleService
+	getDrawScheduleService    *scheduleApp.GetDrawScheduleService
+	listDrawSchedulesService  *scheduleApp.ListDrawSchedulesService
+	updateDrawScheduleService *scheduleApp.UpdateDrawScheduleService
+	deleteDrawScheduleService *scheduleApp.DeleteDrawScheduleService
+}
+
+// NewDrawScheduleHandler creates a new DrawScheduleHandler
+func NewDrawScheduleHandler(
+	createDrawScheduleService *scheduleApp.CreateDrawScheduleService,
+	getDrawScheduleService *scheduleApp.GetDrawScheduleService,
+	listDrawSchedulesService *scheduleApp.ListDrawSchedulesService,
+	updateDrawScheduleService *scheduleApp.UpdateDrawScheduleService,
+	deleteDrawScheduleService *scheduleApp.DeleteDrawScheduleService,
+) *DrawScheduleHandler {
+	return &DrawScheduleHandler{
+		createDrawScheduleService: createDrawScheduleService,
+		getDrawScheduleService:    getDrawScheduleService,
+		listDrawSchedulesService:  listDrawSchedulesService,
+		updateDrawScheduleService: updateDrawScheduleService,
+		deleteDrawScheduleService: deleteDrawScheduleService,
+	}
+}
+
+// ListDrawSchedules handles GET /api/admin/draw-schedules
+func (h *DrawScheduleHandler) ListDrawSchedules(c *gin.Context) {
+	schedules, err := h.listDrawSchedulesService.ListDrawSchedules(c.Request.Context())
+	if err != nil {
+		writeScheduleError(c, "Failed to list draw schedules", err)
+		return
+	}
+
+	now := time.Now()
+	data := make([]response.DrawScheduleResponse, 0, len(schedules))
+	for i := range schedules {
+		data = append(data, toDrawScheduleResponse(&schedules[i], now))
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Data:    data,
+	})
+}
+
+// GetDrawSchedule handles GET /api/admin/draw-schedules/:id
+func (h *DrawScheduleHandler) GetDrawSchedule(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid draw schedule ID format",
+		})
+		return
+	}
+
+	drawSchedule, err := h.getDrawScheduleService.GetDrawSchedule(c.Request.Context(), id)
+	if err != nil {
+		writeScheduleError(c, "Failed to get draw schedule", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Data:    toDrawScheduleResponse(drawSchedule, time.Now()),
+	})
+}
+
+// CreateDrawSchedule handles POST /api/admin/draw-schedules
+func (h *DrawScheduleHandler) CreateDrawSchedule(c *gin.Context) {
+	var req request.DrawScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid request: " + err.Error(),
+		})
+		return
+	}
+
+	prizeStructureID, ok := parseSchedulePrizeStructureID(c, req.PrizeStructureID)
+	if !ok {
+		return
+	}
+
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	drawSchedule, err := h.createDrawScheduleService.CreateDrawSchedule(c.Request.Context(), scheduleApp.CreateDrawScheduleInput{
+		Name:             req.Name,
+		CronExpression:   req.CronExpression,
+		TimeZone:         req.TimeZone,
+		PrizeStructureID: prizeStructureID,
+		Enabled:          req.Enabled,
+		CreatedBy:        userID,
+	})
+	if err != nil {
+		writeScheduleError(c, "Failed to create draw schedule", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.SuccessResponse{
+		Success: true,
+		Message: "Draw schedule created successfully",
+		Data:    toDrawScheduleResponse(drawSchedule, time.Now()),
+	})
+}
+
+// UpdateDrawSchedule handles PUT /api/admin/draw-schedules/:id
+func (h *DrawScheduleHandler) UpdateDrawSchedule(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid draw schedule ID format",
+		})
+		return
+	}
+
+	var req request.DrawScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid request: " + err.Error(),
+		})
+		return
+	}
+
+	prizeStructureID, ok := parseSchedulePrizeStructureID(c, req.PrizeStructureID)
+	if !ok {
+		return
+	}
+
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	drawSchedule, err := h.updateDrawScheduleService.UpdateDrawSchedule(c.Request.Context(), scheduleApp.UpdateDrawScheduleInput{
+		ID:               id,
+		Name:             req.Name,
+		CronExpression:   req.CronExpression,
+		TimeZone:         req.TimeZone,
+		PrizeStructureID: prizeStructureID,
+		Enabled:          req.Enabled,
+		UpdatedBy:        userID,
+	})
+	if err != nil {
+		writeScheduleError(c, "Failed to update draw schedule", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Draw schedule updated successfully",
+		Data:    toDrawScheduleResponse(drawSchedule, time.Now()),
+	})
+}
+
+// DeleteDrawSchedule handles DELETE /api/admin/draw-schedules/:id
+func (h *DrawScheduleHandler) DeleteDrawSchedule(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid draw schedule ID format",
+		})
+		return
+	}
+
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.deleteDrawScheduleService.DeleteDrawSchedule(c.Request.Context(), id, userID); err != nil {
+		writeScheduleError(c, "Failed to delete draw schedule", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Draw schedule deleted successfully",
+	})
+}
+
+// writeScheduleError writes the HTTP status matching a schedule error
+func writeScheduleError(c *gin.Context, message string, err error) {
+	status := http.StatusInternalServerError
+
+	var scheduleErr *schedule.ScheduleError
+	if errors.As(err, &scheduleErr) {
+		switch scheduleErr.Code {
+		case schedule.ErrScheduleNotFound:
+			status = http.StatusNotFound
+		case schedule.ErrInvalidSchedule:
+			status = http.StatusBadRequest
+		case schedule.ErrScheduleOverlap:
+			status = http.StatusConflict
+		}
+	}
+
+	c.JSON(status, response.ErrorResponse{
+		Success: false,
+		Error:   message + ": " + err.Error(),
+	})
+}
+
+// parseSchedulePrizeStructureID parses an optional prize structure ID, writing an error response when it is invalid
+func parseSchedulePrizeStructureID(c *gin.Context, value string) (uuid.UUID, bool) {
+	if value == "" {
+		return uuid.Nil, true
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid prize structure ID format",
+		})
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
+
+// toDrawScheduleResponse converts a draw schedule to its response
+func toDrawScheduleResponse(s *schedule.DrawSchedule, now time.Time) response.DrawScheduleResponse {
+	resp := response.DrawScheduleResponse{
+		ID:             s.ID,
+		Name:           s.Name,
+		CronExpression: s.CronExpression,
+		TimeZone:       s.TimeZone,
+		Enabled:        s.Enabled,
+		LastRunStatus:  s.LastRunStatus,
+		LastRunError:   s.LastRunError,
+		CreatedAt:      s.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      s.UpdatedAt.Format(time.RFC3339),
+	}
+	if s.PrizeStructureID != uuid.Nil {
+		resp.PrizeStructureID = s.PrizeStructureID.String()
+	}
+	if s.Enabled {
+		if next, err := s.NextRun(now); err == nil && !next.IsZero() {
+			resp.NextRunAt = next.Format(time.RFC3339)
+		}
+	}
+	if s.LastRunAt != nil {
+		resp.LastRunAt = s.LastRunAt.Format(time.RFC3339)
+	}
+	if s.LastDrawID != uuid.Nil {
+		resp.LastDrawID = s.LastDrawID.String()
+	}
+	return resp
+}
@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	notificationApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/notification"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/notification"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/dto/request"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/dto/response"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/util"
+)
+
+// NotificationHandler handles winner notification HTTP requests
+type NotificationHandler struct {
+	handleDeliveryReceiptService   *notificationApp.HandleDeliveryReceiptService
+	listWinnerNotificationsService *notificationApp.ListWinnerNotificationsService
+	callbackToken                  string
+}
+
+// NewNotificationHandler creates a new NotificationHandler. Delivery receipts must carry
+// callbackToken; they are refused while it is empty.
+func NewNotificationHandler(
+	handleDeliveryReceiptService *notificationApp.HandleDeliveryReceiptService,
+	listWinnerNotificationsService *notificationApp.ListWinnerNotificationsService,
+	callbackToken string,
+) *NotificationHandler {
+	return &NotificationHandler{
+		handleDeliveryReceiptService:   handleDeliveryReceiptService,
+		listWinnerNotificationsService: listWinnerNotificationsService,
+		callbackToken:                  callbackToken,
+	}
+}
+
+// DeliveryReceipt handles POST /api/v1/notifications/delivery-receipts. The SMS gateway
+// authenticates with the X-Callback-Token header or, when it cannot set headers, a token query parameter.
+func (h *NotificationHandler) DeliveryReceipt(c *gin.Context) {
+	token := c.GetHeader("X-Callback-Token")
+	if token == "" {
+		token = c.Query("token")
+	}
+	if h.callbackToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(h.callbackToken)) != 1 {
+		c.JSON(http.StatusUnauthorized, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid callback token",
+		})
+		return
+	}
+
+	var req request.DeliveryReceiptRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid request: " + err.Error(),
+		})
+		return
+	}
+
+	input := notificationApp.HandleDeliveryReceiptInput{
+		ProviderMessageID: req.MessageID,
+		Status:            req.Status,
+		Error:             req.Error,
+	}
+	if req.DeliveredAt != "" {
+		deliveredAt, err := time.Parse(time.RFC3339, req.DeliveredAt)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, response.ErrorResponse{
+				Success: false,
+				Error:   "Invalid deliveredAt, expected RFC 3339",
+			})
+			return
+		}
+		input.DeliveredAt = &deliveredAt
+	}
+
+	output, err := h.handleDeliveryReceiptService.HandleDeliveryReceipt(c.Request.Context(), input)
+	if err != nil {
+		writeNotificationError(c, "Failed to handle delivery receipt", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Data: response.DeliveryReceiptResponse{
+			NotificationID:     output.NotificationID.String(),
+			WinnerID:           output.WinnerID.String(),
+			NotificationStatus: output.NotificationStatus,
+			WinnerNotified:     output.WinnerNotified,
+		},
+	})
+}
+
+// ListWinnerNotifications handles GET /api/admin/winners/:id/notifications
+func (h *NotificationHandler) ListWinnerNotifications(c *gin.Context) {
+	winnerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid winner ID format",
+		})
+		return
+	}
+
+	notifications, err := h.listWinnerNotificationsService.ListWinnerNotifications(c.Request.Context(), winnerID)
+	if err != nil {
+		writeNotificationError(c, "Failed to list winner notifications", err)
+		return
+	}
+
+	data := make([]response.NotificationResponse, 0, len(notifications))
+	for i := range notifications {
+		data = append(data, toNotificationResponse(&notifications[i]))
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Data:    data,
+	})
+}
+
+// toNotificationResponse converts a domain notification to a NotificationResponse
+func toNotificationResponse(n *notification.Notification) response.NotificationResponse {
+	notificationResponse := response.NotificationResponse{
+		ID:                n.ID.String(),
+		WinnerID:          n.WinnerID.String(),
+		DrawID:            n.DrawID.String(),
+		MaskedMSISDN:      util.MaskMSISDN(n.MSISDN),
+		Channel:           n.Channel,
+		Message:           n.Message,
+		Status:            n.Status,
+		Attempts:          n.Attempts,
+		MaxAttempts:       n.MaxAttempts,
+		LastError:         n.LastError,
+		ProviderMessageID: n.ProviderMessageID,
+		CreatedAt:         util.FormatTimeOrEmpty(n.CreatedAt, time.RFC3339),
+	}
+	if n.Status == notification.StatusPending {
+		notificationResponse.NextAttemptAt = util.FormatTimeOrEmpty(n.NextAttemptAt, time.RFC3339)
+	}
+	if n.SentAt != nil {
+		notificationResponse.SentAt = n.SentAt.Format(time.RFC3339)
+	}
+	if n.DeliveredAt != nil {
+		notificationResponse.DeliveredAt = n.DeliveredAt.Format(time.RFC3339)
+	}
+	return notificationResponse
+}
+
+// writeNotificationError writes the response for a failed notification request
+func writeNotificationError(c *gin.Context, message string, err error) {
+	status := http.StatusInternalServerError
+
+	var notificationErr *notification.NotificationError
+	if errors.As(err, &notificationErr) {
+		switch notificationErr.Code {
+		case notification.ErrNotificationNotFound:
+			status = http.StatusNotFound
+		default:
+			status = http.StatusBadRequest
+		}
+	}
+
+	c.JSON(status, response.ErrorResponse{
+		Success: false,
+		Error:   message + ": " + err.Error(),
+	})
+}
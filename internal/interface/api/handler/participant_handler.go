@@ -1,8 +1,9 @@
 package handler
 
 import (
+	"encoding/csv"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"strconv"
 	"time"
@@ -14,23 +15,30 @@ import (
 	participantApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/participant"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/participant"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/dto/response"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/spreadsheet"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/util"
 )
 
+// maxRowErrorsInResponse limits the row errors returned inline by an upload
+const maxRowErrorsInResponse = 100
+
 // ParticipantHandler handles participant-related HTTP requests
 type ParticipantHandler struct {
-	participantServiceAdapter *adapter.ParticipantServiceAdapter
-	getParticipantStatsService *participantApp.GetParticipantStatsService
+	participantServiceAdapter   *adapter.ParticipantServiceAdapter
+	getParticipantStatsService  *participantApp.GetParticipantStatsService
+	getUploadErrorReportService *participantApp.GetUploadErrorReportService
 }
 
 // NewParticipantHandler creates a new ParticipantHandler
 func NewParticipantHandler(
 	participantServiceAdapter *adapter.ParticipantServiceAdapter,
 	getParticipantStatsService *participantApp.GetParticipantStatsService,
+	getUploadErrorReportService *participantApp.GetUploadErrorReportService,
 ) *ParticipantHandler {
 	return &ParticipantHandler{
-		participantServiceAdapter: participantServiceAdapter,
-		getParticipantStatsService: getParticipantStatsService,
+		participantServiceAdapter:   participantServiceAdapter,
+		getParticipantStatsService:  getParticipantStatsService,
+		getUploadErrorReportService: getUploadErrorReportService,
 	}
 }
 
@@ -156,28 +164,27 @@ func (h *ParticipantHandler) UploadParticipants(c *gin.Context) {
 		return
 	}
 
-	// Read file content - we'll use this later for CSV parsing
-	_, err = io.ReadAll(file)
+	// Stream rows from the uploaded CSV or XLSX file
+	rows, err := spreadsheet.NewReader(file, header.Size, header.Filename)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
 			Success: false,
-			Error:   "Failed to read file content: " + err.Error(),
+			Error:   "Failed to read file: " + err.Error(),
 		})
 		return
 	}
 
-	// Convert to participant inputs - simplified for now
-	// In a real implementation, this would parse CSV data
-	participants := []participant.ParticipantInput{
-		{
-			MSISDN: "1234567890",
-			Points: 10,
-		},
-	}
-
 	// Upload participants
-	output, err := h.participantServiceAdapter.UploadParticipants(c.Request.Context(), participants, uploadedBy, header.Filename)
+	output, err := h.participantServiceAdapter.ImportParticipantsFile(c.Request.Context(), rows, uploadedBy, header.Filename)
 	if err != nil {
+		var participantErr *participant.ParticipantError
+		if errors.As(err, &participantErr) && participantErr.Code == participant.ErrInvalidCSVFormat {
+			c.JSON(http.StatusBadRequest, response.ErrorResponse{
+				Success: false,
+				Error:   participantErr.Error(),
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
 			Success: false,
 			Error:   "Failed to upload participants: " + err.Error(),
@@ -185,25 +192,87 @@ func (h *ParticipantHandler) UploadParticipants(c *gin.Context) {
 		return
 	}
 
+	// Only a preview of row errors is returned inline, the full list is in the error report
+	rowErrors := output.RowErrors
+	if len(rowErrors) > maxRowErrorsInResponse {
+		rowErrors = rowErrors[:maxRowErrorsInResponse]
+	}
+
+	errorReportURL := ""
+	if output.ErrorsEncountered > 0 {
+		errorReportURL = fmt.Sprintf("/api/v1/admin/participants/uploads/%s/errors", output.ID.String())
+	}
+
 	// Prepare response
 	c.JSON(http.StatusOK, response.SuccessResponse{
 		Success: true,
-		Message: fmt.Sprintf("Successfully uploaded %d participants", output.RecordCount),
+		Message: fmt.Sprintf("Successfully uploaded %d participants", output.SuccessfullyImported),
 		Data: map[string]interface{}{
 			"id":                   output.ID.String(),
 			"fileName":             output.FileName,
 			"totalUploaded":        output.RecordCount,
-			"successfullyImported": output.RecordCount,
-			"duplicatesSkipped":    0,
-			"errorsEncountered":    0,
+			"successfullyImported": output.SuccessfullyImported,
+			"duplicatesSkipped":    output.DuplicatesSkipped,
+			"errorsEncountered":    output.ErrorsEncountered,
+			"rowErrors":            rowErrors,
+			"errorReportUrl":       errorReportURL,
 			"status":               output.Status,
 			"details":              output.ErrorMessage,
+			"processingTime":       output.ProcessingTime,
 			"uploadedBy":           uploadedBy.String(),
 			"uploadedAt":           util.FormatTimeOrEmpty(output.UploadDate, time.RFC3339),
 		},
 	})
 }
 
+// GetUploadErrorReport handles GET /api/v1/admin/participants/uploads/:id/errors
+func (h *ParticipantHandler) GetUploadErrorReport(c *gin.Context) {
+	// Parse upload ID
+	uploadID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid upload ID format",
+		})
+		return
+	}
+
+	output, err := h.getUploadErrorReportService.GetUploadErrorReport(c.Request.Context(), participantApp.GetUploadErrorReportInput{
+		UploadID: uploadID,
+	})
+	if err != nil {
+		var participantErr *participant.ParticipantError
+		if errors.As(err, &participantErr) && participantErr.Code == participant.ErrUploadAuditNotFound {
+			c.JSON(http.StatusNotFound, response.ErrorResponse{
+				Success: false,
+				Error:   "Upload not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+			Success: false,
+			Error:   "Failed to get upload error report: " + err.Error(),
+		})
+		return
+	}
+
+	// Write the report as CSV
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=upload-errors-%s.csv", uploadID.String()))
+	c.Status(http.StatusOK)
+
+	writer := csv.NewWriter(c.Writer)
+	_ = writer.Write([]string{"Row", "MSISDN", "Reason"})
+	for _, rowErr := range output.RowErrors {
+		row := ""
+		if rowErr.Row > 0 {
+			row = strconv.Itoa(rowErr.Row)
+		}
+		_ = writer.Write([]string{row, rowErr.MSISDN, rowErr.Reason})
+	}
+	writer.Flush()
+}
+
 // ListUploadAudits handles GET /api/admin/participants/upload-audits
 func (h *ParticipantHandler) ListUploadAudits(c *gin.Context) {
 	// Parse pagination parameters
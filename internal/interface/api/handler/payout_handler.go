@@ -0,0 +1,198 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	payoutApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/payout"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/payout"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/dto/request"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/dto/response"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/util"
+)
+
+// PayoutHandler handles winner payout HTTP requests
+type PayoutHandler struct {
+	requestPayoutService        *payoutApp.RequestPayoutService
+	getWinnerPayoutService      *payoutApp.GetWinnerPayoutService
+	handlePayoutCallbackService *payoutApp.HandlePayoutCallbackService
+	callbackToken               string
+}
+
+// NewPayoutHandler creates a new PayoutHandler. Provider callbacks must carry callbackToken;
+// they are refused while it is empty and payouts are then settled by polling only.
+func NewPayoutHandler(
+	requestPayoutService *payoutApp.RequestPayoutService,
+	getWinnerPayoutService *payoutApp.GetWinnerPayoutService,
+	handlePayoutCallbackService *payoutApp.HandlePayoutCallbackService,
+	callbackToken string,
+) *PayoutHandler {
+	return &PayoutHandler{
+		requestPayoutService:        requestPayoutService,
+		getWinnerPayoutService:      getWinnerPayoutService,
+		handlePayoutCallbackService: handlePayoutCallbackService,
+		callbackToken:               callbackToken,
+	}
+}
+
+// RequestPayout handles POST /api/admin/winners/:id/payout. It pays the winner's prize, or
+// retries a failed payout; the outcome is recorded on the winner once the provider settles it.
+func (h *PayoutHandler) RequestPayout(c *gin.Context) {
+	winnerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid winner ID format",
+		})
+		return
+	}
+
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	output, err := h.requestPayoutService.RequestPayout(c.Request.Context(), payoutApp.RequestPayoutInput{
+		WinnerID:    winnerID,
+		RequestedBy: userID,
+	})
+	if err != nil {
+		writePayoutError(c, "Failed to request payout", err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, response.SuccessResponse{
+		Success: true,
+		Message: "Payout requested",
+		Data:    toPayoutResponse(output),
+	})
+}
+
+// GetWinnerPayout handles GET /api/admin/winners/:id/payout
+func (h *PayoutHandler) GetWinnerPayout(c *gin.Context) {
+	winnerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid winner ID format",
+		})
+		return
+	}
+
+	output, err := h.getWinnerPayoutService.GetWinnerPayout(c.Request.Context(), winnerID)
+	if err != nil {
+		writePayoutError(c, "Failed to get payout", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Data:    toPayoutResponse(output),
+	})
+}
+
+// PayoutCallback handles POST /api/v1/payouts/callback. The payout provider authenticates
+// with the X-Callback-Token header or, when it cannot set headers, a token query parameter.
+func (h *PayoutHandler) PayoutCallback(c *gin.Context) {
+	token := c.GetHeader("X-Callback-Token")
+	if token == "" {
+		token = c.Query("token")
+	}
+	if h.callbackToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(h.callbackToken)) != 1 {
+		c.JSON(http.StatusUnauthorized, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid callback token",
+		})
+		return
+	}
+
+	var req request.PayoutCallbackRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid request: " + err.Error(),
+		})
+		return
+	}
+
+	output, err := h.handlePayoutCallbackService.HandlePayoutCallback(c.Request.Context(), payoutApp.HandlePayoutCallbackInput{
+		Reference:        req.Reference,
+		ProviderPayoutID: req.ProviderPayoutID,
+		Status:           req.Status,
+		FailureReason:    req.FailureReason,
+	})
+	if err != nil {
+		writePayoutError(c, "Failed to handle payout callback", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Data:    toPayoutResponse(output),
+	})
+}
+
+// toPayoutResponse converts a PayoutOutput to a PayoutResponse
+func toPayoutResponse(output *payoutApp.PayoutOutput) response.PayoutResponse {
+	payoutResponse := response.PayoutResponse{
+		ID:                output.ID.String(),
+		WinnerID:          output.WinnerID.String(),
+		DrawID:            output.DrawID.String(),
+		MaskedMSISDN:      util.MaskMSISDN(output.MSISDN),
+		Method:            output.Method,
+		Amount:            output.Amount,
+		Currency:          output.Currency,
+		Status:            output.Status,
+		Provider:          output.Provider,
+		Attempts:          output.Attempts,
+		ProviderReference: output.ProviderReference,
+		ProviderPayoutID:  output.ProviderPayoutID,
+		FailureReason:     output.FailureReason,
+		RequestedBy:       output.RequestedBy.String(),
+		CreatedAt:         util.FormatTimeOrEmpty(output.CreatedAt, time.RFC3339),
+		UpdatedAt:         util.FormatTimeOrEmpty(output.UpdatedAt, time.RFC3339),
+	}
+	if output.CompletedAt != nil {
+		payoutResponse.CompletedAt = output.CompletedAt.Format(time.RFC3339)
+	}
+	return payoutResponse
+}
+
+// writePayoutError writes the response for a failed payout request
+func writePayoutError(c *gin.Context, message string, err error) {
+	var drawErr *draw.DrawError
+	if errors.As(err, &drawErr) {
+		writeDrawError(c, message, err)
+		return
+	}
+
+	status := http.StatusInternalServerError
+	details := ""
+
+	var payoutErr *payout.PayoutError
+	if errors.As(err, &payoutErr) {
+		details = payoutErr.Code
+		switch payoutErr.Code {
+		case payout.ErrPayoutNotFound:
+			status = http.StatusNotFound
+		case payout.ErrWinnerNotPayable, payout.ErrPayoutInProgress, payout.ErrPayoutAlreadyPaid:
+			status = http.StatusConflict
+		case payout.ErrNoProviderForMethod:
+			status = http.StatusServiceUnavailable
+		default:
+			status = http.StatusBadRequest
+		}
+	}
+
+	c.JSON(status, response.ErrorResponse{
+		Success: false,
+		Error:   message + ": " + err.Error(),
+		Details: details,
+	})
+}
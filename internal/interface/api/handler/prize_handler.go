@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/google/uuid"
 
 	prizeApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/prize"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/prize"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/dto/request"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/dto/response"
@@ -126,6 +128,8 @@ func (h *PrizeHandler) CreatePrizeStructure(c *gin.Context) {
 			Value:             value, // Using converted float64 value
 			Quantity:          p.Quantity,
 			NumberOfRunnerUps: p.NumberOfRunnerUps,
+			ClaimWindowHours:  p.ClaimWindowHours,
+			NotificationTemplate: p.NotificationTemplate,
 		})
 	}
 
@@ -139,6 +143,9 @@ func (h *PrizeHandler) CreatePrizeStructure(c *gin.Context) {
 		CreatedBy:   userID,
 		IsActive:    req.IsActive,
 	}
+	if rules := toEligibilityRules(req.EligibilityRules); rules != nil {
+		appInput.EligibilityRules = *rules
+	}
 	
 	// Convert domain prizes to application prizes
 	for _, p := range prizes {
@@ -148,16 +155,15 @@ func (h *PrizeHandler) CreatePrizeStructure(c *gin.Context) {
 			Value:             p.Value,
 			Quantity:          p.Quantity,
 			NumberOfRunnerUps: p.NumberOfRunnerUps,
+			ClaimWindowHours:  p.ClaimWindowHours,
+			NotificationTemplate: p.NotificationTemplate,
 		})
 	}
 
 	// Create prize structure
 	result, err := h.createPrizeStructureService.CreatePrizeStructure(c.Request.Context(), appInput)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
-			Success: false,
-			Error:   "Failed to create prize structure: " + err.Error(),
-		})
+		writePrizeError(c, "Failed to create prize structure", err)
 		return
 	}
 
@@ -171,6 +177,8 @@ func (h *PrizeHandler) CreatePrizeStructure(c *gin.Context) {
 			Value:             util.FormatCurrency(p.Value, "N"), // Format as currency string
 			Quantity:          p.Quantity,
 			NumberOfRunnerUps: p.NumberOfRunnerUps,
+			ClaimWindowHours:  p.ClaimWindowHours,
+			NotificationTemplate: p.NotificationTemplate,
 		})
 	}
 
@@ -182,6 +190,7 @@ func (h *PrizeHandler) CreatePrizeStructure(c *gin.Context) {
 		ValidFrom:   result.StartDate.Format("2006-01-02"),
 		ValidTo:     result.EndDate.Format("2006-01-02"),
 		Prizes:      prizesResponse,
+		EligibilityRules: toEligibilityRulesResponse(result.EligibilityRules),
 		IsActive:    result.IsActive,
 	}
 
@@ -238,6 +247,8 @@ func (h *PrizeHandler) GetPrizeStructure(c *gin.Context) {
 			Value:             util.FormatCurrency(p.Value, "N"), // Format as currency string
 			Quantity:          p.Quantity,
 			NumberOfRunnerUps: p.NumberOfRunnerUps,
+			ClaimWindowHours:  p.ClaimWindowHours,
+			NotificationTemplate: p.NotificationTemplate,
 		})
 	}
 
@@ -249,6 +260,7 @@ func (h *PrizeHandler) GetPrizeStructure(c *gin.Context) {
 		ValidFrom:   result.StartDate.Format("2006-01-02"),
 		ValidTo:     result.EndDate.Format("2006-01-02"),
 		Prizes:      prizesResponse,
+		EligibilityRules: toEligibilityRulesResponse(result.EligibilityRules),
 		IsActive:    result.IsActive,
 	}
 
@@ -301,6 +313,8 @@ func (h *PrizeHandler) ListPrizeStructures(c *gin.Context) {
 				Value:             util.FormatCurrency(p.Value, "N"), // Format as currency string
 				Quantity:          p.Quantity,
 				NumberOfRunnerUps: p.NumberOfRunnerUps,
+				ClaimWindowHours:  p.ClaimWindowHours,
+				NotificationTemplate: p.NotificationTemplate,
 			})
 		}
 
@@ -311,6 +325,7 @@ func (h *PrizeHandler) ListPrizeStructures(c *gin.Context) {
 			ValidFrom:   ps.StartDate.Format("2006-01-02"),
 			ValidTo:     ps.EndDate.Format("2006-01-02"),
 			Prizes:      prizesResponse,
+			EligibilityRules: toEligibilityRulesResponse(ps.EligibilityRules),
 			IsActive:    ps.IsActive,
 		})
 	}
@@ -446,6 +461,8 @@ func (h *PrizeHandler) UpdatePrizeStructure(c *gin.Context) {
 			Value:             value, // Using converted float64 value
 			Quantity:          p.Quantity,
 			NumberOfRunnerUps: p.NumberOfRunnerUps,
+			ClaimWindowHours:  p.ClaimWindowHours,
+			NotificationTemplate: p.NotificationTemplate,
 		})
 	}
 
@@ -457,6 +474,7 @@ func (h *PrizeHandler) UpdatePrizeStructure(c *gin.Context) {
 		StartDate:   startDate,
 		EndDate:     endDate,
 		Prizes:      make([]prizeApp.UpdatePrizeInput, 0, len(prizes)),
+		EligibilityRules: toEligibilityRules(req.EligibilityRules),
 		UpdatedBy:   userID,
 		IsActive:    req.IsActive,
 	}
@@ -470,16 +488,15 @@ func (h *PrizeHandler) UpdatePrizeStructure(c *gin.Context) {
 			Value:             p.Value,
 			Quantity:          p.Quantity,
 			NumberOfRunnerUps: p.NumberOfRunnerUps,
+			ClaimWindowHours:  p.ClaimWindowHours,
+			NotificationTemplate: p.NotificationTemplate,
 		})
 	}
 
 	// Update prize structure
 	result, err := h.updatePrizeStructureService.UpdatePrizeStructure(c.Request.Context(), appInput)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
-			Success: false,
-			Error:   "Failed to update prize structure: " + err.Error(),
-		})
+		writePrizeError(c, "Failed to update prize structure", err)
 		return
 	}
 
@@ -493,6 +510,8 @@ func (h *PrizeHandler) UpdatePrizeStructure(c *gin.Context) {
 			Value:             util.FormatCurrency(p.Value, "N"), // Format as currency string
 			Quantity:          p.Quantity,
 			NumberOfRunnerUps: p.NumberOfRunnerUps,
+			ClaimWindowHours:  p.ClaimWindowHours,
+			NotificationTemplate: p.NotificationTemplate,
 		})
 	}
 
@@ -504,6 +523,7 @@ func (h *PrizeHandler) UpdatePrizeStructure(c *gin.Context) {
 		ValidFrom:   startDate.Format("2006-01-02"),
 		ValidTo:     endDate.Format("2006-01-02"),
 		Prizes:      prizesResponse,
+		EligibilityRules: toEligibilityRulesResponse(result.EligibilityRules),
 		IsActive:    result.IsActive,
 	}
 
@@ -590,3 +610,56 @@ func (h *PrizeHandler) DeletePrizeStructure(c *gin.Context) {
 		Message: "Prize structure deleted successfully",
 	})
 }
+
+// toEligibilityRules converts optional eligibility rules from a request
+func toEligibilityRules(req *request.EligibilityRulesRequest) *draw.EligibilityRules {
+	if req == nil {
+		return nil
+	}
+	
+	return &draw.EligibilityRules{
+		RechargeWindowDays: req.RechargeWindowDays,
+		MinimumPoints:      req.MinimumPoints,
+		BlacklistedMSISDNs: req.BlacklistedMSISDNs,
+		WinCooldownDays:    req.WinCooldownDays,
+	}
+}
+
+// toEligibilityRulesResponse converts eligibility rules for a response
+func toEligibilityRulesResponse(rules draw.EligibilityRules) response.EligibilityRulesResponse {
+	blacklist := rules.BlacklistedMSISDNs
+	if blacklist == nil {
+		blacklist = []string{}
+	}
+	
+	return response.EligibilityRulesResponse{
+		RechargeWindowDays: rules.RechargeWindowDays,
+		MinimumPoints:      rules.MinimumPoints,
+		BlacklistedMSISDNs: blacklist,
+		WinCooldownDays:    rules.WinCooldownDays,
+	}
+}
+
+// writePrizeError maps prize domain errors to HTTP statuses
+func writePrizeError(c *gin.Context, message string, err error) {
+	var prizeErr *prize.PrizeError
+	if !errors.As(err, &prizeErr) {
+		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+			Success: false,
+			Error:   message + ": " + err.Error(),
+		})
+		return
+	}
+
+	status := http.StatusBadRequest
+	switch prizeErr.Code {
+	case prize.ErrPrizeStructureNotFound, prize.ErrPrizeNotFound, prize.ErrPrizeTierNotFound:
+		status = http.StatusNotFound
+	}
+
+	c.JSON(status, response.ErrorResponse{
+		Success: false,
+		Error:   message + ": " + prizeErr.Error(),
+		Details: prizeErr.Code,
+	})
+}
@@ -0,0 +1,223 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	reportApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/report"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/draw"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/report"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/user"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/dto/response"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/spreadsheet"
+)
+
+// ReportHandler handles report export HTTP requests
+type ReportHandler struct {
+	exportWinnersReportService        *reportApp.ExportWinnersReportService
+	exportPaymentLedgerService        *reportApp.ExportPaymentLedgerService
+	exportPrizeLiabilityService       *reportApp.ExportPrizeLiabilityService
+	generateWinnersCertificateService *reportApp.GenerateWinnersCertificateService
+}
+
+// NewReportHandler creates a new ReportHandler
+func NewReportHandler(
+	exportWinnersReportService *reportApp.ExportWinnersReportService,
+	exportPaymentLedgerService *reportApp.ExportPaymentLedgerService,
+	exportPrizeLiabilityService *reportApp.ExportPrizeLiabilityService,
+	generateWinnersCertificateService *reportApp.GenerateWinnersCertificateService,
+) *ReportHandler {
+	return &ReportHandler{
+		exportWinnersReportService:        exportWinnersReportService,
+		exportPaymentLedgerService:        exportPaymentLedgerService,
+		exportPrizeLiabilityService:       exportPrizeLiabilityService,
+		generateWinnersCertificateService: generateWinnersCertificateService,
+	}
+}
+
+// exportFunc writes a report to w
+type exportFunc func(ctx context.Context, input reportApp.ExportInput, w spreadsheet.RowWriter) (*reportApp.ExportOutput, error)
+
+// ExportWinnersReport handles GET /api/admin/reports/winners
+func (h *ReportHandler) ExportWinnersReport(c *gin.Context) {
+	h.export(c, "winners-report", h.exportWinnersReportService.ExportWinnersReport)
+}
+
+// ExportPaymentLedger handles GET /api/admin/reports/payments
+func (h *ReportHandler) ExportPaymentLedger(c *gin.Context) {
+	h.export(c, "payment-ledger", h.exportPaymentLedgerService.ExportPaymentLedger)
+}
+
+// ExportPrizeLiability handles GET /api/admin/reports/prize-liability
+func (h *ReportHandler) ExportPrizeLiability(c *gin.Context) {
+	h.export(c, "prize-liability", h.exportPrizeLiabilityService.ExportPrizeLiability)
+}
+
+// GetWinnersCertificate handles GET /api/admin/reports/draws/:id/certificate
+func (h *ReportHandler) GetWinnersCertificate(c *gin.Context) {
+	drawID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid draw ID",
+			Details: "Draw ID must be a valid UUID",
+		})
+		return
+	}
+
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	output, err := h.generateWinnersCertificateService.GenerateWinnersCertificate(c.Request.Context(), drawID, userID)
+	if err != nil {
+		writeReportError(c, "Failed to generate winners certificate", err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=winners-certificate-%s.pdf", output.DrawID.String()))
+	c.Header("X-Certificate-Signature", output.Signature)
+	c.Header("X-Certificate-Key-Id", output.KeyID)
+	c.Data(http.StatusOK, "application/pdf", output.PDF)
+}
+
+// export streams a report as CSV or XLSX, chosen by the format query parameter.
+// MSISDNs are always masked for report-only roles; other roles can ask for masking with maskMsisdn=true.
+func (h *ReportHandler) export(c *gin.Context, name string, exportReport exportFunc) {
+	format := c.DefaultQuery("format", spreadsheet.FormatCSV)
+	if format != spreadsheet.FormatCSV && format != spreadsheet.FormatXLSX {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid format",
+			Details: "Format must be csv or xlsx",
+		})
+		return
+	}
+
+	filters, err := parseReportFilters(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid report filters",
+			Details: err.Error(),
+		})
+		return
+	}
+	if err := filters.Validate(); err != nil {
+		writeReportError(c, "Invalid report filters", err)
+		return
+	}
+
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	maskMSISDN, _ := strconv.ParseBool(c.DefaultQuery("maskMsisdn", "false"))
+	if user.IsReportOnlyRole(c.GetString("role")) {
+		maskMSISDN = true
+	}
+
+	c.Header("Content-Type", spreadsheet.ContentType(format))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s-%s.%s", name, time.Now().Format("20060102-150405"), format))
+	c.Status(http.StatusOK)
+
+	writer, err := spreadsheet.NewWriter(c.Writer, format)
+	if err == nil {
+		_, err = exportReport(c.Request.Context(), reportApp.ExportInput{
+			Filters:    filters,
+			Format:     format,
+			MaskMSISDN: maskMSISDN,
+			ExportedBy: userID,
+		}, writer)
+	}
+	if err == nil {
+		err = writer.Close()
+	}
+	if err != nil {
+		if !c.Writer.Written() {
+			c.Writer.Header().Del("Content-Type")
+			c.Writer.Header().Del("Content-Disposition")
+			writeReportError(c, "Failed to export report", err)
+			return
+		}
+		// Part of the file has been sent; drop the connection so the client sees an incomplete download
+		fmt.Printf("Failed to export %s: %v\n", name, err)
+		if conn, _, hijackErr := c.Writer.Hijack(); hijackErr == nil {
+			conn.Close()
+		}
+		c.Abort()
+	}
+}
+
+// parseReportFilters reads the draw and date range of a report from the query string
+func parseReportFilters(c *gin.Context) (report.Filters, error) {
+	var filters report.Filters
+
+	if value := c.Query("drawId"); value != "" {
+		drawID, err := uuid.Parse(value)
+		if err != nil {
+			return filters, errors.New("drawId must be a valid UUID")
+		}
+		filters.DrawID = drawID
+	}
+
+	if value := c.Query("startDate"); value != "" {
+		startDate, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return filters, errors.New("startDate must be in YYYY-MM-DD format")
+		}
+		filters.StartDate = startDate
+	}
+
+	if value := c.Query("endDate"); value != "" {
+		endDate, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return filters, errors.New("endDate must be in YYYY-MM-DD format")
+		}
+		filters.EndDate = endDate
+	}
+
+	return filters, nil
+}
+
+// writeReportError writes a report error with the status matching its code; draw errors
+// are written as the draw handler writes them
+func writeReportError(c *gin.Context, message string, err error) {
+	var drawErr *draw.DrawError
+	if errors.As(err, &drawErr) {
+		writeDrawError(c, message, err)
+		return
+	}
+
+	var reportErr *report.ReportError
+	if !errors.As(err, &reportErr) {
+		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+			Success: false,
+			Error:   message + ": " + err.Error(),
+		})
+		return
+	}
+
+	status := http.StatusBadRequest
+	switch reportErr.Code {
+	case report.ErrDrawNotCertifiable:
+		status = http.StatusConflict
+	case report.ErrSigningKeyMissing:
+		status = http.StatusServiceUnavailable
+	}
+
+	c.JSON(status, response.ErrorResponse{
+		Success: false,
+		Error:   message + ": " + reportErr.Error(),
+		Details: reportErr.Code,
+	})
+}
@@ -1,12 +1,14 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
 	userApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/user"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/user"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/dto/request"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/dto/response"
 )
@@ -95,7 +97,12 @@ func (h *ResetPasswordHandler) ResetPassword(c *gin.Context) {
 
 	_, err = h.resetPasswordService.ResetPassword(c.Request.Context(), input)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+		status := http.StatusBadRequest
+		var userErr *user.UserError
+		if errors.As(err, &userErr) && userErr.Code == user.ErrInsufficientRole {
+			status = http.StatusForbidden
+		}
+		c.JSON(status, response.ErrorResponse{
 			Success: false,
 			Error:   "Failed to reset password: " + err.Error(),
 		})
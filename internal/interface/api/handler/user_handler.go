@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/google/uuid"
 
 	userApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/user"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/dto/request"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/dto/response"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/util"
@@ -52,12 +54,20 @@ func (h *UserHandler) Login(c *gin.Context) {
 	}
 
 	input := userApp.AuthenticateUserInput{
-		Email:    req.Email,
-		Password: req.Password,
+		Email:     req.Email,
+		Password:  req.Password,
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
 	}
 
 	output, err := h.authenticateUserService.AuthenticateUser(c.Request.Context(), input)
 	if err != nil {
+		// Lockouts carry their own status and error code
+		var authErr *auth.AuthError
+		if errors.As(err, &authErr) {
+			writeAuthError(c, "Authentication failed", err)
+			return
+		}
 		c.JSON(http.StatusUnauthorized, response.ErrorResponse{
 			Success: false,
 			Error:   "Authentication failed: " + err.Error(),
@@ -68,22 +78,65 @@ func (h *UserHandler) Login(c *gin.Context) {
 	// Return response with explicit type conversions at DTO boundary
 	c.JSON(http.StatusOK, response.SuccessResponse{
 		Success: true,
-		Data: response.LoginResponse{
-			Token: output.Token,
-			User: response.UserResponse{
-				ID:       output.User.ID.String(),
-				Username: output.User.Username,
-				Email:    output.User.Email,
-				Role:     output.User.Role,
-				IsActive: true, // Default to true since field is missing
-				// Include additional fields for frontend compatibility
-				FullName:  output.User.Username, // Use username as fallback for fullname
-				CreatedAt: time.Now().Format(time.RFC3339), // Default since field is missing
-				UpdatedAt: time.Now().Format(time.RFC3339), // Default since field is missing
-			},
-			Expiry: util.FormatTimeOrEmpty(output.ExpiresAt, time.RFC3339),
-		},
+		Data:    newLoginResponse(output),
+	})
+}
+
+// VerifyLogin handles the second step of a two-factor login
+func (h *UserHandler) VerifyLogin(c *gin.Context) {
+	var req request.VerifyLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Error:   "Invalid request: " + err.Error(),
+		})
+		return
+	}
+
+	output, err := h.authenticateUserService.VerifyTwoFactorLogin(c.Request.Context(), userApp.VerifyTwoFactorLoginInput{
+		ChallengeToken: req.ChallengeToken,
+		Code:           req.Code,
+		IPAddress:      c.ClientIP(),
+		UserAgent:      c.Request.UserAgent(),
 	})
+	if err != nil {
+		writeAuthError(c, "Authentication failed", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Data:    newLoginResponse(output),
+	})
+}
+
+// newLoginResponse converts the output of a login step to its response
+func newLoginResponse(output *userApp.AuthenticateUserOutput) response.LoginResponse {
+	loginResponse := response.LoginResponse{
+		Token: output.Token,
+		User: response.UserResponse{
+			ID:       output.User.ID.String(),
+			Username: output.User.Username,
+			Email:    output.User.Email,
+			Role:     output.User.Role,
+			IsActive: true, // Default to true since field is missing
+			// Include additional fields for frontend compatibility
+			FullName:  output.User.Username, // Use username as fallback for fullname
+			CreatedAt: time.Now().Format(time.RFC3339), // Default since field is missing
+			UpdatedAt: time.Now().Format(time.RFC3339), // Default since field is missing
+		},
+		Expiry:        util.FormatTimeOrEmpty(output.ExpiresAt, time.RFC3339),
+		RefreshToken:  output.RefreshToken,
+		RefreshExpiry: util.FormatTimeOrEmpty(output.RefreshExpiresAt, time.RFC3339),
+	}
+
+	if output.TwoFactorRequired {
+		loginResponse.TwoFactorRequired = true
+		loginResponse.ChallengeToken = output.ChallengeToken
+		loginResponse.ChallengeExpiry = util.FormatTimeOrEmpty(output.ChallengeExpiresAt, time.RFC3339)
+	}
+
+	return loginResponse
 }
 
 // CreateUser handles user creation
@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"strings"
 	"time"
@@ -8,26 +10,47 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/user"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/dto/response"
 )
 
+// StepUpHeader carries the fresh TOTP code demanded by sensitive operations
+const StepUpHeader = "X-TOTP-Code"
+
+// StepUpVerifier checks the fresh second factor code of a sensitive operation
+type StepUpVerifier interface {
+	VerifyStepUp(ctx context.Context, userID uuid.UUID, code, ipAddress string) error
+}
+
 // AuthMiddleware handles JWT authentication
 type AuthMiddleware struct {
-	jwtSecret string
+	jwtSecret       string
+	tokenRepository auth.TokenRepository
+	userRepository  user.UserRepository
+	stepUpVerifier  StepUpVerifier
 }
 
-// NewAuthMiddleware creates a new AuthMiddleware
-func NewAuthMiddleware(jwtSecret string) *AuthMiddleware {
+// NewAuthMiddleware creates a new AuthMiddleware. Access tokens on the revocation list
+// of tokenRepository are rejected, as are tokens of users that are inactive or whose token
+// version has moved on. userRepository is consulted on every request and should be cached.
+// stepUpVerifier checks the TOTP codes of routes behind RequireStepUp.
+func NewAuthMiddleware(jwtSecret string, tokenRepository auth.TokenRepository, userRepository user.UserRepository, stepUpVerifier StepUpVerifier) *AuthMiddleware {
 	return &AuthMiddleware{
-		jwtSecret: jwtSecret,
+		jwtSecret:       jwtSecret,
+		tokenRepository: tokenRepository,
+		userRepository:  userRepository,
+		stepUpVerifier:  stepUpVerifier,
 	}
 }
 
 // Claims represents JWT claims
 type Claims struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
-	Role     string `json:"role"`
+	UserID       string `json:"user_id"`
+	Username     string `json:"username"`
+	Role         string `json:"role"`
+	SessionID    string `json:"sid"`
+	TokenVersion int    `json:"ver"`
 	jwt.RegisteredClaims
 }
 
@@ -96,17 +119,104 @@ func (m *AuthMiddleware) Authenticate() gin.HandlerFunc {
 			return
 		}
 
-		// Set user information in context
-		// Convert userID to UUID for consistent handling
+		// Reject tokens revoked by logout or by reuse of their session's refresh token.
+		// Tokens issued without a jti cannot be revoked and run until they expire.
+		if claims.ID != "" {
+			revoked, err := m.tokenRepository.IsAccessTokenRevoked(claims.ID)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+					Success: false,
+					Error:   "Internal server error",
+					Details: "Failed to check token revocation",
+				})
+				c.Abort()
+				return
+			}
+			if revoked {
+				c.JSON(http.StatusUnauthorized, response.ErrorResponse{
+					Success: false,
+					Error:   "Unauthorized",
+					Details: "Token has been revoked",
+				})
+				c.Abort()
+				return
+			}
+		}
+
+		// Check the token against the current state of its user: deactivation, a role change
+		// or a password reset bumps the user's token version and ends every earlier token
 		userUUID, err := uuid.Parse(claims.UserID)
-		if err == nil {
-			c.Set("userID", userUUID)
-		} else {
-			// Fallback to string if parsing fails
-			c.Set("userID", claims.UserID)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, response.ErrorResponse{
+				Success: false,
+				Error:   "Unauthorized",
+				Details: "Invalid token subject",
+			})
+			c.Abort()
+			return
+		}
+
+		userEntity, err := m.userRepository.GetByID(userUUID)
+		if err != nil {
+			var userErr *user.UserError
+			if errors.As(err, &userErr) && userErr.Code == user.ErrUserNotFound {
+				c.JSON(http.StatusUnauthorized, response.ErrorResponse{
+					Success: false,
+					Error:   "Unauthorized",
+					Details: "User no longer exists",
+				})
+			} else {
+				c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+					Success: false,
+					Error:   "Internal server error",
+					Details: "Failed to check token user",
+				})
+			}
+			c.Abort()
+			return
+		}
+
+		if !userEntity.IsActive {
+			c.JSON(http.StatusUnauthorized, response.ErrorResponse{
+				Success: false,
+				Error:   "Unauthorized",
+				Details: "User is inactive",
+			})
+			c.Abort()
+			return
+		}
+
+		if claims.TokenVersion != userEntity.TokenVersion {
+			c.JSON(http.StatusUnauthorized, response.ErrorResponse{
+				Success: false,
+				Error:   "Unauthorized",
+				Details: "Token is no longer valid, please log in again",
+			})
+			c.Abort()
+			return
 		}
+
+		// Rows not yet migrated may hold a legacy role spelling such as "super_admin"
+		role, ok := user.NormalizeRole(userEntity.Role)
+		if !ok {
+			c.JSON(http.StatusForbidden, response.ErrorResponse{
+				Success: false,
+				Error:   "Forbidden",
+				Details: "Unknown user role",
+			})
+			c.Abort()
+			return
+		}
+
+		// Set user information in context
+		c.Set("userID", userUUID)
 		c.Set("username", claims.Username)
-		c.Set("role", claims.Role)
+		c.Set("role", role)
+		c.Set("tokenID", claims.ID)
+		c.Set("sessionID", claims.SessionID)
+		if claims.ExpiresAt != nil {
+			c.Set("tokenExpiresAt", claims.ExpiresAt.Time)
+		}
 		c.Next()
 	}
 }
@@ -140,7 +250,7 @@ func (m *AuthMiddleware) RequireRole(roles ...string) gin.HandlerFunc {
 		// Check if user has one of the required roles
 		hasRole := false
 		for _, r := range roles {
-			if role == r {
+			if canonical, ok := user.NormalizeRole(r); ok && role == canonical {
 				hasRole = true
 				break
 			}
@@ -160,6 +270,83 @@ func (m *AuthMiddleware) RequireRole(roles ...string) gin.HandlerFunc {
 	}
 }
 
+// RequirePermission checks if the user's role grants the required permission
+func (m *AuthMiddleware) RequirePermission(permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role := c.GetString("role")
+		if role == "" {
+			c.JSON(http.StatusUnauthorized, response.ErrorResponse{
+				Success: false,
+				Error:   "Unauthorized",
+				Details: "User role not found in token",
+			})
+			c.Abort()
+			return
+		}
+
+		if !user.HasPermission(role, permission) {
+			c.JSON(http.StatusForbidden, response.ErrorResponse{
+				Success: false,
+				Error:   "Forbidden",
+				Details: "Insufficient permissions: " + permission + " is required",
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RequireStepUp demands a fresh TOTP code in the X-TOTP-Code header, even within an active
+// session. It must follow Authenticate.
+func (m *AuthMiddleware) RequireStepUp() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := c.Get("userID")
+		if !ok {
+			c.JSON(http.StatusUnauthorized, response.ErrorResponse{
+				Success: false,
+				Error:   "Unauthorized",
+				Details: "User not found in token",
+			})
+			c.Abort()
+			return
+		}
+
+		err := m.stepUpVerifier.VerifyStepUp(c.Request.Context(), userID.(uuid.UUID), c.GetHeader(StepUpHeader), c.ClientIP())
+		if err == nil {
+			c.Next()
+			return
+		}
+
+		var authErr *auth.AuthError
+		if !errors.As(err, &authErr) {
+			c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+				Success: false,
+				Error:   "Internal server error",
+				Details: "Failed to verify two-factor code",
+			})
+			c.Abort()
+			return
+		}
+
+		status := http.StatusForbidden
+		switch authErr.Code {
+		case auth.ErrAccountLocked:
+			status = http.StatusLocked
+		case auth.ErrLoginThrottled:
+			status = http.StatusTooManyRequests
+		}
+
+		c.JSON(status, response.ErrorResponse{
+			Success: false,
+			Error:   authErr.Message,
+			Details: authErr.Code,
+		})
+		c.Abort()
+	}
+}
+
 // GenerateToken generates a JWT token
 func (m *AuthMiddleware) GenerateToken(userID, username, role string, expirationHours int) (string, time.Time, error) {
 	// Set expiration time
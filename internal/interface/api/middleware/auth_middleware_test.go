@@ -0,0 +1,113 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	userApp "github.com/ArowuTest/GP-Backend-Promo/internal/application/user"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/auth"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/user"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/infrastructure/cache"
+	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/api/middleware"
+)
+
+const testJWTSecret = "test-secret"
+
+// fakeUserRepository keeps users in memory
+type fakeUserRepository struct {
+	user.UserRepository
+	users map[uuid.UUID]user.User
+}
+
+func (r *fakeUserRepository) GetByID(id uuid.UUID) (*user.User, error) {
+	u, ok := r.users[id]
+	if !ok {
+		return nil, user.NewUserError(user.ErrUserNotFound, "User not found", nil)
+	}
+	return &u, nil
+}
+
+func (r *fakeUserRepository) Update(u *user.User) error {
+	r.users[u.ID] = *u
+	return nil
+}
+
+// fakeTokenRepository revokes no access tokens
+type fakeTokenRepository struct {
+	auth.TokenRepository
+}
+
+func (fakeTokenRepository) IsAccessTokenRevoked(tokenID string) (bool, error) {
+	return false, nil
+}
+
+type fakeAuditService struct{}
+
+func (fakeAuditService) LogAudit(action, entityType string, entityID uuid.UUID, userID uuid.UUID, summary, details string) error {
+	return nil
+}
+
+func signToken(t *testing.T, u user.User) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, middleware.Claims{
+		UserID:       u.ID.String(),
+		Username:     u.Username,
+		Role:         u.Role,
+		TokenVersion: u.TokenVersion,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}).SignedString([]byte(testJWTSecret))
+	require.NoError(t, err)
+	return token
+}
+
+func TestAuthenticate_RejectsDeactivatedUserOnNextRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	admin := user.User{ID: uuid.New(), Email: "admin@example.com", Username: "admin", Role: user.RoleAdmin, IsActive: true}
+	repository := &fakeUserRepository{users: map[uuid.UUID]user.User{admin.ID: admin}}
+
+	// Wired as in the server: the middleware reads through the cache, user services through ForWrites
+	cachedUsers := cache.NewUserRepository(repository, time.Hour)
+	authMiddleware := middleware.NewAuthMiddleware(testJWTSecret, fakeTokenRepository{}, cachedUsers, nil)
+	updateUserService := userApp.NewUpdateUserService(cachedUsers.ForWrites(), fakeAuditService{})
+
+	router := gin.New()
+	router.GET("/me", authMiddleware.Authenticate(), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	token := signToken(t, admin)
+	request := func() int {
+		req := httptest.NewRequest(http.MethodGet, "/me", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+		return recorder.Code
+	}
+
+	// The first request caches the user
+	require.Equal(t, http.StatusNoContent, request())
+
+	_, err := updateUserService.UpdateUser(context.Background(), userApp.UpdateUserInput{
+		ID:        admin.ID,
+		Email:     admin.Email,
+		Role:      admin.Role,
+		IsActive:  false,
+		UpdatedBy: uuid.New(),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusUnauthorized, request())
+}
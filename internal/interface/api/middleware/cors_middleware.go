@@ -35,7 +35,7 @@ func Default() *CORSMiddleware {
 	return &CORSMiddleware{
 		allowOrigins:     []string{"https://gp-admin-promo.vercel.app", "http://localhost:3000"},
 		allowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		allowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization"},
+		allowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-TOTP-Code"},
 		exposeHeaders:    []string{"Content-Length"},
 		allowCredentials: true,
 	}
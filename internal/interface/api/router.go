@@ -3,6 +3,7 @@ package api
 import (
 	"github.com/gin-gonic/gin"
 	
+	"github.com/ArowuTest/GP-Backend-Promo/internal/domain/user"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/api/handler"
 	"github.com/ArowuTest/GP-Backend-Promo/internal/interface/api/middleware"
 )
@@ -19,6 +20,12 @@ type Router struct {
 	auditHandler     *handler.AuditHandler
 	userHandler      *handler.UserHandler
 	resetPasswordHandler *handler.ResetPasswordHandler
+	blacklistHandler *handler.BlacklistHandler
+	drawScheduleHandler *handler.DrawScheduleHandler
+	notificationHandler *handler.NotificationHandler
+	payoutHandler *handler.PayoutHandler
+	reportHandler *handler.ReportHandler
+	authHandler *handler.AuthHandler
 }
 
 // NewRouter creates a new Router
@@ -33,6 +40,12 @@ func NewRouter(
 	auditHandler *handler.AuditHandler,
 	userHandler *handler.UserHandler,
 	resetPasswordHandler *handler.ResetPasswordHandler,
+	blacklistHandler *handler.BlacklistHandler,
+	drawScheduleHandler *handler.DrawScheduleHandler,
+	notificationHandler *handler.NotificationHandler,
+	payoutHandler *handler.PayoutHandler,
+	reportHandler *handler.ReportHandler,
+	authHandler *handler.AuthHandler,
 ) *Router {
 	return &Router{
 		engine:           engine,
@@ -45,6 +58,12 @@ func NewRouter(
 		auditHandler:     auditHandler,
 		userHandler:      userHandler,
 		resetPasswordHandler: resetPasswordHandler,
+		blacklistHandler: blacklistHandler,
+		drawScheduleHandler: drawScheduleHandler,
+		notificationHandler: notificationHandler,
+		payoutHandler: payoutHandler,
+		reportHandler: reportHandler,
+		authHandler: authHandler,
 	}
 }
 
@@ -69,65 +88,127 @@ func (r *Router) Setup() {
 	auth := api.Group("/auth")
 	{
 		auth.POST("/login", r.userHandler.Login)
+		auth.POST("/refresh", r.authHandler.RefreshToken)
+		auth.POST("/logout", r.authMiddleware.Authenticate(), r.authHandler.Logout)
+		auth.POST("/login/verify", r.userHandler.VerifyLogin)
+		auth.POST("/forgot-password", r.authHandler.ForgotPassword)
+		auth.POST("/reset-password", r.authHandler.ResetForgottenPassword)
+		auth.POST("/2fa/enroll", r.authMiddleware.Authenticate(), r.authHandler.EnrollTwoFactor)
+		auth.POST("/2fa/confirm", r.authMiddleware.Authenticate(), r.authHandler.ConfirmTwoFactor)
+		auth.POST("/2fa/disable", r.authMiddleware.Authenticate(), r.authHandler.DisableTwoFactor)
+	}
+
+	// SMS gateway callbacks, authenticated with the shared callback token
+	notifications := api.Group("/notifications")
+	{
+		notifications.POST("/delivery-receipts", r.notificationHandler.DeliveryReceipt)
+	}
+
+	// Payout provider callbacks, authenticated with the shared callback token
+	payouts := api.Group("/payouts")
+	{
+		payouts.POST("/callback", r.payoutHandler.PayoutCallback)
 	}
 
 	// Admin routes (require authentication)
 	admin := api.Group("/admin")
 	admin.Use(r.authMiddleware.Authenticate())
 	{
+		// Every route below checks a permission of the caller's role, see user.HasPermission
+
 		// Draw routes
 		draws := admin.Group("/draws")
 		{
-			draws.GET("/eligibility-stats", r.drawHandler.GetEligibilityStats)
-			draws.POST("/execute", r.authMiddleware.RequireRole("super_admin"), r.drawHandler.ExecuteDraw)
-			draws.POST("/invoke-runner-up", r.authMiddleware.RequireRole("super_admin", "admin"), r.drawHandler.InvokeRunnerUp)
-			draws.GET("", r.drawHandler.GetDraws)
-			draws.GET("/:id", r.drawHandler.GetDrawByID)
+			draws.GET("/eligibility-stats", r.authMiddleware.RequirePermission(user.PermDrawRead), r.drawHandler.GetEligibilityStats)
+			draws.POST("/execute", r.authMiddleware.RequirePermission(user.PermDrawExecute), r.authMiddleware.RequireStepUp(), r.drawHandler.ExecuteDraw)
+			draws.POST("/simulate", r.authMiddleware.RequirePermission(user.PermDrawSimulate), r.drawHandler.SimulateDraw)
+			draws.POST("/schedule", r.authMiddleware.RequirePermission(user.PermDrawSchedule), r.drawHandler.ScheduleDraw)
+			draws.POST("/invoke-runner-up", r.authMiddleware.RequirePermission(user.PermWinnerManage), r.authMiddleware.RequireStepUp(), r.drawHandler.InvokeRunnerUp)
+			draws.GET("", r.authMiddleware.RequirePermission(user.PermDrawRead), r.drawHandler.GetDraws)
+			draws.GET("/:id", r.authMiddleware.RequirePermission(user.PermDrawRead), r.drawHandler.GetDrawByID)
+			draws.GET("/:id/verify", r.authMiddleware.RequirePermission(user.PermDrawRead), r.drawHandler.VerifyDraw)
+			draws.POST("/:id/void", r.authMiddleware.RequirePermission(user.PermDrawVoid), r.drawHandler.VoidDraw)
 		}
 
 		// Winner routes
 		winners := admin.Group("/winners")
 		{
-			winners.GET("", r.drawHandler.GetWinners)
-			winners.PUT("/:id/payment-status", r.authMiddleware.RequireRole("super_admin", "admin"), r.drawHandler.UpdateWinnerPaymentStatus)
+			winners.GET("", r.authMiddleware.RequirePermission(user.PermWinnerRead), r.drawHandler.GetWinners)
+			winners.POST("/payments/reconcile", r.authMiddleware.RequirePermission(user.PermWinnerPay), r.authMiddleware.RequireStepUp(), r.drawHandler.ReconcileWinnerPayments)
+			winners.PUT("/:id/payment-status", r.authMiddleware.RequirePermission(user.PermWinnerPay), r.authMiddleware.RequireStepUp(), r.drawHandler.UpdateWinnerPaymentStatus)
+			winners.GET("/:id/payment-history", r.authMiddleware.RequirePermission(user.PermWinnerRead), r.drawHandler.GetWinnerPaymentHistory)
+			winners.POST("/:id/invoke-runner-up", r.authMiddleware.RequirePermission(user.PermWinnerManage), r.authMiddleware.RequireStepUp(), r.drawHandler.InvokeRunnerUp)
+			winners.GET("/:id/replacement-history", r.authMiddleware.RequirePermission(user.PermWinnerRead), r.drawHandler.GetReplacementHistory)
+			winners.POST("/:id/confirm-claim", r.authMiddleware.RequirePermission(user.PermWinnerManage), r.drawHandler.ConfirmWinnerClaim)
+			winners.GET("/:id/notifications", r.authMiddleware.RequirePermission(user.PermWinnerRead), r.notificationHandler.ListWinnerNotifications)
+			winners.POST("/:id/payout", r.authMiddleware.RequirePermission(user.PermWinnerPay), r.authMiddleware.RequireStepUp(), r.payoutHandler.RequestPayout)
+			winners.GET("/:id/payout", r.authMiddleware.RequirePermission(user.PermWinnerRead), r.payoutHandler.GetWinnerPayout)
 		}
 
 		// Prize structure routes
 		prizeStructures := admin.Group("/prize-structures")
 		{
-			prizeStructures.GET("", r.prizeHandler.ListPrizeStructures)
-			prizeStructures.POST("", r.authMiddleware.RequireRole("super_admin", "admin"), r.prizeHandler.CreatePrizeStructure)
-			prizeStructures.GET("/:id", r.prizeHandler.GetPrizeStructure)
-			prizeStructures.PUT("/:id", r.authMiddleware.RequireRole("super_admin", "admin"), r.prizeHandler.UpdatePrizeStructure)
-			prizeStructures.DELETE("/:id", r.authMiddleware.RequireRole("super_admin"), r.prizeHandler.DeletePrizeStructure)
+			prizeStructures.GET("", r.authMiddleware.RequirePermission(user.PermPrizeRead), r.prizeHandler.ListPrizeStructures)
+			prizeStructures.POST("", r.authMiddleware.RequirePermission(user.PermPrizeManage), r.prizeHandler.CreatePrizeStructure)
+			prizeStructures.GET("/:id", r.authMiddleware.RequirePermission(user.PermPrizeRead), r.prizeHandler.GetPrizeStructure)
+			prizeStructures.PUT("/:id", r.authMiddleware.RequirePermission(user.PermPrizeManage), r.prizeHandler.UpdatePrizeStructure)
+			prizeStructures.DELETE("/:id", r.authMiddleware.RequirePermission(user.PermPrizeDelete), r.prizeHandler.DeletePrizeStructure)
 		}
 
 		// Participant routes
 		participants := admin.Group("/participants")
 		{
-			participants.POST("/upload", r.authMiddleware.RequireRole("super_admin", "admin", "senior_user"), r.participantHandler.UploadParticipants)
-			participants.GET("/stats", r.participantHandler.GetParticipantStats)
-			participants.GET("/uploads", r.participantHandler.ListUploadAudits)
-			participants.GET("", r.participantHandler.GetParticipants)
-			participants.DELETE("/uploads/:id", r.authMiddleware.RequireRole("super_admin", "admin"), r.participantHandler.DeleteUpload)
+			participants.POST("/upload", r.authMiddleware.RequirePermission(user.PermParticipantUpload), r.participantHandler.UploadParticipants)
+			participants.GET("/stats", r.authMiddleware.RequirePermission(user.PermParticipantRead), r.participantHandler.GetParticipantStats)
+			participants.GET("/uploads", r.authMiddleware.RequirePermission(user.PermParticipantRead), r.participantHandler.ListUploadAudits)
+			participants.GET("/uploads/:id/errors", r.authMiddleware.RequirePermission(user.PermParticipantRead), r.participantHandler.GetUploadErrorReport)
+			participants.GET("", r.authMiddleware.RequirePermission(user.PermParticipantRead), r.participantHandler.GetParticipants)
+			participants.DELETE("/uploads/:id", r.authMiddleware.RequirePermission(user.PermParticipantDelete), r.participantHandler.DeleteUpload)
+		}
+
+		// Blacklist routes
+		blacklist := admin.Group("/blacklist")
+		{
+			blacklist.GET("", r.authMiddleware.RequirePermission(user.PermBlacklistRead), r.blacklistHandler.ListBlacklistEntries)
+			blacklist.POST("", r.authMiddleware.RequirePermission(user.PermBlacklistManage), r.blacklistHandler.CreateBlacklistEntry)
+			blacklist.POST("/import", r.authMiddleware.RequirePermission(user.PermBlacklistManage), r.blacklistHandler.ImportBlacklist)
+			blacklist.GET("/:id", r.authMiddleware.RequirePermission(user.PermBlacklistRead), r.blacklistHandler.GetBlacklistEntry)
+			blacklist.PUT("/:id", r.authMiddleware.RequirePermission(user.PermBlacklistManage), r.blacklistHandler.UpdateBlacklistEntry)
+			blacklist.DELETE("/:id", r.authMiddleware.RequirePermission(user.PermBlacklistManage), r.blacklistHandler.DeleteBlacklistEntry)
+		}
+
+		// Draw schedule routes
+		drawSchedules := admin.Group("/draw-schedules")
+		{
+			drawSchedules.GET("", r.authMiddleware.RequirePermission(user.PermDrawRead), r.drawScheduleHandler.ListDrawSchedules)
+			drawSchedules.POST("", r.authMiddleware.RequirePermission(user.PermDrawSchedule), r.drawScheduleHandler.CreateDrawSchedule)
+			drawSchedules.GET("/:id", r.authMiddleware.RequirePermission(user.PermDrawRead), r.drawScheduleHandler.GetDrawSchedule)
+			drawSchedules.PUT("/:id", r.authMiddleware.RequirePermission(user.PermDrawSchedule), r.drawScheduleHandler.UpdateDrawSchedule)
+			drawSchedules.DELETE("/:id", r.authMiddleware.RequirePermission(user.PermDrawSchedule), r.drawScheduleHandler.DeleteDrawSchedule)
 		}
 
 		// Report routes
 		reports := admin.Group("/reports")
 		{
-			reports.GET("/data-uploads", r.auditHandler.GetDataUploadAudits)
+			reports.GET("/data-uploads", r.authMiddleware.RequirePermission(user.PermReportUploads), r.auditHandler.GetDataUploadAudits)
+			reports.GET("/winners", r.authMiddleware.RequirePermission(user.PermReportWinners), r.reportHandler.ExportWinnersReport)
+			reports.GET("/draws/:id/certificate", r.authMiddleware.RequirePermission(user.PermReportWinners), r.reportHandler.GetWinnersCertificate)
+			reports.GET("/payments", r.authMiddleware.RequirePermission(user.PermReportFinance), r.reportHandler.ExportPaymentLedger)
+			reports.GET("/prize-liability", r.authMiddleware.RequirePermission(user.PermReportFinance), r.reportHandler.ExportPrizeLiability)
 		}
 
 		// User routes
 		users := admin.Group("/users")
 		{
-			users.GET("", r.authMiddleware.RequireRole("super_admin"), r.userHandler.ListUsers)
-			users.POST("", r.authMiddleware.RequireRole("super_admin"), r.userHandler.CreateUser)
-			users.GET("/:id", r.authMiddleware.RequireRole("super_admin"), r.userHandler.GetUserByID)
-			users.PUT("/:id", r.authMiddleware.RequireRole("super_admin"), r.userHandler.UpdateUser)
+			users.GET("", r.authMiddleware.RequirePermission(user.PermUserManage), r.userHandler.ListUsers)
+			users.POST("", r.authMiddleware.RequirePermission(user.PermUserManage), r.userHandler.CreateUser)
+			users.GET("/:id", r.authMiddleware.RequirePermission(user.PermUserManage), r.userHandler.GetUserByID)
+			users.PUT("/:id", r.authMiddleware.RequirePermission(user.PermUserManage), r.userHandler.UpdateUser)
+			users.POST("/:id/unlock", r.authMiddleware.RequirePermission(user.PermUserUnlock), r.authHandler.UnlockUser)
+			users.POST("/:id/2fa/reset", r.authMiddleware.RequirePermission(user.PermUserResetPassword), r.authHandler.ResetTwoFactor)
 			
 			// Add the new reset password endpoint
-			users.POST("/reset-password", r.authMiddleware.RequireRole("super_admin", "admin"), r.resetPasswordHandler.ResetPassword)
+			users.POST("/reset-password", r.authMiddleware.RequirePermission(user.PermUserResetPassword), r.resetPasswordHandler.ResetPassword)
 		}
 	}
 }
@@ -13,6 +13,7 @@ type CreatePrizeStructureRequest struct {
 	ValidFrom   string                `json:"validFrom" binding:"required"` // Format: YYYY-MM-DD
 	ValidTo     string                `json:"validTo"`                      // Format: YYYY-MM-DD
 	Prizes      []CreatePrizeRequest  `json:"prizes" binding:"required,dive"`
+	EligibilityRules *EligibilityRulesRequest `json:"eligibilityRules"`
 	IsActive    bool                  `json:"isActive"`
 }
 
@@ -23,6 +24,8 @@ type CreatePrizeRequest struct {
 	Value             string `json:"value" binding:"required"`
 	Quantity          int    `json:"quantity" binding:"required,min=1"`
 	NumberOfRunnerUps int    `json:"numberOfRunnerUps" binding:"min=0"`
+	ClaimWindowHours  int    `json:"claimWindowHours" binding:"min=0"` // 0 uses the default of 72 hours
+	NotificationTemplate string `json:"notificationTemplate"` // Empty uses the default winner SMS
 }
 
 // UpdatePrizeStructureRequest defines the request for updating a prize structure
@@ -32,6 +35,7 @@ type UpdatePrizeStructureRequest struct {
 	ValidFrom   string                `json:"validFrom" binding:"required"` // Format: YYYY-MM-DD
 	ValidTo     string                `json:"validTo"`                      // Format: YYYY-MM-DD
 	Prizes      []UpdatePrizeRequest  `json:"prizes" binding:"required,dive"`
+	EligibilityRules *EligibilityRulesRequest `json:"eligibilityRules"` // Omit to keep the current rules
 	IsActive    bool                  `json:"isActive"`
 }
 
@@ -43,6 +47,29 @@ type UpdatePrizeRequest struct {
 	Value             string `json:"value" binding:"required"`
 	Quantity          int    `json:"quantity" binding:"required,min=1"`
 	NumberOfRunnerUps int    `json:"numberOfRunnerUps" binding:"min=0"`
+	ClaimWindowHours  int    `json:"claimWindowHours" binding:"min=0"` // 0 uses the default of 72 hours
+	NotificationTemplate string `json:"notificationTemplate"` // Empty uses the default winner SMS
+}
+
+// EligibilityRulesRequest defines the draw eligibility rules of a prize structure
+type EligibilityRulesRequest struct {
+	RechargeWindowDays int      `json:"rechargeWindowDays" binding:"min=0"` // 0 for all recharges up to the draw date
+	MinimumPoints      int      `json:"minimumPoints" binding:"min=0"`
+	BlacklistedMSISDNs []string `json:"blacklistedMsisdns"`
+	WinCooldownDays    int      `json:"winCooldownDays" binding:"min=0"`
+}
+
+// CreateBlacklistEntryRequest defines the request for blacklisting an MSISDN
+type CreateBlacklistEntryRequest struct {
+	MSISDN    string `json:"msisdn" binding:"required"`
+	Reason    string `json:"reason" binding:"required"`
+	ExpiresAt string `json:"expiresAt"` // Optional, format: YYYY-MM-DD or RFC3339
+}
+
+// UpdateBlacklistEntryRequest defines the request for updating a blacklist entry
+type UpdateBlacklistEntryRequest struct {
+	Reason    string `json:"reason" binding:"required"`
+	ExpiresAt string `json:"expiresAt"` // Optional, format: YYYY-MM-DD or RFC3339. Omit for a permanent entry
 }
 
 // LoginRequest defines the request for user login
@@ -51,6 +78,38 @@ type LoginRequest struct {
 	Password string `json:"password" binding:"required"`
 }
 
+// VerifyLoginRequest defines the request for the second step of a two-factor login
+type VerifyLoginRequest struct {
+	ChallengeToken string `json:"challengeToken" binding:"required"`
+	Code           string `json:"code" binding:"required"` // TOTP or recovery code
+}
+
+// TwoFactorCodeRequest defines a request carrying a two-factor code
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// ForgotPasswordRequest defines the request for mailing a password reset link
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// ResetForgottenPasswordRequest defines the request for choosing a new password with a mailed reset token
+type ResetForgottenPasswordRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"newPassword" binding:"required,min=8"`
+}
+
+// UnlockUserRequest defines the request for lifting a user's login lockout
+type UnlockUserRequest struct {
+	IPAddress string `json:"ipAddress"` // Optional IP address to unlock as well
+}
+
+// RefreshTokenRequest defines the request for exchanging a refresh token
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken" binding:"required"`
+}
+
 // CreateUserRequest defines the request for creating a user
 type CreateUserRequest struct {
 	Email    string `json:"email" binding:"required,email"`
@@ -91,12 +150,56 @@ type ExecuteDrawRequest struct {
 
 // UpdateWinnerPaymentStatusRequest defines the request for updating a winner's payment status
 type UpdateWinnerPaymentStatusRequest struct {
-	PaymentStatus string `json:"paymentStatus" binding:"required"`
+	PaymentStatus string `json:"paymentStatus" binding:"required"` // "Approved", "Paid" or "Failed"
 	PaymentDate   string `json:"paymentDate"`
-	PaymentRef    string `json:"paymentRef"`
+	PaymentRef    string `json:"paymentRef"` // Required for "Paid"
+	Notes         string `json:"notes"`
 }
 
 // InvokeRunnerUpRequest defines the request for invoking a runner-up
 type InvokeRunnerUpRequest struct {
+	WinnerID uuid.UUID `json:"winnerId"` // Used when the winner is not given in the path
+	Reason   string    `json:"reason" binding:"required"`
+}
+
+// ScheduleDrawRequest defines the request for scheduling a draw
+type ScheduleDrawRequest struct {
+	DrawDate         string    `json:"drawDate" binding:"required"` // YYYY-MM-DD
+	PrizeStructureID uuid.UUID `json:"prizeStructureId" binding:"required"`
+}
+
+// SimulateDrawRequest defines the request for simulating a draw
+type SimulateDrawRequest struct {
+	DrawDate         string    `json:"drawDate" binding:"required"` // YYYY-MM-DD
+	PrizeStructureID uuid.UUID `json:"prizeStructureId" binding:"required"`
+}
+
+// VoidDrawRequest defines the request for voiding a draw
+type VoidDrawRequest struct {
 	Reason string `json:"reason" binding:"required"`
 }
+
+// DrawScheduleRequest defines the request for creating or updating a draw schedule
+type DrawScheduleRequest struct {
+	Name             string `json:"name" binding:"required"`
+	CronExpression   string `json:"cronExpression" binding:"required"`
+	TimeZone         string `json:"timeZone"`         // Optional, defaults to Africa/Lagos
+	PrizeStructureID string `json:"prizeStructureId"` // Optional, the structure active on the draw date is used when empty
+	Enabled          bool   `json:"enabled"`
+}
+
+// DeliveryReceiptRequest defines the delivery receipt posted by the SMS gateway, as JSON or a form
+type DeliveryReceiptRequest struct {
+	MessageID   string `json:"messageId" form:"messageId" binding:"required"`
+	Status      string `json:"status" form:"status" binding:"required"` // e.g. DELIVRD, UNDELIV, EXPIRED
+	Error       string `json:"error" form:"error"`
+	DeliveredAt string `json:"deliveredAt" form:"deliveredAt"` // Optional, RFC 3339
+}
+
+// PayoutCallbackRequest defines the payout outcome posted by the payout provider
+type PayoutCallbackRequest struct {
+	Reference        string `json:"reference" binding:"required"`
+	ProviderPayoutID string `json:"id"`
+	Status           string `json:"status" binding:"required"` // pending, succeeded or failed
+	FailureReason    string `json:"failureReason"`
+}
@@ -42,9 +42,18 @@ type PrizeStructureResponse struct {
 	ValidFrom   string          `json:"validFrom"` // Format: YYYY-MM-DD
 	ValidTo     string          `json:"validTo"`   // Format: YYYY-MM-DD
 	Prizes      []PrizeResponse `json:"prizes"`
+	EligibilityRules EligibilityRulesResponse `json:"eligibilityRules"`
 	IsActive    bool            `json:"isActive"`
 }
 
+// EligibilityRulesResponse defines the response for the draw eligibility rules of a prize structure
+type EligibilityRulesResponse struct {
+	RechargeWindowDays int      `json:"rechargeWindowDays"`
+	MinimumPoints      int      `json:"minimumPoints"`
+	BlacklistedMSISDNs []string `json:"blacklistedMsisdns"`
+	WinCooldownDays    int      `json:"winCooldownDays"`
+}
+
 // PrizeResponse defines the response for a prize tier
 type PrizeResponse struct {
 	ID                uuid.UUID `json:"id"`
@@ -53,6 +62,37 @@ type PrizeResponse struct {
 	Value             string    `json:"value"`
 	Quantity          int       `json:"quantity"`
 	NumberOfRunnerUps int       `json:"numberOfRunnerUps"`
+	ClaimWindowHours  int       `json:"claimWindowHours"`
+	NotificationTemplate string `json:"notificationTemplate,omitempty"`
+}
+
+// BlacklistEntryResponse defines the response for a blacklist entry
+type BlacklistEntryResponse struct {
+	ID        uuid.UUID `json:"id"`
+	MSISDN    string    `json:"msisdn"`
+	Reason    string    `json:"reason"`
+	ExpiresAt string    `json:"expiresAt,omitempty"` // Format: RFC3339, empty for a permanent entry
+	IsActive  bool      `json:"isActive"`
+	CreatedBy uuid.UUID `json:"createdBy"`
+	CreatedAt string    `json:"createdAt"`
+	UpdatedAt string    `json:"updatedAt"`
+}
+
+// DrawScheduleResponse defines the response for a draw schedule
+type DrawScheduleResponse struct {
+	ID               uuid.UUID `json:"id"`
+	Name             string    `json:"name"`
+	CronExpression   string    `json:"cronExpression"`
+	TimeZone         string    `json:"timeZone"`
+	PrizeStructureID string    `json:"prizeStructureId,omitempty"` // Empty when the structure active on the draw date is used
+	Enabled          bool      `json:"enabled"`
+	NextRunAt        string    `json:"nextRunAt,omitempty"`
+	LastRunAt        string    `json:"lastRunAt,omitempty"`
+	LastRunStatus    string    `json:"lastRunStatus,omitempty"`
+	LastRunError     string    `json:"lastRunError,omitempty"`
+	LastDrawID       string    `json:"lastDrawId,omitempty"`
+	CreatedAt        string    `json:"createdAt"`
+	UpdatedAt        string    `json:"updatedAt"`
 }
 
 // UserResponseBase defines the base response for a user (used internally)
@@ -66,9 +106,27 @@ type UserResponseBase struct {
 
 // LoginResponse defines the response for user login
 type LoginResponse struct {
-	Token  string       `json:"token"`
-	User   UserResponse `json:"user"`
-	Expiry string       `json:"expiry"`
+	Token         string       `json:"token"`
+	User          UserResponse `json:"user"`
+	Expiry        string       `json:"expiry"`
+	RefreshToken  string       `json:"refreshToken"`
+	RefreshExpiry string       `json:"refreshExpiry"`
+
+	// Set instead of the tokens when the user must present their second factor
+	TwoFactorRequired bool   `json:"twoFactorRequired,omitempty"`
+	ChallengeToken    string `json:"challengeToken,omitempty"`
+	ChallengeExpiry   string `json:"challengeExpiry,omitempty"`
+}
+
+// TwoFactorEnrollmentResponse defines the response for starting TOTP enrollment
+type TwoFactorEnrollmentResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioningUri"` // otpauth URI to show as a QR code
+}
+
+// RecoveryCodesResponse defines the response carrying newly issued recovery codes
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
 }
 
 // DrawResponse defines the response for a draw
@@ -79,11 +137,34 @@ type DrawResponse struct {
 	DrawDate       string           `json:"drawDate"`
 	Status         string           `json:"status"`
 	PrizeStructure string           `json:"prizeStructure"`
+	SeedHash       string           `json:"seedHash,omitempty"`
+	FailureReason  string           `json:"failureReason,omitempty"`
+	VoidReason     string           `json:"voidReason,omitempty"`
+	VoidedAt       string           `json:"voidedAt,omitempty"`
+	ReplacementDrawID string        `json:"replacementDrawId,omitempty"`
 	Winners        []WinnerResponse `json:"winners"`
+	RunnerUps      []TierRunnerUpsResponse `json:"runnerUps,omitempty"`
 	CreatedAt      string           `json:"createdAt"`
 	CreatedBy      string           `json:"createdBy"`
 }
 
+// TierRunnerUpsResponse defines the runner-ups requested and drawn for a prize tier
+type TierRunnerUpsResponse struct {
+	PrizeTierID string `json:"prizeTierId"`
+	PrizeName   string `json:"prizeName"`
+	Requested   int    `json:"requested"`
+	Available   int    `json:"available"`
+}
+
+// DrawStatusResponse defines the response for a draw status change
+type DrawStatusResponse struct {
+	ID             uuid.UUID `json:"id"`
+	PreviousStatus string    `json:"previousStatus"`
+	Status         string    `json:"status"`
+	VoidReason     string    `json:"voidReason,omitempty"`
+	VoidedAt       string    `json:"voidedAt,omitempty"`
+}
+
 // WinnerResponse defines the response for a winner
 type WinnerResponse struct {
 	ID            uuid.UUID `json:"id"`
@@ -97,9 +178,18 @@ type WinnerResponse struct {
 	PaymentDate   string    `json:"paymentDate"`
 	PaymentRef    string    `json:"paymentRef"`
 	PaymentNotes  string    `json:"paymentNotes"`     // Added to match frontend expectations
+	PaymentApprovedBy string `json:"paymentApprovedBy,omitempty"`
+	PaymentApprovedAt string `json:"paymentApprovedAt,omitempty"`
+	PaymentHistory    []PaymentEventResponse `json:"paymentHistory,omitempty"`
 	Status        string    `json:"status"`           // Added to match frontend expectations
 	IsRunnerUp    bool      `json:"isRunnerUp"`
 	RunnerUpRank  int       `json:"runnerUpRank"`     // Added to match frontend expectations
+	ReplacesWinnerID   string `json:"replacesWinnerId,omitempty"`
+	ReplacedByWinnerID string `json:"replacedByWinnerId,omitempty"`
+	ReplacementReason  string `json:"replacementReason,omitempty"`
+	ReplacedAt         string `json:"replacedAt,omitempty"`
+	ClaimDeadline      string `json:"claimDeadline,omitempty"`
+	ClaimedAt          string `json:"claimedAt,omitempty"`
 	InvokedAt     string    `json:"invokedAt"`
 	CreatedAt     string    `json:"createdAt"`        // Added to match frontend expectations
 	UpdatedAt     string    `json:"updatedAt"`        // Added to match frontend expectations
@@ -154,6 +244,25 @@ type EligibilityStatsResponse struct {
 	Date              string `json:"date,omitempty"`     // Added to match frontend expectations
 	TotalEligible     int    `json:"totalEligible"`
 	TotalEntries      int    `json:"totalEntries"`
+	CandidateMSISDNs  int    `json:"candidateMsisdns"` // MSISDNs with points before eligibility rules are applied
+	CandidateEntries  int    `json:"candidateEntries"`
+	Exclusions        []EligibilityExclusionResponse `json:"exclusions"`
+	PrizeStructureID  string `json:"prizeStructureId,omitempty"`
+}
+
+// EligibilityExclusionResponse defines how many MSISDNs an eligibility rule excluded
+type EligibilityExclusionResponse struct {
+	Rule            string `json:"rule"`
+	ExcludedMSISDNs int    `json:"excludedMsisdns"`
+}
+
+// ReplacementHistoryResponse defines the response for the replacement history of a prize slot
+type ReplacementHistoryResponse struct {
+	DrawID             string           `json:"drawId"`
+	PrizeTierID        string           `json:"prizeTierId"`
+	CurrentWinnerID    string           `json:"currentWinnerId"`
+	RemainingRunnerUps int              `json:"remainingRunnerUps"`
+	Chain              []WinnerResponse `json:"chain"`
 }
 
 // RunnerUpInvocationResult defines the response for invoking a runner-up
@@ -162,3 +271,73 @@ type RunnerUpInvocationResult struct {
 	OriginalWinner WinnerResponse `json:"originalWinner"`
 	NewWinner      WinnerResponse `json:"newWinner"`
 }
+
+// NotificationResponse defines the response for a winner notification in the outbox
+type NotificationResponse struct {
+	ID                string `json:"id"`
+	WinnerID          string `json:"winnerId"`
+	DrawID            string `json:"drawId"`
+	MaskedMSISDN      string `json:"maskedMsisdn"`
+	Channel           string `json:"channel"`
+	Message           string `json:"message"`
+	Status            string `json:"status"`
+	Attempts          int    `json:"attempts"`
+	MaxAttempts       int    `json:"maxAttempts"`
+	NextAttemptAt     string `json:"nextAttemptAt,omitempty"`
+	LastError         string `json:"lastError,omitempty"`
+	ProviderMessageID string `json:"providerMessageId,omitempty"`
+	SentAt            string `json:"sentAt,omitempty"`
+	DeliveredAt       string `json:"deliveredAt,omitempty"`
+	CreatedAt         string `json:"createdAt"`
+}
+
+// DeliveryReceiptResponse defines the response to an SMS gateway delivery receipt
+type DeliveryReceiptResponse struct {
+	NotificationID     string `json:"notificationId"`
+	WinnerID           string `json:"winnerId"`
+	NotificationStatus string `json:"notificationStatus"`
+	WinnerNotified     bool   `json:"winnerNotified"`
+}
+
+// PayoutResponse defines the response for a winner payout
+type PayoutResponse struct {
+	ID                string  `json:"id"`
+	WinnerID          string  `json:"winnerId"`
+	DrawID            string  `json:"drawId"`
+	MaskedMSISDN      string  `json:"maskedMsisdn"`
+	Method            string  `json:"method"`
+	Amount            float64 `json:"amount"`
+	Currency          string  `json:"currency"`
+	Status            string  `json:"status"`
+	Provider          string  `json:"provider"`
+	Attempts          int     `json:"attempts"`
+	ProviderReference string  `json:"providerReference,omitempty"`
+	ProviderPayoutID  string  `json:"providerPayoutId,omitempty"`
+	FailureReason     string  `json:"failureReason,omitempty"`
+	RequestedBy       string  `json:"requestedBy"`
+	CompletedAt       string  `json:"completedAt,omitempty"`
+	CreatedAt         string  `json:"createdAt"`
+	UpdatedAt         string  `json:"updatedAt"`
+}
+
+// PaymentEventResponse defines the response for one step of paying a winner's prize
+type PaymentEventResponse struct {
+	ID         string `json:"id"`
+	FromStatus string `json:"fromStatus"`
+	ToStatus   string `json:"toStatus"`
+	ActorID    string `json:"actorId"`
+	Reference  string `json:"reference,omitempty"`
+	Notes      string `json:"notes,omitempty"`
+	At         string `json:"at"`
+}
+
+// PaymentHistoryResponse defines the response for the payment history of a winner's prize
+type PaymentHistoryResponse struct {
+	WinnerID          string                 `json:"winnerId"`
+	PaymentStatus     string                 `json:"paymentStatus"`
+	PaymentRef        string                 `json:"paymentRef,omitempty"`
+	PaymentApprovedBy string                 `json:"paymentApprovedBy,omitempty"`
+	PaymentApprovedAt string                 `json:"paymentApprovedAt,omitempty"`
+	PaymentDate       string                 `json:"paymentDate,omitempty"`
+	Events            []PaymentEventResponse `json:"events"`
+}
@@ -93,7 +93,7 @@ func Default() gin.HandlerFunc {
 	middleware := NewCORSMiddleware(
 		[]string{"*"},
 		[]string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		[]string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With"},
+		[]string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With", "X-TOTP-Code"},
 		[]string{"Content-Length"},
 		true,
 		"43200", // 12 hours in seconds
@@ -106,7 +106,7 @@ func WithAllowedOrigins(origins []string) gin.HandlerFunc {
 	middleware := NewCORSMiddleware(
 		origins,
 		[]string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		[]string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With"},
+		[]string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With", "X-TOTP-Code"},
 		[]string{"Content-Length"},
 		true,
 		"43200", // 12 hours in seconds
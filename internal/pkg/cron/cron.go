@@ -0,0 +1,256 @@
+// Package cron parses standard five-field cron expressions and computes when they next fire.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// searchLimit bounds how far ahead Next looks before giving up on an expression
+// that can never fire, such as "0 0 30 2 *"
+const searchLimit = 5 * 366 * 24 * time.Hour
+
+// descriptors are the supported shorthand expressions
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var monthNames = map[string]int{
+	"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+	"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+}
+
+var dayNames = map[string]int{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
+
+// field describes the allowed range of one cron field
+type field struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day of month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12, names: monthNames}
+	dowField    = field{name: "day of week", min: 0, max: 7, names: dayNames} // 7 is also Sunday
+)
+
+// Schedule is a parsed cron expression
+type Schedule struct {
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+	domAny bool
+	dowAny bool
+}
+
+// Parse parses a five-field cron expression (minute, hour, day of month, month, day of week)
+// or one of the @yearly, @monthly, @weekly, @daily and @hourly shorthands. Fields accept
+// "*", single values, ranges, lists and steps, and month and day names.
+func Parse(expr string) (*Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if expanded, ok := descriptors[strings.ToLower(expr)]; ok {
+		expr = expanded
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields, got %d", len(fields))
+	}
+
+	s := &Schedule{}
+	var err error
+	if s.minute, _, err = parseField(fields[0], minuteField); err != nil {
+		return nil, err
+	}
+	if s.hour, _, err = parseField(fields[1], hourField); err != nil {
+		return nil, err
+	}
+	if s.dom, s.domAny, err = parseField(fields[2], domField); err != nil {
+		return nil, err
+	}
+	if s.month, _, err = parseField(fields[3], monthField); err != nil {
+		return nil, err
+	}
+	if s.dow, s.dowAny, err = parseField(fields[4], dowField); err != nil {
+		return nil, err
+	}
+
+	// Sunday may be written as 0 or 7
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+
+	return s, nil
+}
+
+// Next returns the first time after t, to the minute, at which the schedule fires. Times are
+// evaluated in t's location. The zero time is returned when the schedule never fires.
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(searchLimit)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !s.dayMatches(t.Day(), t.Weekday()) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+// SharesDay reports whether s and other both fire on some calendar day, at whatever times of
+// it. Every day of the year falls on every weekday in some year, so each combination is tried.
+func (s *Schedule) SharesDay(other *Schedule) bool {
+	for month := time.January; month <= time.December; month++ {
+		// 2024 is a leap year, so 29 February is tried as well
+		days := time.Date(2024, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		for day := 1; day <= days; day++ {
+			for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+				if s.firesOnDay(month, day, weekday) && other.firesOnDay(month, day, weekday) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// firesOnDay reports whether the schedule fires at some time of a day
+func (s *Schedule) firesOnDay(month time.Month, day int, weekday time.Weekday) bool {
+	return s.month&(1<<uint(month)) != 0 && s.dayMatches(day, weekday)
+}
+
+// dayMatches applies the usual cron rule: when both day fields are restricted a day
+// matching either of them fires, otherwise the restricted one decides
+func (s *Schedule) dayMatches(day int, weekday time.Weekday) bool {
+	domMatch := s.dom&(1<<uint(day)) != 0
+	dowMatch := s.dow&(1<<uint(weekday)) != 0
+
+	if s.domAny || s.dowAny {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// parseField parses one field into a bitset of allowed values, reporting whether it was "*"
+func parseField(value string, f field) (uint64, bool, error) {
+	if value == "*" || value == "?" {
+		return bitRange(f.min, f.max, 1), true, nil
+	}
+
+	var bits uint64
+	for _, item := range strings.Split(value, ",") {
+		itemBits, err := parseItem(item, f)
+		if err != nil {
+			return 0, false, err
+		}
+		bits |= itemBits
+	}
+
+	return bits, false, nil
+}
+
+// parseItem parses a single value, range or step expression
+func parseItem(item string, f field) (uint64, error) {
+	rangePart, stepPart, hasStep := strings.Cut(item, "/")
+
+	step := 1
+	if hasStep {
+		var err error
+		step, err = strconv.Atoi(stepPart)
+		if err != nil || step <= 0 {
+			return 0, fmt.Errorf("invalid step %q in %s field", stepPart, f.name)
+		}
+	}
+
+	var start, end int
+	switch {
+	case rangePart == "*":
+		start, end = f.min, f.max
+	case strings.Contains(rangePart, "-"):
+		lowPart, highPart, _ := strings.Cut(rangePart, "-")
+		var err error
+		if start, err = parseValue(lowPart, f); err != nil {
+			return 0, err
+		}
+		if end, err = parseValue(highPart, f); err != nil {
+			return 0, err
+		}
+		if start > end {
+			return 0, fmt.Errorf("invalid range %q in %s field", rangePart, f.name)
+		}
+	default:
+		var err error
+		if start, err = parseValue(rangePart, f); err != nil {
+			return 0, err
+		}
+		end = start
+		// "5/15" means every 15 starting at 5
+		if hasStep {
+			end = f.max
+		}
+	}
+
+	return bitRange(start, end, step), nil
+}
+
+// parseValue parses a number or name and checks it is within the field's range
+func parseValue(value string, f field) (int, error) {
+	if n, ok := f.names[strings.ToLower(value)]; ok {
+		return n, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", value, f.name)
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("value %d out of range %d-%d in %s field", n, f.min, f.max, f.name)
+	}
+
+	return n, nil
+}
+
+// bitRange returns a bitset with every step-th bit from start to end set
+func bitRange(start, end, step int) uint64 {
+	var bits uint64
+	for i := start; i <= end; i += step {
+		bits |= 1 << uint(i)
+	}
+	return bits
+}
@@ -0,0 +1,94 @@
+package cron_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/cron"
+)
+
+func TestSchedule_Next(t *testing.T) {
+	lagos, err := time.LoadLocation("Africa/Lagos")
+	require.NoError(t, err)
+
+	// 2026-03-04 is a Wednesday
+	from := time.Date(2026, 3, 4, 10, 30, 0, 0, lagos)
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"0 20 * * *", time.Date(2026, 3, 4, 20, 0, 0, 0, lagos)},
+		{"@daily", time.Date(2026, 3, 5, 0, 0, 0, 0, lagos)},
+		{"0 21 * * SUN", time.Date(2026, 3, 8, 21, 0, 0, 0, lagos)},
+		{"0 21 * * 7", time.Date(2026, 3, 8, 21, 0, 0, 0, lagos)},
+		{"*/15 * * * *", time.Date(2026, 3, 4, 10, 45, 0, 0, lagos)},
+		{"0 9 1 * *", time.Date(2026, 4, 1, 9, 0, 0, 0, lagos)},
+		{"0 20 * * 1-5", time.Date(2026, 3, 4, 20, 0, 0, 0, lagos)},
+		{"30 10 * * *", time.Date(2026, 3, 5, 10, 30, 0, 0, lagos)},
+		// Either day field matches when both are restricted
+		{"0 0 15 * FRI", time.Date(2026, 3, 6, 0, 0, 0, 0, lagos)},
+	}
+
+	for _, tt := range tests {
+		schedule, err := cron.Parse(tt.expr)
+		require.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.want, schedule.Next(from), tt.expr)
+	}
+}
+
+func TestSchedule_NextNeverFires(t *testing.T) {
+	schedule, err := cron.Parse("0 0 30 2 *")
+	require.NoError(t, err)
+
+	assert.True(t, schedule.Next(time.Now()).IsZero())
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+	} {
+		_, err := cron.Parse(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestSchedule_SharesDay(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		// A daily draw and a weekly mega draw meet every Sunday
+		{"0 20 * * *", "0 21 * * SUN", true},
+		{"0 20 * * 1-6", "0 21 * * SUN", false},
+		{"0 9 1 * *", "0 9 15 * *", false},
+		{"0 9 1 * *", "0 20 * * MON", true},
+		{"0 0 1 1 *", "0 0 1 7 *", false},
+		// 29 February falls on a Monday in some years
+		{"0 0 29 2 *", "0 0 * 2 MON", true},
+		// Either day field matches when both are restricted
+		{"0 0 15 * FRI", "0 0 * * FRI", true},
+		{"0 0 30 2 *", "@daily", false},
+	}
+
+	for _, tt := range tests {
+		a, err := cron.Parse(tt.a)
+		require.NoError(t, err, tt.a)
+		b, err := cron.Parse(tt.b)
+		require.NoError(t, err, tt.b)
+		assert.Equal(t, tt.want, a.SharesDay(b), "%s / %s", tt.a, tt.b)
+		assert.Equal(t, tt.want, b.SharesDay(a), "%s / %s", tt.b, tt.a)
+	}
+}
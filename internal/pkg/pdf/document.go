@@ -0,0 +1,196 @@
+// Package pdf renders simple text documents as PDF using the standard Helvetica fonts,
+// so no font files need to be embedded.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// A4 page size and margins, in points
+const (
+	pageWidth  = 595.0
+	pageHeight = 842.0
+	margin     = 50.0
+)
+
+// Line is one line of text in a document
+type Line struct {
+	Text string
+	Size float64
+	Bold bool
+}
+
+// Document is a text document laid out top to bottom, starting a new page when one is full
+type Document struct {
+	title string
+	info  map[string]string
+	lines []Line
+}
+
+// NewDocument creates an empty document with a title
+func NewDocument(title string) *Document {
+	return &Document{
+		title: title,
+		info:  make(map[string]string),
+	}
+}
+
+// SetInfo sets an entry of the document information dictionary, such as Subject or Keywords
+func (d *Document) SetInfo(key, value string) {
+	d.info[key] = value
+}
+
+// Heading adds a bold line of text
+func (d *Document) Heading(text string, size float64) {
+	d.lines = append(d.lines, Line{Text: text, Size: size, Bold: true})
+}
+
+// Text adds a line of text, wrapped to the page width
+func (d *Document) Text(text string, size float64) {
+	for _, line := range wrap(text, size) {
+		d.lines = append(d.lines, Line{Text: line, Size: size})
+	}
+}
+
+// Space adds an empty line
+func (d *Document) Space(size float64) {
+	d.lines = append(d.lines, Line{Size: size})
+}
+
+// WriteTo renders the document and writes it to w
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	pages := d.layout()
+
+	var out bytes.Buffer
+	offsets := make([]int, 0)
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n")
+
+	// Objects 1 to 4: catalog, page tree, regular and bold fonts. Each page is followed by its content.
+	pageIDs := make([]string, len(pages))
+	for i := range pages {
+		pageIDs[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(pageIDs, " "), len(pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	for i, content := range pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, 6+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+	}
+
+	info := fmt.Sprintf("<< /Title %s /Producer (GP-Backend-Promo)", literal(d.title))
+	keys := make([]string, 0, len(d.info))
+	for key := range d.info {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		info += fmt.Sprintf(" /%s %s", key, literal(d.info[key]))
+	}
+	object(info + " >>")
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, len(offsets), xref)
+
+	n, err := w.Write(out.Bytes())
+	return int64(n), err
+}
+
+// layout places the lines on pages and returns the content stream of each page
+func (d *Document) layout() []string {
+	pages := make([]string, 0)
+	var page strings.Builder
+	y := pageHeight - margin
+
+	for _, line := range d.lines {
+		leading := line.Size * 1.4
+		if y-leading < margin && page.Len() > 0 {
+			pages = append(pages, page.String())
+			page.Reset()
+			y = pageHeight - margin
+		}
+		y -= leading
+
+		if line.Text == "" {
+			continue
+		}
+		font := "F1"
+		if line.Bold {
+			font = "F2"
+		}
+		fmt.Fprintf(&page, "BT /%s %.1f Tf %.1f %.1f Td %s Tj ET\n", font, line.Size, margin, y, literal(line.Text))
+	}
+
+	return append(pages, page.String())
+}
+
+// wrap splits text into lines that fit the page width at the given font size.
+// Helvetica averages about half an em per character.
+func wrap(text string, size float64) []string {
+	maxChars := int((pageWidth - 2*margin) / (size * 0.5))
+	if len(text) <= maxChars {
+		return []string{text}
+	}
+
+	lines := make([]string, 0)
+	current := ""
+	for _, word := range strings.Fields(text) {
+		for len(word) > maxChars {
+			if current != "" {
+				lines = append(lines, current)
+				current = ""
+			}
+			lines = append(lines, word[:maxChars])
+			word = word[maxChars:]
+		}
+		switch {
+		case current == "":
+			current = word
+		case len(current)+1+len(word) <= maxChars:
+			current += " " + word
+		default:
+			lines = append(lines, current)
+			current = word
+		}
+	}
+	if current != "" {
+		lines = append(lines, current)
+	}
+	return lines
+}
+
+// literal encodes text as a PDF string literal. Characters outside
+// printable ASCII are replaced, as the fonts are not embedded.
+func literal(text string) string {
+	var b strings.Builder
+	b.WriteByte('(')
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 32 || r > 126:
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte(')')
+	return b.String()
+}
@@ -0,0 +1,45 @@
+package pdf_test
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/pdf"
+)
+
+func TestDocumentWriteTo(t *testing.T) {
+	doc := pdf.NewDocument("Winners Certificate")
+	doc.SetInfo("Keywords", "signature=abc")
+	doc.Heading("Winners (Draw 1)", 16)
+	for i := 0; i < 80; i++ {
+		doc.Text(fmt.Sprintf("Line %d", i), 11)
+	}
+
+	var buf bytes.Buffer
+	_, err := doc.WriteTo(&buf)
+	require.NoError(t, err)
+	out := buf.String()
+
+	assert.True(t, strings.HasPrefix(out, "%PDF-1.4\n"))
+	assert.True(t, strings.HasSuffix(out, "%%EOF\n"))
+	assert.Contains(t, out, `(Winners \(Draw 1\)) Tj`)
+	assert.Contains(t, out, "/Count 2")
+	assert.Contains(t, out, "/Keywords (signature=abc)")
+
+	// Every cross-reference entry points at the start of its object
+	xref := out[strings.LastIndex(out, "\nxref\n"):]
+	entries := regexp.MustCompile(`(\d{10}) 00000 n`).FindAllStringSubmatch(xref, -1)
+	require.NotEmpty(t, entries)
+	for i, entry := range entries {
+		offset, err := strconv.Atoi(entry[1])
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(out[offset:], fmt.Sprintf("%d 0 obj", i+1)), "object %d", i+1)
+	}
+}
@@ -0,0 +1,28 @@
+package spreadsheet
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// CSVReader streams rows from a CSV file
+type CSVReader struct {
+	reader *csv.Reader
+}
+
+// NewCSVReader creates a new CSVReader
+func NewCSVReader(r io.Reader) *CSVReader {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1 // Tolerate ragged rows, missing cells are validated per row
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = false
+
+	return &CSVReader{
+		reader: reader,
+	}
+}
+
+// Read implements the RowReader interface
+func (r *CSVReader) Read() ([]string, error) {
+	return r.reader.Read()
+}
@@ -0,0 +1,45 @@
+package spreadsheet
+
+import (
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// ErrUnsupportedFormat is returned when a file is neither CSV nor XLSX
+var ErrUnsupportedFormat = errors.New("unsupported file format, expected .csv or .xlsx")
+
+// RowReader reads a tabular file one row at a time.
+// Read returns io.EOF once all rows have been consumed.
+type RowReader interface {
+	Read() ([]string, error)
+}
+
+// File is the minimal file handle needed to read either supported format.
+// multipart.File satisfies this interface.
+type File interface {
+	io.Reader
+	io.ReaderAt
+}
+
+// NewReader returns a RowReader for the given file, choosing the format from the file extension
+func NewReader(file File, size int64, fileName string) (RowReader, error) {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".csv", ".txt":
+		return NewCSVReader(file), nil
+	case ".xlsx":
+		return NewXLSXReader(file, size)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// NormalizeHeader lowercases a header cell and collapses separators so that
+// "Recharge_Date", "recharge-date" and " Recharge  Date " all compare equal
+func NormalizeHeader(header string) string {
+	header = strings.TrimPrefix(header, "\ufeff")
+	header = strings.ToLower(strings.TrimSpace(header))
+	header = strings.NewReplacer("_", " ", "-", " ", ".", " ").Replace(header)
+	return strings.Join(strings.Fields(header), " ")
+}
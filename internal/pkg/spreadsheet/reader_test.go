@@ -0,0 +1,86 @@
+package spreadsheet_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/spreadsheet"
+)
+
+func readAll(t *testing.T, reader spreadsheet.RowReader) [][]string {
+	rows := make([][]string, 0)
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			return rows
+		}
+		require.NoError(t, err)
+		rows = append(rows, row)
+	}
+}
+
+func buildXLSX(t *testing.T, files map[string]string) *bytes.Reader {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestNewReaderCSV(t *testing.T) {
+	data := "MSISDN,Recharge Amount,Recharge Date\n08031234567,500,2025-01-02\n"
+	reader, err := spreadsheet.NewReader(strings.NewReader(data), int64(len(data)), "upload.CSV")
+	require.NoError(t, err)
+
+	rows := readAll(t, reader)
+	assert.Equal(t, [][]string{
+		{"MSISDN", "Recharge Amount", "Recharge Date"},
+		{"08031234567", "500", "2025-01-02"},
+	}, rows)
+}
+
+func TestNewReaderUnsupportedFormat(t *testing.T) {
+	_, err := spreadsheet.NewReader(strings.NewReader(""), 0, "upload.pdf")
+	assert.ErrorIs(t, err, spreadsheet.ErrUnsupportedFormat)
+}
+
+func TestXLSXReader(t *testing.T) {
+	file := buildXLSX(t, map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="Data" sheetId="1" r:id="rId1"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Target="worksheets/data.xml"/></Relationships>`,
+		"xl/sharedStrings.xml": `<sst><si><t>MSISDN</t></si><si><t>Amount</t></si><si><r><t>Recharge </t></r><r><t>Date</t></r></si></sst>`,
+		"xl/worksheets/data.xml": `<worksheet><sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="C1" t="s"><v>2</v></c></row>
+<row r="3"><c r="A3" t="inlineStr"><is><t>2348031234567</t></is></c><c r="C3"><v>45658</v></c></row>
+</sheetData></worksheet>`,
+	})
+
+	reader, err := spreadsheet.NewReader(file, file.Size(), "upload.xlsx")
+	require.NoError(t, err)
+
+	rows := readAll(t, reader)
+	assert.Equal(t, [][]string{
+		{"MSISDN", "Amount", "Recharge Date"},
+		{},
+		{"2348031234567", "", "45658"},
+	}, rows)
+}
+
+func TestNormalizeHeader(t *testing.T) {
+	assert.Equal(t, "recharge date", spreadsheet.NormalizeHeader("\ufeff Recharge_Date "))
+	assert.Equal(t, "recharge date", spreadsheet.NormalizeHeader("recharge-date"))
+	assert.Equal(t, "phone number", spreadsheet.NormalizeHeader("Phone  Number"))
+}
@@ -0,0 +1,52 @@
+package spreadsheet_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/spreadsheet"
+)
+
+func TestXLSXWriterRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := spreadsheet.NewWriter(&buf, spreadsheet.FormatXLSX)
+	require.NoError(t, err)
+
+	rows := [][]string{
+		{"MSISDN", "Prize", "Notes"},
+		{"234****567", "N1,000 <Airtime>", ""},
+		{"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "AA"},
+	}
+	for _, row := range rows {
+		require.NoError(t, writer.Write(row))
+	}
+	require.NoError(t, writer.Close())
+
+	reader, err := spreadsheet.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()), "report.xlsx")
+	require.NoError(t, err)
+
+	read := readAll(t, reader)
+	require.Len(t, read, 3)
+	assert.Equal(t, rows[0], read[0])
+	assert.Equal(t, []string{"234****567", "N1,000 <Airtime>"}, read[1])
+	assert.Equal(t, rows[2], read[2])
+}
+
+func TestCSVWriterNeutralisesFormulas(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := spreadsheet.NewWriter(&buf, spreadsheet.FormatCSV)
+	require.NoError(t, err)
+
+	require.NoError(t, writer.Write([]string{"=HYPERLINK(\"x\")", "2348031234567"}))
+	require.NoError(t, writer.Close())
+
+	assert.Equal(t, "\"'=HYPERLINK(\"\"x\"\")\",2348031234567\n", buf.String())
+}
+
+func TestNewWriterUnsupportedFormat(t *testing.T) {
+	_, err := spreadsheet.NewWriter(&bytes.Buffer{}, "pdf")
+	assert.Error(t, err)
+}
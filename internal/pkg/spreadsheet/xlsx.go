@@ -0,0 +1,314 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+const defaultWorksheetPath = "xl/worksheets/sheet1.xml"
+
+// XLSXReader streams rows from the first worksheet of an XLSX workbook.
+// Only shared strings are held in memory; worksheet XML is decoded token by token.
+type XLSXReader struct {
+	sheet         io.ReadCloser
+	decoder       *xml.Decoder
+	sharedStrings []string
+	lastRow       int
+	pendingRow    int
+	pendingCells  []string
+	done          bool
+}
+
+// NewXLSXReader opens an XLSX workbook and prepares its first worksheet for reading
+func NewXLSXReader(ra io.ReaderAt, size int64) (*XLSXReader, error) {
+	archive, err := zip.NewReader(ra, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open xlsx archive: %w", err)
+	}
+
+	files := make(map[string]*zip.File, len(archive.File))
+	for _, f := range archive.File {
+		files[f.Name] = f
+	}
+
+	sharedStrings, err := readSharedStrings(files["xl/sharedStrings.xml"])
+	if err != nil {
+		return nil, err
+	}
+
+	sheetFile := files[firstWorksheetPath(files)]
+	if sheetFile == nil {
+		return nil, errors.New("xlsx workbook does not contain a worksheet")
+	}
+
+	sheet, err := sheetFile.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open worksheet: %w", err)
+	}
+
+	return &XLSXReader{
+		sheet:         sheet,
+		decoder:       xml.NewDecoder(sheet),
+		sharedStrings: sharedStrings,
+	}, nil
+}
+
+// Read implements the RowReader interface.
+// Rows omitted from the sheet XML are returned as empty rows so that row
+// numbers reported to users match what they see in their spreadsheet.
+func (r *XLSXReader) Read() ([]string, error) {
+	if r.pendingCells != nil {
+		if r.lastRow+1 < r.pendingRow {
+			r.lastRow++
+			return []string{}, nil
+		}
+		cells := r.pendingCells
+		r.lastRow = r.pendingRow
+		r.pendingCells = nil
+		return cells, nil
+	}
+
+	if r.done {
+		return nil, io.EOF
+	}
+
+	rowNumber, cells, err := r.nextRow()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			r.done = true
+			r.Close()
+		}
+		return nil, err
+	}
+
+	if rowNumber == 0 {
+		rowNumber = r.lastRow + 1
+	}
+	r.pendingRow = rowNumber
+	r.pendingCells = cells
+
+	return r.Read()
+}
+
+// Close releases the underlying worksheet stream
+func (r *XLSXReader) Close() error {
+	if r.sheet == nil {
+		return nil
+	}
+	err := r.sheet.Close()
+	r.sheet = nil
+	return err
+}
+
+// nextRow decodes tokens until a complete <row> element has been read
+func (r *XLSXReader) nextRow() (int, []string, error) {
+	for {
+		token, err := r.decoder.Token()
+		if err != nil {
+			return 0, nil, err
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "row" {
+			continue
+		}
+
+		rowNumber, _ := strconv.Atoi(attr(start, "r"))
+		cells, err := r.readCells()
+		if err != nil {
+			return 0, nil, err
+		}
+		return rowNumber, cells, nil
+	}
+}
+
+// readCells reads the <c> elements of the current row
+func (r *XLSXReader) readCells() ([]string, error) {
+	cells := make([]string, 0)
+
+	for {
+		token, err := r.decoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read worksheet row: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if t.Name.Local != "c" {
+				continue
+			}
+
+			var cell struct {
+				Value  string `xml:"v"`
+				Inline struct {
+					Text []string `xml:"t"`
+					Runs []struct {
+						Text string `xml:"t"`
+					} `xml:"r"`
+				} `xml:"is"`
+			}
+			if err := r.decoder.DecodeElement(&cell, &t); err != nil {
+				return nil, fmt.Errorf("failed to decode worksheet cell: %w", err)
+			}
+
+			value := cell.Value
+			switch attr(t, "t") {
+			case "s":
+				index, err := strconv.Atoi(strings.TrimSpace(cell.Value))
+				if err != nil || index < 0 || index >= len(r.sharedStrings) {
+					return nil, fmt.Errorf("invalid shared string reference %q", cell.Value)
+				}
+				value = r.sharedStrings[index]
+			case "inlineStr":
+				var b strings.Builder
+				for _, text := range cell.Inline.Text {
+					b.WriteString(text)
+				}
+				for _, run := range cell.Inline.Runs {
+					b.WriteString(run.Text)
+				}
+				value = b.String()
+			}
+
+			column := columnIndex(attr(t, "r"))
+			if column < 0 {
+				column = len(cells)
+			}
+			for len(cells) < column {
+				cells = append(cells, "")
+			}
+			if column < len(cells) {
+				cells[column] = value
+			} else {
+				cells = append(cells, value)
+			}
+
+		case xml.EndElement:
+			if t.Name.Local == "row" {
+				return cells, nil
+			}
+		}
+	}
+}
+
+// readSharedStrings loads the shared string table, which may be absent for numeric-only sheets
+func readSharedStrings(f *zip.File) ([]string, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open shared strings: %w", err)
+	}
+	defer rc.Close()
+
+	var table struct {
+		Items []struct {
+			Text []string `xml:"t"`
+			Runs []struct {
+				Text string `xml:"t"`
+			} `xml:"r"`
+		} `xml:"si"`
+	}
+	if err := xml.NewDecoder(rc).Decode(&table); err != nil {
+		return nil, fmt.Errorf("failed to decode shared strings: %w", err)
+	}
+
+	strs := make([]string, 0, len(table.Items))
+	for _, item := range table.Items {
+		var b strings.Builder
+		for _, text := range item.Text {
+			b.WriteString(text)
+		}
+		for _, run := range item.Runs {
+			b.WriteString(run.Text)
+		}
+		strs = append(strs, b.String())
+	}
+
+	return strs, nil
+}
+
+// firstWorksheetPath resolves the first sheet listed in the workbook, falling back to sheet1.xml
+func firstWorksheetPath(files map[string]*zip.File) string {
+	var workbook struct {
+		Sheets []struct {
+			RelID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	var rels struct {
+		Relationships []struct {
+			ID     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+
+	if err := decodeZipXML(files["xl/workbook.xml"], &workbook); err != nil || len(workbook.Sheets) == 0 {
+		return defaultWorksheetPath
+	}
+	if err := decodeZipXML(files["xl/_rels/workbook.xml.rels"], &rels); err != nil {
+		return defaultWorksheetPath
+	}
+
+	for _, rel := range rels.Relationships {
+		if rel.ID != workbook.Sheets[0].RelID {
+			continue
+		}
+		target := strings.TrimPrefix(rel.Target, "/")
+		if !strings.HasPrefix(target, "xl/") {
+			target = path.Join("xl", target)
+		}
+		return target
+	}
+
+	return defaultWorksheetPath
+}
+
+// decodeZipXML decodes a whole XML file from the archive into v
+func decodeZipXML(f *zip.File, v interface{}) error {
+	if f == nil {
+		return errors.New("file not found in archive")
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	return xml.NewDecoder(rc).Decode(v)
+}
+
+// columnIndex converts a cell reference such as "C7" to a zero-based column index
+func columnIndex(ref string) int {
+	index := 0
+	letters := 0
+	for _, ch := range ref {
+		if ch < 'A' || ch > 'Z' {
+			break
+		}
+		index = index*26 + int(ch-'A'+1)
+		letters++
+	}
+	if letters == 0 {
+		return -1
+	}
+	return index - 1
+}
+
+// attr returns the value of the named attribute, ignoring namespaces
+func attr(el xml.StartElement, name string) string {
+	for _, a := range el.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
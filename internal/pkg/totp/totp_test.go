@@ -0,0 +1,82 @@
+package totp_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArowuTest/GP-Backend-Promo/internal/pkg/totp"
+)
+
+// rfcSecret is the SHA-1 test secret of RFC 6238 appendix B, "12345678901234567890", in base32
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCodeMatchesRFC6238(t *testing.T) {
+	// The RFC lists eight digit codes; six digit codes are their last six digits
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1111111111: "050471",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+
+	for unix, expected := range vectors {
+		code, err := totp.Code(rfcSecret, totp.Step(time.Unix(unix, 0)))
+		require.NoError(t, err)
+		assert.Equal(t, expected, code, "time %d", unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	code, err := totp.Code(rfcSecret, totp.Step(now))
+	require.NoError(t, err)
+
+	step, ok := totp.Validate(rfcSecret, code, now, 1)
+	assert.True(t, ok)
+	assert.Equal(t, totp.Step(now), step)
+
+	t.Run("previous step within skew", func(t *testing.T) {
+		step, ok := totp.Validate(rfcSecret, code, now.Add(totp.Period), 1)
+		assert.True(t, ok)
+		assert.Equal(t, totp.Step(now), step)
+	})
+
+	t.Run("outside skew", func(t *testing.T) {
+		_, ok := totp.Validate(rfcSecret, code, now.Add(2*totp.Period), 1)
+		assert.False(t, ok)
+	})
+
+	t.Run("spaces are ignored", func(t *testing.T) {
+		_, ok := totp.Validate(rfcSecret, code[:3]+" "+code[3:], now, 0)
+		assert.True(t, ok)
+	})
+
+	t.Run("wrong length", func(t *testing.T) {
+		_, ok := totp.Validate(rfcSecret, "12345", now, 1)
+		assert.False(t, ok)
+	})
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	assert.Len(t, secret, 32)
+
+	_, err = totp.Code(secret, 1)
+	assert.NoError(t, err)
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri := totp.ProvisioningURI("MyNumba DonWin", "admin@example.com", rfcSecret)
+
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/MyNumba%20DonWin:admin@example.com?"))
+	assert.Contains(t, uri, "secret="+rfcSecret)
+	assert.Contains(t, uri, "issuer=MyNumba+DonWin")
+	assert.Contains(t, uri, "digits=6")
+	assert.Contains(t, uri, "period=30")
+}